@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"net"
 	"regexp"
+	"strconv"
+	"strings"
 )
 
 // CIDR is a SchemaValidateFunc which tests if the provided value is a valid IPv4 CIDR
@@ -61,3 +63,54 @@ func validatePortNumber(i interface{}, k string, allowZero bool) (warnings []str
 
 	return warnings, errors
 }
+
+// ParsePortRange parses a port number, a range of port numbers (e.g. `8000-8080`) or `*` into the
+// first and last port numbers which it covers
+func ParsePortRange(input string) (start int, end int, err error) {
+	if input == "*" {
+		return 0, 65535, nil
+	}
+
+	first, last, isRange := strings.Cut(input, "-")
+	if start, err = parsePortRangeNumber(first); err != nil {
+		return 0, 0, err
+	}
+	if !isRange {
+		return start, start, nil
+	}
+
+	if end, err = parsePortRangeNumber(last); err != nil {
+		return 0, 0, err
+	}
+	if end < start {
+		return 0, 0, fmt.Errorf("the end of the range %q must not be before the start", input)
+	}
+
+	return start, end, nil
+}
+
+func parsePortRangeNumber(input string) (int, error) {
+	v, err := strconv.Atoi(strings.TrimSpace(input))
+	if err != nil || v < 0 || 65535 < v {
+		return 0, fmt.Errorf("%q is not a port number between 0 and 65535", input)
+	}
+	return v, nil
+}
+
+// ParseAddressPrefix parses an IPv4/IPv6 address or CIDR into the network which it covers, an address
+// being treated as a network containing only that address. false is returned where the value is
+// neither, for example a Service Tag or `*`
+func ParseAddressPrefix(input string) (*net.IPNet, bool) {
+	if _, network, err := net.ParseCIDR(input); err == nil {
+		return network, true
+	}
+
+	ip := net.ParseIP(input)
+	if ip == nil {
+		return nil, false
+	}
+	if four := ip.To4(); four != nil {
+		return &net.IPNet{IP: four, Mask: net.CIDRMask(32, 32)}, true
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}, true
+}
@@ -187,3 +187,119 @@ func TestPortNumberOrZero(t *testing.T) {
 		})
 	}
 }
+
+func TestParsePortRange(t *testing.T) {
+	cases := []struct {
+		PortRange string
+		Start     int
+		End       int
+		Error     bool
+	}{
+		{
+			PortRange: "",
+			Error:     true,
+		},
+		{
+			PortRange: "*",
+			Start:     0,
+			End:       65535,
+		},
+		{
+			PortRange: "443",
+			Start:     443,
+			End:       443,
+		},
+		{
+			PortRange: "8000-8080",
+			Start:     8000,
+			End:       8080,
+		},
+		{
+			PortRange: "8080-8000",
+			Error:     true,
+		},
+		{
+			PortRange: "1-65536",
+			Error:     true,
+		},
+		{
+			PortRange: "http",
+			Error:     true,
+		},
+		{
+			PortRange: "80-",
+			Error:     true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.PortRange, func(t *testing.T) {
+			start, end, err := ParsePortRange(tc.PortRange)
+			if (err != nil) != tc.Error {
+				t.Fatalf("Expected ParsePortRange to return an error %t but got %+v", tc.Error, err)
+			}
+			if tc.Error {
+				return
+			}
+
+			if start != tc.Start || end != tc.End {
+				t.Fatalf("Expected ParsePortRange to return %d-%d not %d-%d", tc.Start, tc.End, start, end)
+			}
+		})
+	}
+}
+
+func TestParseAddressPrefix(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected string
+		Valid    bool
+	}{
+		{
+			Input: "*",
+			Valid: false,
+		},
+		{
+			Input: "VirtualNetwork",
+			Valid: false,
+		},
+		{
+			Input:    "10.0.0.0/16",
+			Expected: "10.0.0.0/16",
+			Valid:    true,
+		},
+		{
+			Input:    "10.0.1.4/16",
+			Expected: "10.0.0.0/16",
+			Valid:    true,
+		},
+		{
+			Input:    "10.0.1.4",
+			Expected: "10.0.1.4/32",
+			Valid:    true,
+		},
+		{
+			Input:    "2001:db8::/32",
+			Expected: "2001:db8::/32",
+			Valid:    true,
+		},
+		{
+			Input:    "2001:db8::1",
+			Expected: "2001:db8::1/128",
+			Valid:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Input, func(t *testing.T) {
+			network, valid := ParseAddressPrefix(tc.Input)
+			if valid != tc.Valid {
+				t.Fatalf("Expected ParseAddressPrefix to return %t not %t", tc.Valid, valid)
+			}
+
+			if valid && network.String() != tc.Expected {
+				t.Fatalf("Expected ParseAddressPrefix to return %q not %q", tc.Expected, network.String())
+			}
+		})
+	}
+}
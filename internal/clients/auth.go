@@ -5,9 +5,12 @@ package clients
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
+	"net/url"
 	"strings"
 
 	"github.com/hashicorp/go-azure-sdk/sdk/auth"
@@ -16,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients/graph"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+	"golang.org/x/oauth2"
 )
 
 type ResourceManagerAccount struct {
@@ -30,12 +34,7 @@ type ResourceManagerAccount struct {
 	RegisteredResourceProviders      resourceproviders.ResourceProviders
 }
 
-func NewResourceManagerAccount(ctx context.Context, config auth.Credentials, subscriptionId string, registeredResourceProviders resourceproviders.ResourceProviders) (*ResourceManagerAccount, error) {
-	authorizer, err := auth.NewAuthorizerFromCredentials(ctx, config, config.Environment.MicrosoftGraph)
-	if err != nil {
-		return nil, fmt.Errorf("unable to build authorizer for Microsoft Graph API: %+v", err)
-	}
-
+func NewResourceManagerAccount(ctx context.Context, config auth.Credentials, authorizer auth.Authorizer, subscriptionId string, registeredResourceProviders resourceproviders.ResourceProviders) (*ResourceManagerAccount, error) {
 	// Acquire an access token so we can inspect the claims
 	token, err := authorizer.Token(ctx, &http.Request{})
 	if err != nil {
@@ -142,3 +141,137 @@ func NewResourceManagerAccount(ctx context.Context, config auth.Credentials, sub
 
 	return &account, nil
 }
+
+// azureDevOpsPipelineOIDCApiVersion is the API Version used to request an ID Token for a Service Connection
+const azureDevOpsPipelineOIDCApiVersion = "7.1"
+
+// AzureDevOpsPipelineOIDCOptions configures authenticating using OpenID Connect from within an Azure DevOps Pipeline,
+// where an ID Token is requested for a Service Connection.
+type AzureDevOpsPipelineOIDCOptions struct {
+	// RequestURL is the URL used to request an ID Token, exposed within a Pipeline in the `SYSTEM_OIDCREQUESTURI` environment variable
+	RequestURL string
+
+	// RequestToken is the bearer token used to request an ID Token, exposed within a Pipeline in the `SYSTEM_ACCESSTOKEN` environment variable
+	RequestToken string
+
+	// ServiceConnectionID is the ID of the Service Connection to request an ID Token for
+	ServiceConnectionID string
+}
+
+// NewAuthorizer returns an Authorizer for the specified API - using the Azure DevOps Pipeline Service Connection when
+// one is specified, otherwise using the Credentials.
+func NewAuthorizer(ctx context.Context, config auth.Credentials, azureDevOpsPipelineOIDC *AzureDevOpsPipelineOIDCOptions, api environments.Api) (auth.Authorizer, error) {
+	if azureDevOpsPipelineOIDC != nil {
+		return NewAzureDevOpsPipelineOIDCAuthorizer(config, *azureDevOpsPipelineOIDC, api)
+	}
+
+	return auth.NewAuthorizerFromCredentials(ctx, config, api)
+}
+
+var _ auth.Authorizer = &AzureDevOpsPipelineOIDCAuthorizer{}
+
+// AzureDevOpsPipelineOIDCAuthorizer requests a new ID Token for the Service Connection each time an access token is
+// acquired, since the ID Token is only valid for a limited time and would otherwise expire during long-running operations.
+type AzureDevOpsPipelineOIDCAuthorizer struct {
+	api     environments.Api
+	config  auth.Credentials
+	options AzureDevOpsPipelineOIDCOptions
+}
+
+// NewAzureDevOpsPipelineOIDCAuthorizer returns an Authorizer which requests an ID Token for the Service Connection from
+// Azure DevOps, and then exchanges it for an access token using client assertion authentication.
+func NewAzureDevOpsPipelineOIDCAuthorizer(config auth.Credentials, options AzureDevOpsPipelineOIDCOptions, api environments.Api) (auth.Authorizer, error) {
+	if strings.TrimSpace(options.RequestURL) == "" {
+		return nil, fmt.Errorf("an OIDC Request URL must be specified to authenticate using an Azure DevOps Service Connection")
+	}
+	if strings.TrimSpace(options.RequestToken) == "" {
+		return nil, fmt.Errorf("an OIDC Request Token must be specified to authenticate using an Azure DevOps Service Connection")
+	}
+
+	return auth.NewCachedAuthorizer(&AzureDevOpsPipelineOIDCAuthorizer{
+		api:     api,
+		config:  config,
+		options: options,
+	})
+}
+
+func (a *AzureDevOpsPipelineOIDCAuthorizer) tokenSource(ctx context.Context) (auth.Authorizer, error) {
+	idToken, err := azureDevOpsPipelineOIDCToken(ctx, a.options)
+	if err != nil {
+		return nil, err
+	}
+
+	return auth.NewOIDCAuthorizer(ctx, auth.OIDCAuthorizerOptions{
+		Environment:        a.config.Environment,
+		Api:                a.api,
+		TenantId:           a.config.TenantID,
+		AuxiliaryTenantIds: a.config.AuxiliaryTenantIDs,
+		ClientId:           a.config.ClientID,
+		FederatedAssertion: *idToken,
+	})
+}
+
+func (a *AzureDevOpsPipelineOIDCAuthorizer) Token(ctx context.Context, req *http.Request) (*oauth2.Token, error) {
+	source, err := a.tokenSource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return source.Token(ctx, req)
+}
+
+func (a *AzureDevOpsPipelineOIDCAuthorizer) AuxiliaryTokens(ctx context.Context, req *http.Request) ([]*oauth2.Token, error) {
+	source, err := a.tokenSource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return source.AuxiliaryTokens(ctx, req)
+}
+
+// azureDevOpsPipelineOIDCToken requests an ID Token for the Service Connection from within an Azure DevOps Pipeline,
+// which can then be exchanged for an access token when authenticating using OpenID Connect.
+func azureDevOpsPipelineOIDCToken(ctx context.Context, options AzureDevOpsPipelineOIDCOptions) (*string, error) {
+	serviceConnectionId := options.ServiceConnectionID
+
+	endpoint, err := url.Parse(options.RequestURL)
+	if err != nil {
+		return nil, fmt.Errorf("parsing OIDC Request URL %q: %+v", options.RequestURL, err)
+	}
+	query := endpoint.Query()
+	query.Set("api-version", azureDevOpsPipelineOIDCApiVersion)
+	query.Set("serviceConnectionId", serviceConnectionId)
+	endpoint.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.String(), http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("building request for an ID Token for the Service Connection %q: %+v", serviceConnectionId, err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", options.RequestToken))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("requesting an ID Token for the Service Connection %q: %+v", serviceConnectionId, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading the ID Token response for the Service Connection %q: %+v", serviceConnectionId, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("requesting an ID Token for the Service Connection %q: unexpected status %d: %s", serviceConnectionId, resp.StatusCode, string(body))
+	}
+
+	var result struct {
+		OIDCToken string `json:"oidcToken"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("unmarshaling the ID Token response for the Service Connection %q: %+v", serviceConnectionId, err)
+	}
+	if result.OIDCToken == "" {
+		return nil, fmt.Errorf("the ID Token response for the Service Connection %q did not contain a token", serviceConnectionId)
+	}
+
+	return &result.OIDCToken, nil
+}
@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+func TestAzureDevOpsPipelineOIDCToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer system-access-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Query().Get("api-version") != azureDevOpsPipelineOIDCApiVersion {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		switch r.URL.Query().Get("serviceConnectionId") {
+		case "00000000-0000-0000-0000-000000000001":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"oidcToken": "id-token"}`))
+		case "00000000-0000-0000-0000-000000000002":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	testData := []struct {
+		name                string
+		requestUrl          string
+		requestToken        string
+		serviceConnectionId string
+		expected            string
+		expectError         bool
+	}{
+		{
+			name:                "valid",
+			requestUrl:          server.URL + "/project/_apis/distributedtask/hubs/build/plans/plan/jobs/job/oidctoken",
+			requestToken:        "system-access-token",
+			serviceConnectionId: "00000000-0000-0000-0000-000000000001",
+			expected:            "id-token",
+		},
+		{
+			name:                "no request url",
+			requestToken:        "system-access-token",
+			serviceConnectionId: "00000000-0000-0000-0000-000000000001",
+			expectError:         true,
+		},
+		{
+			name:                "no request token",
+			requestUrl:          server.URL,
+			serviceConnectionId: "00000000-0000-0000-0000-000000000001",
+			expectError:         true,
+		},
+		{
+			name:                "invalid request token",
+			requestUrl:          server.URL,
+			requestToken:        "other-token",
+			serviceConnectionId: "00000000-0000-0000-0000-000000000001",
+			expectError:         true,
+		},
+		{
+			name:                "unknown service connection",
+			requestUrl:          server.URL,
+			requestToken:        "system-access-token",
+			serviceConnectionId: "00000000-0000-0000-0000-000000000003",
+			expectError:         true,
+		},
+		{
+			name:                "no token returned",
+			requestUrl:          server.URL,
+			requestToken:        "system-access-token",
+			serviceConnectionId: "00000000-0000-0000-0000-000000000002",
+			expectError:         true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		options := AzureDevOpsPipelineOIDCOptions{
+			RequestURL:          v.requestUrl,
+			RequestToken:        v.requestToken,
+			ServiceConnectionID: v.serviceConnectionId,
+		}
+		actual, err := azureDevOpsPipelineOIDCToken(context.TODO(), options)
+		if err != nil {
+			if v.expectError {
+				continue
+			}
+
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if v.expectError {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if *actual != v.expected {
+			t.Fatalf("expected %q but got %q", v.expected, *actual)
+		}
+	}
+}
+
+func TestAzureDevOpsPipelineOIDCAuthorizer(t *testing.T) {
+	idTokensIssued := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oidctoken":
+			if r.Header.Get("Authorization") != "Bearer system-access-token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			idTokensIssued++
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(fmt.Sprintf(`{"oidcToken": "id-token-%d"}`, idTokensIssued)))
+
+		case "/00000000-0000-0000-0000-000000000000/oauth2/v2.0/token":
+			if err := r.ParseForm(); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			// the ID Token exchanged for an access token should be the one most recently issued
+			if r.PostForm.Get("client_assertion") != fmt.Sprintf("id-token-%d", idTokensIssued) {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(fmt.Sprintf(`{"access_token": "access-token-%d", "token_type": "Bearer", "expires_in": "60"}`, idTokensIssued)))
+
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	env := environments.AzurePublic()
+	env.Authorization.LoginEndpoint = server.URL
+
+	config := auth.Credentials{
+		Environment: *env,
+		ClientID:    "11111111-1111-1111-1111-111111111111",
+		TenantID:    "00000000-0000-0000-0000-000000000000",
+	}
+
+	if _, err := NewAzureDevOpsPipelineOIDCAuthorizer(config, AzureDevOpsPipelineOIDCOptions{ServiceConnectionID: "00000000-0000-0000-0000-000000000001"}, env.ResourceManager); err == nil {
+		t.Fatalf("expected an error when no Request URL or Request Token are specified but didn't get one")
+	}
+
+	options := AzureDevOpsPipelineOIDCOptions{
+		RequestURL:          server.URL + "/oidctoken",
+		RequestToken:        "system-access-token",
+		ServiceConnectionID: "00000000-0000-0000-0000-000000000001",
+	}
+	authorizer, err := NewAzureDevOpsPipelineOIDCAuthorizer(config, options, env.ResourceManager)
+	if err != nil {
+		t.Fatalf("building authorizer: %+v", err)
+	}
+
+	// the access token expires within the renewal window, so each acquisition should request a new ID Token
+	for i := 1; i <= 2; i++ {
+		token, err := authorizer.Token(context.TODO(), &http.Request{})
+		if err != nil {
+			t.Fatalf("acquiring access token %d: %+v", i, err)
+		}
+		if expected := fmt.Sprintf("access-token-%d", i); token.AccessToken != expected {
+			t.Fatalf("expected %q but got %q", expected, token.AccessToken)
+		}
+	}
+
+	if idTokensIssued != 2 {
+		t.Fatalf("expected 2 ID Tokens to be requested but got %d", idTokensIssued)
+	}
+}
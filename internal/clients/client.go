@@ -6,6 +6,7 @@ package clients
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/validation"
@@ -24,6 +25,7 @@ import (
 	systemcentervirtualmachinemanager_2023_10_07 "github.com/hashicorp/go-azure-sdk/resource-manager/systemcentervirtualmachinemanager/2023-10-07"
 	timeseriesinsights_v2020_05_15 "github.com/hashicorp/go-azure-sdk/resource-manager/timeseriesinsights/2020-05-15"
 	workloads_v2023_04_01 "github.com/hashicorp/go-azure-sdk/resource-manager/workloads/2023-04-01"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients/resourcegraph"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	aadb2c "github.com/hashicorp/terraform-provider-azurerm/internal/services/aadb2c/client"
@@ -163,6 +165,13 @@ type Client struct {
 	Account  *ResourceManagerAccount
 	Features features.UserFeatures
 
+	// options are the ClientOptions used to build this Client, which are reused when building
+	// Clients for other Subscriptions
+	options *common.ClientOptions
+
+	// subscriptionClients is a cache of the Clients for other Subscriptions, shared by all of the Clients built from the same Provider
+	subscriptionClients *subscriptionClientCache
+
 	AadB2c                            *aadb2c_v2021_04_01_preview.Client
 	Advisor                           *advisor.Client
 	AnalysisServices                  *analysisservices_v2017_08_01.Client
@@ -266,6 +275,7 @@ type Client struct {
 	RedisEnterprise                   *redisenterprise.Client
 	Relay                             *relay.Client
 	Resource                          *resource.Client
+	ResourceGraph                     *resourcegraph.Client
 	Search                            *search.Client
 	SecurityCenter                    *securityCenter.Client
 	Sentinel                          *sentinel.Client
@@ -304,6 +314,16 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 
 	client.Features = o.Features
 	client.StopContext = ctx
+	client.options = o
+	if client.subscriptionClients == nil {
+		client.subscriptionClients = &subscriptionClientCache{
+			clients: map[string]*subscriptionClientEntry{
+				strings.ToLower(o.SubscriptionId): {
+					client: client,
+				},
+			},
+		}
+	}
 
 	var err error
 
@@ -610,6 +630,9 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	if client.Resource, err = resource.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for Resource: %+v", err)
 	}
+	if client.ResourceGraph, err = resourcegraph.NewClient(o); err != nil {
+		return fmt.Errorf("building clients for ResourceGraph: %+v", err)
+	}
 	if client.Search, err = search.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for Search: %+v", err)
 	}
@@ -0,0 +1,295 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+// metadataFile is the format of the file used to define the Environment. This is a superset of the response from the
+// Azure Metadata Service (e.g. `https://management.azure.com/metadata/endpoints?api-version=2022-09-01`), where the
+// `apis` block allows any API within the Environment to be defined (or overridden) - since the Metadata Service
+// doesn't return the details for all of the APIs used by the Provider.
+type metadataFile struct {
+	Name           string `json:"name"`
+	Authentication struct {
+		Audiences        []string `json:"audiences"`
+		IdentityProvider string   `json:"identityProvider"`
+		LoginEndpoint    string   `json:"loginEndpoint"`
+		Tenant           string   `json:"tenant"`
+	} `json:"authentication"`
+	Suffixes struct {
+		AcrLoginServer               string `json:"acrLoginServer"`
+		AttestationEndpoint          string `json:"attestationEndpoint"`
+		AzureDataLakeStoreFileSystem string `json:"azureDataLakeStoreFileSystem"`
+		AzureFrontDoorEndpointSuffix string `json:"azureFrontDoorEndpointSuffix"`
+		KeyVaultDns                  string `json:"keyVaultDns"`
+		MariadbServerEndpoint        string `json:"mariadbServerEndpoint"`
+		MhsmDns                      string `json:"mhsmDns"`
+		MysqlServerEndpoint          string `json:"mysqlServerEndpoint"`
+		PostgresqlServerEndpoint     string `json:"postgresqlServerEndpoint"`
+		SqlServerHostname            string `json:"sqlServerHostname"`
+		Storage                      string `json:"storage"`
+		StorageSyncEndpointSuffix    string `json:"storageSyncEndpointSuffix"`
+		SynapseAnalytics             string `json:"synapseAnalytics"`
+	} `json:"suffixes"`
+	ActiveDirectoryDataLake    string `json:"activeDirectoryDataLake"`
+	AttestationResourceId      string `json:"attestationResourceId"`
+	Batch                      string `json:"batch"`
+	LogAnalyticsResourceId     string `json:"logAnalyticsResourceId"`
+	MicrosoftGraphResourceId   string `json:"microsoftGraphResourceId"`
+	OssrDbmsResourceId         string `json:"ossrDbmsResourceId"`
+	ResourceManager            string `json:"resourceManager"`
+	SynapseAnalyticsResourceId string `json:"synapseAnalyticsResourceId"`
+
+	// Apis is a map of the name of the API within the Environment (e.g. `keyVault`) to its definition
+	Apis map[string]metadataFileApi `json:"apis"`
+}
+
+type metadataFileApi struct {
+	AppId              *string `json:"appId"`
+	DomainSuffix       *string `json:"domainSuffix"`
+	Endpoint           *string `json:"endpoint"`
+	ResourceIdentifier *string `json:"resourceIdentifier"`
+}
+
+// EnvironmentFromMetadataFile loads the Environment from a local file - which allows the Environment to be configured
+// where the Azure Metadata Service isn't reachable, such as within an air-gapped network.
+//
+// The file can either contain the response from the Azure Metadata Service, or define each API within the Environment
+// using the `apis` block - or a combination of both, where the `apis` block takes precedence.
+func EnvironmentFromMetadataFile(path string) (*environments.Environment, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading Metadata from file %q: %+v", path, err)
+	}
+
+	// Trim away a BOM if present
+	contents = bytes.TrimPrefix(contents, []byte("\xef\xbb\xbf"))
+
+	var metadata metadataFile
+	if err := json.Unmarshal(contents, &metadata); err != nil {
+		return nil, fmt.Errorf("parsing Metadata from file %q: %+v", path, err)
+	}
+
+	env, err := environmentFromMetadata(metadata)
+	if err != nil {
+		return nil, fmt.Errorf("loading Environment from Metadata file %q: %+v", path, err)
+	}
+
+	return env, nil
+}
+
+func environmentFromMetadata(input metadataFile) (*environments.Environment, error) {
+	if input.Name == "" {
+		return nil, fmt.Errorf("`name` must be specified")
+	}
+
+	env := environments.Environment{
+		Name: input.Name,
+		Authorization: &environments.Authorization{
+			Audiences:        input.Authentication.Audiences,
+			IdentityProvider: input.Authentication.IdentityProvider,
+			LoginEndpoint:    input.Authentication.LoginEndpoint,
+			Tenant:           input.Authentication.Tenant,
+		},
+	}
+
+	// the Application IDs are common to all Environments, as such each API starts out with only the Application ID
+	// (taken from Azure Public) - meaning that it's unavailable unless it's defined within the file
+	apis := environmentApis(&env)
+	public := environmentApis(environments.AzurePublic())
+	for name, api := range apis {
+		definition := apiDefinition{
+			name: name,
+		}
+		if existing := *public[name]; existing != nil {
+			definition.name = existing.Name()
+			definition.appId, _ = existing.AppId()
+		}
+		*api = definition
+	}
+
+	applyMetadataServiceResponse(&env, input)
+
+	names := make([]string, 0, len(input.Apis))
+	for name := range input.Apis {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		api, ok := apis[strings.ToLower(name)]
+		if !ok {
+			return nil, fmt.Errorf("the API %q defined within `apis` is not supported", name)
+		}
+		*api = definitionForApi(*api).merge(input.Apis[name])
+	}
+
+	if endpoint, ok := env.ResourceManager.Endpoint(); !ok || *endpoint == "" {
+		return nil, fmt.Errorf("an endpoint for the Resource Manager API must be specified, using either `resourceManager` or `apis.resourceManager.endpoint`")
+	}
+	if resourceId, ok := env.MicrosoftGraph.ResourceIdentifier(); !ok || *resourceId == "" {
+		return nil, fmt.Errorf("a resource identifier for the Microsoft Graph API must be specified, using either `microsoftGraphResourceId` or `apis.microsoftGraph.resourceIdentifier`")
+	}
+
+	return &env, nil
+}
+
+// applyMetadataServiceResponse configures the APIs which are returned from the Azure Metadata Service, matching how
+// the Environment is configured when using `metadata_host`
+func applyMetadataServiceResponse(env *environments.Environment, input metadataFile) {
+	normalizeResourceId := func(input string) string {
+		return strings.TrimRight(input, "/")
+	}
+	suffixes := input.Suffixes
+
+	if suffixes.AttestationEndpoint != "" && input.AttestationResourceId != "" {
+		env.Attestation = environments.AttestationAPI(normalizeResourceId(input.AttestationResourceId), suffixes.AttestationEndpoint)
+	}
+	if input.Batch != "" {
+		env.Batch = environments.BatchAPI(normalizeResourceId(input.Batch))
+	}
+	if suffixes.AzureFrontDoorEndpointSuffix != "" {
+		env.CDNFrontDoor = environments.CDNFrontDoorAPI(suffixes.AzureFrontDoorEndpointSuffix)
+	}
+	if suffixes.AcrLoginServer != "" {
+		env.ContainerRegistry = environments.ContainerRegistryAPI(suffixes.AcrLoginServer)
+	}
+	if suffixes.AzureDataLakeStoreFileSystem != "" && input.ActiveDirectoryDataLake != "" {
+		env.DataLake = environments.DataLakeAPI(suffixes.AzureDataLakeStoreFileSystem).WithResourceIdentifier(normalizeResourceId(input.ActiveDirectoryDataLake))
+	}
+	if suffixes.KeyVaultDns != "" {
+		// the Key Vault resource identifier isn't returned, so this is a best-effort guess from the domain suffix
+		env.KeyVault = environments.KeyVaultAPI(suffixes.KeyVaultDns).WithResourceIdentifier(fmt.Sprintf("https://%s", suffixes.KeyVaultDns))
+	}
+	if suffixes.MhsmDns != "" {
+		// the Managed HSM resource identifier isn't returned, so this is a best-effort guess from the domain suffix
+		endpoint := fmt.Sprintf("https://%s", suffixes.MhsmDns)
+		env.ManagedHSM = environments.ManagedHSMAPI(endpoint, suffixes.MhsmDns).WithResourceIdentifier(endpoint)
+	}
+	if suffixes.MariadbServerEndpoint != "" && input.OssrDbmsResourceId != "" {
+		env.MariaDB = environments.MariaDBAPI(suffixes.MariadbServerEndpoint).WithResourceIdentifier(normalizeResourceId(input.OssrDbmsResourceId))
+	}
+	if input.MicrosoftGraphResourceId != "" {
+		env.MicrosoftGraph = environments.MicrosoftGraphAPI(normalizeResourceId(input.MicrosoftGraphResourceId))
+	}
+	if suffixes.MysqlServerEndpoint != "" && input.OssrDbmsResourceId != "" {
+		env.MySql = environments.MySqlAPI(suffixes.MysqlServerEndpoint).WithResourceIdentifier(normalizeResourceId(input.OssrDbmsResourceId))
+	}
+	if input.LogAnalyticsResourceId != "" {
+		env.OperationalInsights = environments.OperationalInsightsAPI().WithResourceIdentifier(normalizeResourceId(input.LogAnalyticsResourceId))
+	}
+	if suffixes.PostgresqlServerEndpoint != "" && input.OssrDbmsResourceId != "" {
+		env.Postgresql = environments.PostgresqlAPI(suffixes.PostgresqlServerEndpoint).WithResourceIdentifier(normalizeResourceId(input.OssrDbmsResourceId))
+	}
+	if input.ResourceManager != "" {
+		env.ResourceManager = environments.ResourceManagerAPI(input.ResourceManager)
+	}
+	if suffixes.SqlServerHostname != "" {
+		// the SQL resource identifier isn't returned, so this is a best-effort guess from the domain suffix
+		env.Sql = environments.SqlAPI(suffixes.SqlServerHostname).WithResourceIdentifier(fmt.Sprintf("https://%s", suffixes.SqlServerHostname))
+	}
+	if suffixes.Storage != "" {
+		env.Storage = environments.StorageAPI(suffixes.Storage)
+	}
+	if suffixes.StorageSyncEndpointSuffix != "" {
+		env.StorageSync = environments.StorageSyncAPI(suffixes.StorageSyncEndpointSuffix)
+	}
+	if suffixes.SynapseAnalytics != "" && input.SynapseAnalyticsResourceId != "" {
+		env.Synapse = environments.SynapseAPI(suffixes.SynapseAnalytics).WithResourceIdentifier(normalizeResourceId(input.SynapseAnalyticsResourceId))
+	}
+}
+
+// environmentApis returns a map of the (lower-cased) name of each API field within the Environment to the field
+func environmentApis(env *environments.Environment) map[string]*environments.Api {
+	apiType := reflect.TypeOf((*environments.Api)(nil)).Elem()
+
+	output := make(map[string]*environments.Api)
+	value := reflect.ValueOf(env).Elem()
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if field.Type != apiType {
+			continue
+		}
+		output[strings.ToLower(field.Name)] = value.Field(i).Addr().Interface().(*environments.Api)
+	}
+
+	return output
+}
+
+var _ environments.Api = apiDefinition{}
+
+// apiDefinition is an API within the Environment, which (unlike environments.ApiEndpoint) allows each value to be set
+type apiDefinition struct {
+	name               string
+	appId              *string
+	domainSuffix       *string
+	endpoint           *string
+	resourceIdentifier *string
+}
+
+func definitionForApi(input environments.Api) apiDefinition {
+	output := apiDefinition{
+		name: input.Name(),
+	}
+	output.appId, _ = input.AppId()
+	output.domainSuffix, _ = input.DomainSuffix()
+	output.endpoint, _ = input.Endpoint()
+	output.resourceIdentifier, _ = input.ResourceIdentifier()
+	return output
+}
+
+func (a apiDefinition) merge(input metadataFileApi) apiDefinition {
+	if input.AppId != nil {
+		a.appId = input.AppId
+	}
+	if input.DomainSuffix != nil {
+		a.domainSuffix = input.DomainSuffix
+	}
+	if input.Endpoint != nil {
+		a.endpoint = input.Endpoint
+	}
+	if input.ResourceIdentifier != nil {
+		a.resourceIdentifier = input.ResourceIdentifier
+	}
+	return a
+}
+
+func (a apiDefinition) AppId() (*string, bool) {
+	return a.appId, a.appId != nil
+}
+
+func (a apiDefinition) Available() bool {
+	return a.endpoint != nil || a.resourceIdentifier != nil
+}
+
+func (a apiDefinition) DomainSuffix() (*string, bool) {
+	return a.domainSuffix, a.domainSuffix != nil
+}
+
+func (a apiDefinition) Endpoint() (*string, bool) {
+	return a.endpoint, a.endpoint != nil
+}
+
+func (a apiDefinition) Name() string {
+	return a.name
+}
+
+func (a apiDefinition) ResourceIdentifier() (*string, bool) {
+	return a.resourceIdentifier, a.resourceIdentifier != nil
+}
+
+func (a apiDefinition) WithResourceIdentifier(identifier string) environments.Api {
+	a.resourceIdentifier = pointer.To(identifier)
+	return a
+}
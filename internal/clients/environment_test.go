@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+func TestEnvironmentFromMetadataFile(t *testing.T) {
+	env, err := EnvironmentFromMetadataFile(filepath.Join("testdata", "metadata.json"))
+	if err != nil {
+		t.Fatalf("loading Environment: %+v", err)
+	}
+
+	if env.Name != "AzureStackUser" {
+		t.Fatalf("expected the name to be %q but got %q", "AzureStackUser", env.Name)
+	}
+	if !env.IsAzureStack() {
+		t.Fatalf("expected the Environment to be an Azure Stack Environment")
+	}
+	if env.Authorization == nil || env.Authorization.LoginEndpoint != "https://adfs.local.azurestack.external/adfs" {
+		t.Fatalf("expected the Login Endpoint to be %q but got %+v", "https://adfs.local.azurestack.external/adfs", env.Authorization)
+	}
+
+	endpoint, ok := env.ResourceManager.Endpoint()
+	if !ok || *endpoint != "https://management.local.azurestack.external/" {
+		t.Fatalf("expected the Resource Manager endpoint to be %q but got %v", "https://management.local.azurestack.external/", endpoint)
+	}
+	suffix, ok := env.KeyVault.DomainSuffix()
+	if !ok || *suffix != "vault.local.azurestack.external" {
+		t.Fatalf("expected the Key Vault domain suffix to be %q but got %v", "vault.local.azurestack.external", suffix)
+	}
+	suffix, ok = env.Storage.DomainSuffix()
+	if !ok || *suffix != "local.azurestack.external" {
+		t.Fatalf("expected the Storage domain suffix to be %q but got %v", "local.azurestack.external", suffix)
+	}
+}
+
+func TestEnvironmentFromMetadataFileApis(t *testing.T) {
+	env, err := EnvironmentFromMetadataFile(filepath.Join("testdata", "environment.json"))
+	if err != nil {
+		t.Fatalf("loading Environment: %+v", err)
+	}
+
+	if env.Name != "Disconnected" {
+		t.Fatalf("expected the name to be %q but got %q", "Disconnected", env.Name)
+	}
+	if env.IsAzureStack() {
+		t.Fatalf("expected the Environment not to be an Azure Stack Environment")
+	}
+
+	endpoint, ok := env.ResourceManager.Endpoint()
+	if !ok || *endpoint != "https://management.disconnected.example.com/" {
+		t.Fatalf("expected the Resource Manager endpoint to be %q but got %v", "https://management.disconnected.example.com/", endpoint)
+	}
+	resourceId, ok := env.MicrosoftGraph.ResourceIdentifier()
+	if !ok || *resourceId != "https://graph.disconnected.example.com" {
+		t.Fatalf("expected the Microsoft Graph resource identifier to be %q but got %v", "https://graph.disconnected.example.com", resourceId)
+	}
+	suffix, ok := env.CosmosDB.DomainSuffix()
+	if !ok || *suffix != "documents.disconnected.example.com" {
+		t.Fatalf("expected the CosmosDB domain suffix to be %q but got %v", "documents.disconnected.example.com", suffix)
+	}
+	endpoint, ok = env.ServiceBus.Endpoint()
+	if !ok || *endpoint != "https://servicebus.disconnected.example.com" {
+		t.Fatalf("expected the Service Bus endpoint to be %q but got %v", "https://servicebus.disconnected.example.com", endpoint)
+	}
+
+	// the Application ID is common to all Environments, so should be set even though it's not in the file
+	expectedAppId, _ := environments.AzurePublic().KeyVault.AppId()
+	appId, ok := env.KeyVault.AppId()
+	if !ok || *appId != *expectedAppId {
+		t.Fatalf("expected the Key Vault Application ID to be %q but got %v", *expectedAppId, appId)
+	}
+
+	// APIs which aren't defined within the file should be unavailable, rather than using the Azure Public endpoints
+	if env.Batch.Available() {
+		t.Fatalf("expected the Batch API to be unavailable")
+	}
+	if _, ok := env.AppConfiguration.DomainSuffix(); ok {
+		t.Fatalf("expected no domain suffix for the App Configuration API")
+	}
+}
+
+func TestEnvironmentFromMetadataFileInvalid(t *testing.T) {
+	directory := t.TempDir()
+
+	if _, err := EnvironmentFromMetadataFile(filepath.Join(directory, "missing.json")); err == nil {
+		t.Fatalf("expected an error for a missing file but didn't get one")
+	}
+
+	invalid := filepath.Join(directory, "invalid.json")
+	if err := os.WriteFile(invalid, []byte("not json"), 0o600); err != nil {
+		t.Fatalf("writing file: %+v", err)
+	}
+	if _, err := EnvironmentFromMetadataFile(invalid); err == nil {
+		t.Fatalf("expected an error for an invalid file but didn't get one")
+	}
+
+	// the Resource Manager endpoint is required
+	incomplete := filepath.Join(directory, "incomplete.json")
+	if err := os.WriteFile(incomplete, []byte(`{"name": "Example", "microsoftGraphResourceId": "https://graph.example.com/"}`), 0o600); err != nil {
+		t.Fatalf("writing file: %+v", err)
+	}
+	if _, err := EnvironmentFromMetadataFile(incomplete); err == nil {
+		t.Fatalf("expected an error for an incomplete file but didn't get one")
+	}
+
+	unknown := filepath.Join(directory, "unknown.json")
+	if err := os.WriteFile(unknown, []byte(`{"name": "Example", "resourceManager": "https://management.example.com/", "microsoftGraphResourceId": "https://graph.example.com/", "apis": {"doesNotExist": {"endpoint": "https://example.com"}}}`), 0o600); err != nil {
+		t.Fatalf("writing file: %+v", err)
+	}
+	if _, err := EnvironmentFromMetadataFile(unknown); err == nil {
+		t.Fatalf("expected an error for an unknown API but didn't get one")
+	}
+}
@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegraph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+const apiVersion = "2021-03-01"
+
+// pageSize is the maximum number of Resources returned by Resource Graph in a single page
+const pageSize = 1000
+
+// Client retrieves Resources from Azure Resource Graph, which allows the Resources of a given Resource Type within
+// the Subscription to be retrieved in bulk (rather than retrieving each Resource individually).
+//
+// The Resources of each Resource Type are retrieved the first time they're requested, and then cached as a snapshot
+// for the lifetime of the Client - as such Resources which have been created or modified within this Client should
+// be retrieved from the API directly, since the snapshot (and Resource Graph itself) may be stale.
+type Client struct {
+	client         *resourcemanager.Client
+	subscriptionId string
+
+	lock      sync.Mutex
+	snapshots map[string]*snapshot
+	modified  map[string]struct{}
+}
+
+type snapshot struct {
+	once      sync.Once
+	err       error
+	resources map[string]json.RawMessage
+}
+
+type queryRequest struct {
+	Subscriptions []string     `json:"subscriptions"`
+	Query         string       `json:"query"`
+	Options       queryOptions `json:"options"`
+}
+
+type queryOptions struct {
+	ResultFormat string  `json:"resultFormat"`
+	SkipToken    *string `json:"$skipToken,omitempty"`
+	Top          int     `json:"$top"`
+}
+
+type queryResponse struct {
+	Data      []json.RawMessage `json:"data"`
+	SkipToken *string           `json:"$skipToken,omitempty"`
+}
+
+type resourceModel struct {
+	Id string `json:"id"`
+}
+
+func NewClient(o *common.ClientOptions) (*Client, error) {
+	resourceManagerClient, err := resourcemanager.NewClient(o.Environment.ResourceManager, "resourcegraph", apiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("building Resource Graph client: %+v", err)
+	}
+	o.Configure(resourceManagerClient, o.Authorizers.ResourceManager)
+
+	return &Client{
+		client:         resourceManagerClient,
+		subscriptionId: o.SubscriptionId,
+		snapshots:      map[string]*snapshot{},
+		modified:       map[string]struct{}{},
+	}, nil
+}
+
+// Get returns the representation of the Resource with the specified Resource ID from the snapshot of the Resources
+// of this Resource Type within the Subscription, which is retrieved from Resource Graph the first time a Resource of
+// this Resource Type is requested.
+//
+// false is returned when the Resource isn't present in the snapshot, when the Resource has been modified by this
+// Client, or when the Resource isn't supported by Resource Graph - in which case the Resource should be retrieved
+// from the API directly.
+func (c *Client) Get(ctx context.Context, resourceId string) (json.RawMessage, bool) {
+	resourceType, ok := resourceTypeForResourceId(resourceId)
+	if !ok {
+		return nil, false
+	}
+
+	key := strings.ToLower(resourceId)
+
+	c.lock.Lock()
+	if _, ok := c.modified[key]; ok {
+		c.lock.Unlock()
+		return nil, false
+	}
+	existing, ok := c.snapshots[resourceType]
+	if !ok {
+		existing = &snapshot{}
+		c.snapshots[resourceType] = existing
+	}
+	c.lock.Unlock()
+
+	existing.once.Do(func() {
+		existing.resources, existing.err = c.listResources(ctx, resourceType)
+	})
+	if existing.err != nil {
+		// falling back to retrieving the Resource directly, rather than failing the Read
+		log.Printf("[WARN] Unable to retrieve the Resources of the type %q from Resource Graph: %+v", resourceType, existing.err)
+		return nil, false
+	}
+
+	v, ok := existing.resources[key]
+	return v, ok
+}
+
+// MarkAsModified records that the Resource with the specified Resource ID has been created, modified or deleted by
+// this Client, such that it's no longer returned from the snapshot.
+func (c *Client) MarkAsModified(resourceId string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.modified[strings.ToLower(resourceId)] = struct{}{}
+}
+
+func (c *Client) listResources(ctx context.Context, resourceType string) (map[string]json.RawMessage, error) {
+	log.Printf("[DEBUG] Retrieving the Resources of the type %q within the Subscription %q from Resource Graph", resourceType, c.subscriptionId)
+
+	output := make(map[string]json.RawMessage)
+	request := queryRequest{
+		Subscriptions: []string{c.subscriptionId},
+		Query:         fmt.Sprintf("Resources | where type =~ '%s'", resourceType),
+		Options: queryOptions{
+			ResultFormat: "objectArray",
+			Top:          pageSize,
+		},
+	}
+
+	for {
+		result, err := c.query(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range result.Data {
+			var resource resourceModel
+			if err := json.Unmarshal(item, &resource); err != nil {
+				return nil, fmt.Errorf("unmarshaling Resource: %+v", err)
+			}
+			output[strings.ToLower(resource.Id)] = item
+		}
+
+		if result.SkipToken == nil || *result.SkipToken == "" {
+			break
+		}
+		request.Options.SkipToken = result.SkipToken
+	}
+
+	log.Printf("[DEBUG] Retrieved %d Resources of the type %q from Resource Graph", len(output), resourceType)
+
+	return output, nil
+}
+
+func (c *Client) query(ctx context.Context, input queryRequest) (*queryResponse, error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPost,
+		Path:       "/providers/Microsoft.ResourceGraph/resources",
+	}
+
+	req, err := c.client.NewRequest(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("building request: %+v", err)
+	}
+
+	if err := req.Marshal(input); err != nil {
+		return nil, fmt.Errorf("marshaling request: %+v", err)
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("querying Resource Graph: %+v", err)
+	}
+
+	var result queryResponse
+	if err := resp.Unmarshal(&result); err != nil {
+		return nil, fmt.Errorf("unmarshaling response: %+v", err)
+	}
+
+	return &result, nil
+}
+
+// resourceTypeForResourceId returns the (lower-cased) Resource Type for the specified Resource ID, for example
+// `microsoft.network/virtualnetworks` - only top-level Resources within a Resource Group are supported, since
+// these are the Resources available within the `Resources` table in Resource Graph.
+func resourceTypeForResourceId(input string) (string, bool) {
+	segments := strings.Split(strings.Trim(input, "/"), "/")
+
+	// e.g. subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{namespace}/{type}/{name}
+	if len(segments) != 8 {
+		return "", false
+	}
+	if !strings.EqualFold(segments[0], "subscriptions") || !strings.EqualFold(segments[2], "resourceGroups") || !strings.EqualFold(segments[4], "providers") {
+		return "", false
+	}
+
+	return strings.ToLower(fmt.Sprintf("%s/%s", segments[5], segments[6])), true
+}
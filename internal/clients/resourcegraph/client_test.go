@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegraph
+
+import "testing"
+
+func TestResourceTypeForResourceId(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected string
+		valid    bool
+	}{
+		{
+			input: "",
+		},
+		{
+			// Resource Group
+			input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example",
+		},
+		{
+			input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/network1",
+			expected: "microsoft.network/virtualnetworks",
+			valid:    true,
+		},
+		{
+			input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/example/providers/Microsoft.OperationalInsights/queryPacks/pack1",
+			expected: "microsoft.operationalinsights/querypacks",
+			valid:    true,
+		},
+		{
+			// nested Resource
+			input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+		},
+		{
+			// Subscription-level Resource
+			input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Resources/deployments/deployment1/extra/segments",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.input)
+
+		actual, valid := resourceTypeForResourceId(v.input)
+		if valid != v.valid {
+			t.Fatalf("expected valid to be %t but got %t", v.valid, valid)
+		}
+		if actual != v.expected {
+			t.Fatalf("expected %q but got %q", v.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+)
+
+type subscriptionClientCache struct {
+	clients map[string]*subscriptionClientEntry
+	lock    sync.Mutex
+}
+
+// subscriptionClientEntry is the cached Client for a single Subscription. The lock is held whilst the Client is
+// built, so that concurrent requests for the same Subscription share a single Client without blocking requests
+// for other Subscriptions - if building the Client fails it's left unset so that it can be retried.
+type subscriptionClientEntry struct {
+	client *Client
+	lock   sync.Mutex
+}
+
+// entryFor returns the cache entry for the specified Subscription, adding an (empty) entry if one doesn't exist
+func (cache *subscriptionClientCache) entryFor(subscriptionId string) *subscriptionClientEntry {
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+
+	key := strings.ToLower(subscriptionId)
+	entry, ok := cache.clients[key]
+	if !ok {
+		entry = &subscriptionClientEntry{}
+		cache.clients[key] = entry
+	}
+
+	return entry
+}
+
+// ForSubscription returns a Client scoped to the specified Subscription, using the same credentials
+// (and Authorizers) as this Client. Clients for other Subscriptions are built (and the required
+// Resource Providers registered) the first time they're requested, and then cached for subsequent use.
+func (client *Client) ForSubscription(subscriptionId string) (*Client, error) {
+	if subscriptionId == "" || strings.EqualFold(subscriptionId, client.Account.SubscriptionId) {
+		return client, nil
+	}
+
+	if client.options == nil || client.subscriptionClients == nil {
+		return nil, fmt.Errorf("internal-error: the Client has not been built")
+	}
+
+	cache := client.subscriptionClients
+	entry := cache.entryFor(subscriptionId)
+	entry.lock.Lock()
+	defer entry.lock.Unlock()
+
+	if entry.client != nil {
+		return entry.client, nil
+	}
+
+	log.Printf("[DEBUG] Building Client for Subscription %q", subscriptionId)
+
+	account := *client.Account
+	account.SubscriptionId = subscriptionId
+
+	options := *client.options
+	options.SubscriptionId = subscriptionId
+
+	subscriptionClient := &Client{
+		Account:             &account,
+		subscriptionClients: cache,
+	}
+	if err := subscriptionClient.Build(client.StopContext, &options); err != nil {
+		return nil, fmt.Errorf("building Client for Subscription %q: %+v", subscriptionId, err)
+	}
+
+	if !options.SkipProviderReg {
+		ctx, cancel := context.WithTimeout(client.StopContext, 30*time.Minute)
+		defer cancel()
+
+		id := commonids.NewSubscriptionID(subscriptionId)
+		if err := resourceproviders.EnsureRegistered(ctx, subscriptionClient.Resource.ResourceProvidersClient, id, account.RegisteredResourceProviders); err != nil {
+			return nil, fmt.Errorf("registering Resource Providers for %s: %+v", id, err)
+		}
+	}
+
+	entry.client = subscriptionClient
+
+	return subscriptionClient, nil
+}
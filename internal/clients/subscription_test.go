@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSubscriptionClientCacheEntryFor(t *testing.T) {
+	cache := &subscriptionClientCache{
+		clients: map[string]*subscriptionClientEntry{},
+	}
+
+	first := cache.entryFor("aaaaaaaa-1111-1111-1111-111111111111")
+	if second := cache.entryFor("aaaaaaaa-1111-1111-1111-111111111111"); first != second {
+		t.Fatalf("expected the same entry to be returned for the same Subscription")
+	}
+	if upper := cache.entryFor("AAAAAAAA-1111-1111-1111-111111111111"); first != upper {
+		t.Fatalf("expected the same entry to be returned regardless of casing")
+	}
+
+	// whilst the Client for one Subscription is being built, the entry for another Subscription shouldn't be blocked
+	first.lock.Lock()
+	defer first.lock.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		other := cache.entryFor("22222222-2222-2222-2222-222222222222")
+		other.lock.Lock()
+		other.lock.Unlock()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for the entry for another Subscription")
+	}
+
+	if other := cache.entryFor("22222222-2222-2222-2222-222222222222"); other == first {
+		t.Fatalf("expected a different entry for another Subscription")
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+// ArmError is an error returned from Azure Resource Manager, which optionally references the field within the
+// request that the error relates to (the Target) and/or contains further details about the error
+type ArmError struct {
+	Code    string     `json:"code"`
+	Message string     `json:"message"`
+	Target  string     `json:"target"`
+	Details []ArmError `json:"details"`
+}
+
+type armErrorRecorderKey struct{}
+
+type armErrorRecorder struct {
+	lock sync.Mutex
+	last *ArmError
+}
+
+// WithArmErrorRecorder returns a Context which records the errors returned from Azure Resource Manager for requests
+// made using this Context, the most recent of which can be retrieved using LastArmError
+func WithArmErrorRecorder(ctx context.Context) context.Context {
+	return context.WithValue(ctx, armErrorRecorderKey{}, &armErrorRecorder{})
+}
+
+// LastArmError returns the most recent error returned from Azure Resource Manager for a request made using this
+// Context, or nil if no error has been returned (or this Context wasn't built using WithArmErrorRecorder)
+func LastArmError(ctx context.Context) *ArmError {
+	recorder, ok := ctx.Value(armErrorRecorderKey{}).(*armErrorRecorder)
+	if !ok {
+		return nil
+	}
+
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+	return recorder.last
+}
+
+// ParseArmError parses an error returned from Azure Resource Manager, which is either wrapped in an `error` object
+// or returned at the top-level of the response body - returning nil if this isn't an error from Azure Resource Manager
+func ParseArmError(body []byte) *ArmError {
+	var wrapped struct {
+		Error *ArmError `json:"error"`
+	}
+	if err := json.Unmarshal(body, &wrapped); err == nil && wrapped.Error != nil && wrapped.Error.Code != "" {
+		return wrapped.Error
+	}
+
+	var unwrapped ArmError
+	if err := json.Unmarshal(body, &unwrapped); err == nil && unwrapped.Code != "" {
+		return &unwrapped
+	}
+
+	return nil
+}
+
+func armErrorRecorderMiddleware() client.ResponseMiddleware {
+	return func(request *http.Request, response *http.Response) (*http.Response, error) {
+		if request == nil || response == nil || response.Body == nil || response.StatusCode < http.StatusBadRequest {
+			return response, nil
+		}
+
+		recorder, ok := request.Context().Value(armErrorRecorderKey{}).(*armErrorRecorder)
+		if !ok || !strings.Contains(strings.ToLower(response.Header.Get("Content-Type")), "json") {
+			return response, nil
+		}
+
+		body, err := io.ReadAll(response.Body)
+		response.Body.Close()
+		// the response body is replaced so that it can be parsed by the SDK as usual
+		response.Body = io.NopCloser(bytes.NewReader(body))
+		if err != nil {
+			return response, nil
+		}
+
+		if armError := ParseArmError(body); armError != nil {
+			recorder.lock.Lock()
+			recorder.last = armError
+			recorder.lock.Unlock()
+		}
+
+		return response, nil
+	}
+}
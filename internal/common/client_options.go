@@ -76,6 +76,7 @@ func (o ClientOptions) Configure(c client.BaseClient, authorizer auth.Authorizer
 
 	c.AppendRequestMiddleware(requestLoggerMiddleware("AzureRM"))
 	c.AppendResponseMiddleware(responseLoggerMiddleware("AzureRM"))
+	c.AppendResponseMiddleware(armErrorRecorderMiddleware())
 }
 
 // ConfigureClient sets up an autorest.Client using an autorest.Authorizer
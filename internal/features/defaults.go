@@ -75,5 +75,12 @@ func Default() UserFeatures {
 			VMBackupStopProtectionAndRetainDataOnDestroy: false,
 			PurgeProtectedItemsFromVaultOnDestroy:        false,
 		},
+		PreventDestroyIf: PreventDestroyIfFeatures{
+			ContainsData:         false,
+			ManagementLockExists: false,
+		},
+		Refresh: RefreshFeatures{
+			UseResourceGraph: false,
+		},
 	}
 }
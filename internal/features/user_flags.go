@@ -20,6 +20,8 @@ type UserFeatures struct {
 	PostgresqlFlexibleServer PostgresqlFlexibleServerFeatures
 	MachineLearning          MachineLearningFeatures
 	RecoveryService          RecoveryServiceFeatures
+	PreventDestroyIf         PreventDestroyIfFeatures
+	Refresh                  RefreshFeatures
 }
 
 type CognitiveAccountFeatures struct {
@@ -104,3 +106,12 @@ type RecoveryServiceFeatures struct {
 	VMBackupStopProtectionAndRetainDataOnDestroy bool
 	PurgeProtectedItemsFromVaultOnDestroy        bool
 }
+
+type PreventDestroyIfFeatures struct {
+	ContainsData         bool
+	ManagementLockExists bool
+}
+
+type RefreshFeatures struct {
+	UseResourceGraph bool
+}
@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package operations
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+// CheckpointPathEnvVar is the Environment Variable which can be used to override the directory where
+// in-flight Long Running Operations are recorded
+const CheckpointPathEnvVar = "ARM_OPERATION_CHECKPOINT_PATH"
+
+// defaultCheckpointPath is relative to the working directory Terraform is running in, so that these
+// are available for subsequent runs of the same configuration
+var defaultCheckpointPath = filepath.Join(".terraform", "azurerm", "operations")
+
+// checkpointHeaders are the HTTP Headers which are needed to resume polling a Long Running Operation
+var checkpointHeaders = []string{
+	"Azure-AsyncOperation",
+	"Content-Type",
+	"Location",
+	"Retry-After",
+}
+
+var checkpointLock = &sync.Mutex{}
+
+// checkpoint is the information recorded about an in-flight Long Running Operation
+type checkpoint struct {
+	ResourceId string            `json:"resourceId"`
+	Method     string            `json:"method"`
+	RequestUri string            `json:"requestUri"`
+	StatusCode int               `json:"statusCode"`
+	Headers    map[string]string `json:"headers"`
+	StartedAt  time.Time         `json:"startedAt"`
+
+	// ConfigHash is a hash of the Resource's configuration when the operation was started, see WithConfigHash
+	ConfigHash string `json:"configHash,omitempty"`
+}
+
+func newCheckpoint(resourceId, configHash string, resp *http.Response) (*checkpoint, error) {
+	if resp == nil || resp.Request == nil || resp.Request.URL == nil {
+		return nil, fmt.Errorf("the HTTP Response or Request was nil")
+	}
+
+	headers := make(map[string]string)
+	for _, header := range checkpointHeaders {
+		if v := resp.Header.Get(header); v != "" {
+			headers[header] = v
+		}
+	}
+
+	return &checkpoint{
+		ResourceId: resourceId,
+		Method:     resp.Request.Method,
+		RequestUri: resp.Request.URL.String(),
+		StatusCode: resp.StatusCode,
+		Headers:    headers,
+		StartedAt:  time.Now().UTC(),
+		ConfigHash: configHash,
+	}, nil
+}
+
+// matchesConfigHash returns whether the operation was started using the same configuration, where either hash
+// is unknown the operation is assumed to match
+func (c checkpoint) matchesConfigHash(configHash string) bool {
+	return c.ConfigHash == "" || configHash == "" || c.ConfigHash == configHash
+}
+
+// response rebuilds the HTTP Response returned when the Long Running Operation was started, which can be used to
+// build a Poller for this operation
+func (c checkpoint) response() (*client.Response, error) {
+	requestUri, err := url.Parse(c.RequestUri)
+	if err != nil {
+		return nil, fmt.Errorf("parsing the Request URI %q: %+v", c.RequestUri, err)
+	}
+
+	headers := http.Header{}
+	for k, v := range c.Headers {
+		headers.Set(k, v)
+	}
+
+	return &client.Response{
+		Response: &http.Response{
+			StatusCode: c.StatusCode,
+			Header:     headers,
+			Request: &http.Request{
+				Method: c.Method,
+				URL:    requestUri,
+				Header: http.Header{},
+			},
+		},
+	}, nil
+}
+
+func checkpointDirectory() string {
+	if v := os.Getenv(CheckpointPathEnvVar); v != "" {
+		return v
+	}
+
+	return defaultCheckpointPath
+}
+
+// checkpointFileName returns the file used to record the checkpoint for this Resource ID, since Resource IDs are
+// case-insensitive this is a hash of the lower-cased Resource ID
+func checkpointFileName(resourceId string) string {
+	hash := sha256.Sum256([]byte(strings.ToLower(resourceId)))
+	return filepath.Join(checkpointDirectory(), fmt.Sprintf("%s.json", hex.EncodeToString(hash[:])))
+}
+
+func loadCheckpoint(resourceId string) (*checkpoint, error) {
+	checkpointLock.Lock()
+	defer checkpointLock.Unlock()
+
+	contents, err := os.ReadFile(checkpointFileName(resourceId))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading checkpoint: %+v", err)
+	}
+
+	var output checkpoint
+	if err := json.Unmarshal(contents, &output); err != nil {
+		return nil, fmt.Errorf("unmarshaling checkpoint: %+v", err)
+	}
+
+	return &output, nil
+}
+
+func saveCheckpoint(input checkpoint) error {
+	checkpointLock.Lock()
+	defer checkpointLock.Unlock()
+
+	if err := os.MkdirAll(checkpointDirectory(), 0o700); err != nil {
+		return fmt.Errorf("creating the directory %q: %+v", checkpointDirectory(), err)
+	}
+
+	contents, err := json.Marshal(input)
+	if err != nil {
+		return fmt.Errorf("marshaling checkpoint: %+v", err)
+	}
+
+	if err := os.WriteFile(checkpointFileName(input.ResourceId), contents, 0o600); err != nil {
+		return fmt.Errorf("writing checkpoint: %+v", err)
+	}
+
+	return nil
+}
+
+func removeCheckpoint(resourceId string) error {
+	checkpointLock.Lock()
+	defer checkpointLock.Unlock()
+
+	if err := os.Remove(checkpointFileName(resourceId)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("removing checkpoint: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package operations
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestCheckpointRoundTrip(t *testing.T) {
+	t.Setenv(CheckpointPathEnvVar, t.TempDir())
+
+	resourceId := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example-resources/providers/Microsoft.Web/hostingEnvironments/example"
+	requestUri, _ := url.Parse("https://management.azure.com" + resourceId + "?api-version=2023-01-01")
+
+	resp := &http.Response{
+		StatusCode: http.StatusCreated,
+		Header: http.Header{
+			"Azure-Asyncoperation": []string{"https://management.azure.com/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Web/locations/westeurope/operations/abc123?api-version=2023-01-01"},
+			"Retry-After":          []string{"15"},
+			"X-Ms-Request-Id":      []string{"not-recorded"},
+		},
+		Request: &http.Request{
+			Method: http.MethodPut,
+			URL:    requestUri,
+		},
+	}
+
+	started, err := newCheckpoint(resourceId, "", resp)
+	if err != nil {
+		t.Fatalf("building checkpoint: %+v", err)
+	}
+	if err := saveCheckpoint(*started); err != nil {
+		t.Fatalf("saving checkpoint: %+v", err)
+	}
+
+	// Resource IDs are case-insensitive
+	loaded, err := loadCheckpoint("/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/resourcegroups/example-resources/providers/Microsoft.Web/hostingEnvironments/example")
+	if err != nil {
+		t.Fatalf("loading checkpoint: %+v", err)
+	}
+	if loaded == nil {
+		t.Fatalf("expected a checkpoint to be loaded but got nil")
+	}
+
+	actual, err := loaded.response()
+	if err != nil {
+		t.Fatalf("building response: %+v", err)
+	}
+	if actual.StatusCode != http.StatusCreated {
+		t.Fatalf("expected the Status Code to be %d but got %d", http.StatusCreated, actual.StatusCode)
+	}
+	if actual.Request.Method != http.MethodPut {
+		t.Fatalf("expected the Method to be %q but got %q", http.MethodPut, actual.Request.Method)
+	}
+	if actual.Request.URL.String() != requestUri.String() {
+		t.Fatalf("expected the Request URI to be %q but got %q", requestUri.String(), actual.Request.URL.String())
+	}
+	if actual.Header.Get("Azure-AsyncOperation") != resp.Header.Get("Azure-AsyncOperation") {
+		t.Fatalf("expected the Azure-AsyncOperation header to be %q but got %q", resp.Header.Get("Azure-AsyncOperation"), actual.Header.Get("Azure-AsyncOperation"))
+	}
+	if actual.Header.Get("X-Ms-Request-Id") != "" {
+		t.Fatalf("expected the X-Ms-Request-Id header not to be recorded")
+	}
+
+	if err := removeCheckpoint(resourceId); err != nil {
+		t.Fatalf("removing checkpoint: %+v", err)
+	}
+	loaded, err = loadCheckpoint(resourceId)
+	if err != nil {
+		t.Fatalf("loading checkpoint: %+v", err)
+	}
+	if loaded != nil {
+		t.Fatalf("expected the checkpoint to have been removed")
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package operations
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+
+	"github.com/hashicorp/go-cty/cty"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type configHashContextKey struct{}
+
+// WithConfigHash returns a copy of the context containing a hash of the configuration for the Resource being
+// created. This is recorded alongside any in-flight Long Running Operation, so that the operation is only resumed
+// when the configuration is unchanged - if the configuration has changed the recorded operation is discarded.
+//
+// This is called for all Resources by the Typed SDK and `timeouts.ForCreate`, so doesn't need calling directly.
+func WithConfigHash(ctx context.Context, d *pluginsdk.ResourceData) context.Context {
+	if d == nil {
+		return ctx
+	}
+
+	return context.WithValue(ctx, configHashContextKey{}, configHash(d.GetRawConfig()))
+}
+
+func configHashFromContext(ctx context.Context) string {
+	if v, ok := ctx.Value(configHashContextKey{}).(string); ok {
+		return v
+	}
+	return ""
+}
+
+// configHash returns a hash of the configuration, excluding the `timeouts` block - since this is commonly changed
+// after an operation has timed out, where the operation should still be resumed
+func configHash(config cty.Value) string {
+	if config.IsNull() || !config.IsWhollyKnown() || !config.Type().IsObjectType() {
+		return ""
+	}
+
+	attributes := make(map[string]cty.Value)
+	for k, v := range config.AsValueMap() {
+		if k == "timeouts" {
+			continue
+		}
+		attributes[k] = v
+	}
+	value := cty.ObjectVal(attributes)
+
+	contents, err := ctyjson.Marshal(value, value.Type())
+	if err != nil {
+		log.Printf("[DEBUG] Unable to hash the configuration: %+v", err)
+		return ""
+	}
+
+	hash := sha256.Sum256(contents)
+	return hex.EncodeToString(hash[:])
+}
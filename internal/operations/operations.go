@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package operations
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+)
+
+// StartFunc issues the request which begins a Long Running Operation, returning the HTTP Response
+type StartFunc func() (*http.Response, error)
+
+// ResumeOrStart ensures that a Long Running Operation (such as a Create or Update) for the specified Resource
+// runs to completion.
+//
+// When a previous run was interrupted (for example, Terraform was killed) whilst polling a Long Running Operation
+// for this Resource, polling is resumed using the recorded `Azure-AsyncOperation`/`Location` URI, rather than
+// issuing the request a second time. Otherwise start is called to issue the request, and the polling information
+// is recorded until the Long Running Operation has completed.
+//
+// A recorded operation is only resumed when the configuration of the Resource is unchanged (see WithConfigHash),
+// otherwise it's discarded and start is called to issue the request using the current configuration.
+func ResumeOrStart(ctx context.Context, client *resourcemanager.Client, id resourceids.Id, start StartFunc) error {
+	existing, err := inFlightCheckpoint(ctx, id)
+	if err != nil {
+		return fmt.Errorf("loading the in-flight operation for %s: %+v", id, err)
+	}
+
+	if existing != nil {
+		log.Printf("[DEBUG] Resuming the in-flight operation for %s (%s %s started at %s)", id, existing.Method, existing.RequestUri, existing.StartedAt)
+		return poll(ctx, client, id, *existing)
+	}
+
+	resp, err := start()
+	if err != nil {
+		return err
+	}
+
+	started, err := newCheckpoint(id.ID(), configHashFromContext(ctx), resp)
+	if err != nil {
+		return fmt.Errorf("recording the in-flight operation for %s: %+v", id, err)
+	}
+	if err := saveCheckpoint(*started); err != nil {
+		// failing to record this only means the operation can't be resumed, so shouldn't fail the operation itself
+		log.Printf("[WARN] Unable to record the in-flight operation for %s: %+v", id, err)
+	}
+
+	return poll(ctx, client, id, *started)
+}
+
+// InFlight returns whether there's a recorded Long Running Operation for the specified Resource from a previous
+// (interrupted) run, which was started using the same configuration. When this is the case the existence check
+// during Create should be skipped, since the Resource is expected to exist, and ResumeOrStart will resume polling
+// the operation.
+func InFlight(ctx context.Context, id resourceids.Id) bool {
+	existing, err := inFlightCheckpoint(ctx, id)
+	if err != nil {
+		log.Printf("[WARN] Unable to load the in-flight operation for %s: %+v", id, err)
+		return false
+	}
+
+	return existing != nil
+}
+
+// inFlightCheckpoint returns the recorded Long Running Operation for the specified Resource, if one exists which
+// was started using the same configuration - any recorded operation using a different configuration is removed
+func inFlightCheckpoint(ctx context.Context, id resourceids.Id) (*checkpoint, error) {
+	existing, err := loadCheckpoint(id.ID())
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, nil
+	}
+
+	if !existing.matchesConfigHash(configHashFromContext(ctx)) {
+		log.Printf("[DEBUG] Discarding the in-flight operation for %s (%s %s started at %s) since the configuration has changed", id, existing.Method, existing.RequestUri, existing.StartedAt)
+		Forget(id.ID())
+		return nil, nil
+	}
+
+	return existing, nil
+}
+
+// Forget removes any recorded Long Running Operation for the specified Resource ID, for example when the Resource
+// has been deleted
+func Forget(resourceId string) {
+	if err := removeCheckpoint(resourceId); err != nil {
+		log.Printf("[WARN] Unable to remove the in-flight operation for %q: %+v", resourceId, err)
+	}
+}
+
+func poll(ctx context.Context, client *resourcemanager.Client, id resourceids.Id, input checkpoint) error {
+	resp, err := input.response()
+	if err != nil {
+		return err
+	}
+
+	poller, err := resourcemanager.PollerFromResponse(resp, client)
+	if err != nil {
+		// this was a synchronous operation, so there's nothing to poll
+		log.Printf("[DEBUG] No Poller was found for the operation for %s: %+v", id, err)
+		Forget(id.ID())
+		return nil
+	}
+
+	if err := poller.PollUntilDone(ctx); err != nil {
+		if ctx.Err() == nil {
+			// the operation itself has failed (rather than Terraform being interrupted) - so it shouldn't be resumed
+			Forget(id.ID())
+		}
+		return fmt.Errorf("polling after %s: %+v", input.Method, err)
+	}
+
+	Forget(id.ID())
+	return nil
+}
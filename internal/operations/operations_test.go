@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package operations
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-cty/cty"
+	"golang.org/x/oauth2"
+)
+
+// fakeOperationServer is a Resource Manager API with a single Resource, where each PUT starts a Long Running
+// Operation which completes the first time it's polled
+type fakeOperationServer struct {
+	*httptest.Server
+
+	lock sync.Mutex
+	puts int
+	// polls is the number of times each operation has been polled, keyed by the path
+	polls map[string]int
+}
+
+func newFakeOperationServer(t *testing.T) *fakeOperationServer {
+	s := &fakeOperationServer{
+		polls: map[string]int{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+
+		switch r.Method {
+		case http.MethodPut:
+			s.puts++
+			w.Header().Set("Azure-AsyncOperation", s.URL+"/operations/started")
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusCreated)
+
+		case http.MethodGet:
+			s.polls[r.URL.Path]++
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"status": "Succeeded"}`))
+
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func (s *fakeOperationServer) client(t *testing.T) *resourcemanager.Client {
+	client, err := resourcemanager.NewResourceManagerClient(environments.NewApiEndpoint("Test", s.URL, nil), "operations", "2020-01-01")
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+	client.Authorizer = fakeAuthorizer{}
+	return client
+}
+
+type fakeAuthorizer struct{}
+
+func (fakeAuthorizer) Token(_ context.Context, _ *http.Request) (*oauth2.Token, error) {
+	return &oauth2.Token{
+		AccessToken: "fake",
+		TokenType:   "Bearer",
+	}, nil
+}
+
+func (fakeAuthorizer) AuxiliaryTokens(_ context.Context, _ *http.Request) ([]*oauth2.Token, error) {
+	return nil, nil
+}
+
+func (s *fakeOperationServer) start(ctx context.Context, id commonids.ResourceGroupId) StartFunc {
+	return func() (*http.Response, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.URL+id.ID()+"?api-version=2020-01-01", nil)
+		if err != nil {
+			return nil, err
+		}
+		return s.Client().Do(req)
+	}
+}
+
+// recordOperation records an in-flight operation for the Resource which is polled using the specified path
+func (s *fakeOperationServer) recordOperation(t *testing.T, id commonids.ResourceGroupId, operationPath, configHash string) {
+	err := saveCheckpoint(checkpoint{
+		ResourceId: id.ID(),
+		Method:     http.MethodPut,
+		RequestUri: s.URL + id.ID() + "?api-version=2020-01-01",
+		StatusCode: http.StatusCreated,
+		Headers: map[string]string{
+			"Azure-AsyncOperation": s.URL + operationPath,
+			"Retry-After":          "0",
+		},
+		StartedAt:  time.Now().UTC(),
+		ConfigHash: configHash,
+	})
+	if err != nil {
+		t.Fatalf("saving checkpoint: %+v", err)
+	}
+}
+
+func testContext(t *testing.T, configHash string) context.Context {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	t.Cleanup(cancel)
+	return context.WithValue(ctx, configHashContextKey{}, configHash)
+}
+
+func assertNoCheckpoint(t *testing.T, id commonids.ResourceGroupId) {
+	existing, err := loadCheckpoint(id.ID())
+	if err != nil {
+		t.Fatalf("loading checkpoint: %+v", err)
+	}
+	if existing != nil {
+		t.Fatalf("expected the checkpoint to have been removed but got %+v", *existing)
+	}
+}
+
+func TestResumeOrStartFresh(t *testing.T) {
+	t.Setenv(CheckpointPathEnvVar, t.TempDir())
+	server := newFakeOperationServer(t)
+	id := commonids.NewResourceGroupID("12345678-1234-9876-4563-123456789012", "fresh")
+	ctx := testContext(t, "abc123")
+
+	if InFlight(ctx, id) {
+		t.Fatalf("expected no operation to be in-flight")
+	}
+
+	if err := ResumeOrStart(ctx, server.client(t), id, server.start(ctx, id)); err != nil {
+		t.Fatalf("ResumeOrStart: %+v", err)
+	}
+
+	if server.puts != 1 {
+		t.Fatalf("expected the request to be issued once but got %d", server.puts)
+	}
+	if server.polls["/operations/started"] == 0 {
+		t.Fatalf("expected the operation to be polled")
+	}
+	assertNoCheckpoint(t, id)
+}
+
+func TestResumeOrStartResume(t *testing.T) {
+	t.Setenv(CheckpointPathEnvVar, t.TempDir())
+	server := newFakeOperationServer(t)
+	id := commonids.NewResourceGroupID("12345678-1234-9876-4563-123456789012", "resume")
+	ctx := testContext(t, "abc123")
+
+	server.recordOperation(t, id, "/operations/interrupted", "abc123")
+
+	if !InFlight(ctx, id) {
+		t.Fatalf("expected the recorded operation to be in-flight")
+	}
+
+	if err := ResumeOrStart(ctx, server.client(t), id, server.start(ctx, id)); err != nil {
+		t.Fatalf("ResumeOrStart: %+v", err)
+	}
+
+	if server.puts != 0 {
+		t.Fatalf("expected the request not to be issued again but got %d requests", server.puts)
+	}
+	if server.polls["/operations/interrupted"] == 0 {
+		t.Fatalf("expected the recorded operation to be polled")
+	}
+	assertNoCheckpoint(t, id)
+}
+
+func TestResumeOrStartStale(t *testing.T) {
+	t.Setenv(CheckpointPathEnvVar, t.TempDir())
+	server := newFakeOperationServer(t)
+	id := commonids.NewResourceGroupID("12345678-1234-9876-4563-123456789012", "stale")
+	ctx := testContext(t, "updated")
+
+	server.recordOperation(t, id, "/operations/interrupted", "original")
+
+	if InFlight(ctx, id) {
+		t.Fatalf("expected the recorded operation not to be in-flight since the configuration has changed")
+	}
+	assertNoCheckpoint(t, id)
+
+	// record it again, since ResumeOrStart should also discard this without an InFlight check
+	server.recordOperation(t, id, "/operations/interrupted", "original")
+
+	if err := ResumeOrStart(ctx, server.client(t), id, server.start(ctx, id)); err != nil {
+		t.Fatalf("ResumeOrStart: %+v", err)
+	}
+
+	if server.puts != 1 {
+		t.Fatalf("expected the request to be issued once but got %d", server.puts)
+	}
+	if server.polls["/operations/interrupted"] != 0 {
+		t.Fatalf("expected the stale operation not to be polled")
+	}
+	if server.polls["/operations/started"] == 0 {
+		t.Fatalf("expected the new operation to be polled")
+	}
+	assertNoCheckpoint(t, id)
+}
+
+func TestConfigHash(t *testing.T) {
+	config := func(name string, timeout string) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"name": cty.StringVal(name),
+			"tags": cty.MapVal(map[string]cty.Value{
+				"env": cty.StringVal("test"),
+			}),
+			"timeouts": cty.ObjectVal(map[string]cty.Value{
+				"create": cty.StringVal(timeout),
+			}),
+		})
+	}
+
+	original := configHash(config("example", "30m"))
+	if original == "" {
+		t.Fatalf("expected a hash for the configuration")
+	}
+	if v := configHash(config("example", "30m")); v != original {
+		t.Fatalf("expected the same configuration to have the same hash")
+	}
+	if v := configHash(config("example", "2h")); v != original {
+		t.Fatalf("expected the `timeouts` block to be excluded from the hash")
+	}
+	if v := configHash(config("other", "30m")); v == original {
+		t.Fatalf("expected a different configuration to have a different hash")
+	}
+	if v := configHash(cty.NullVal(cty.DynamicPseudoType)); v != "" {
+		t.Fatalf("expected no hash for a null configuration but got %q", v)
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+var defaultTimeoutOperations = []string{
+	pluginsdk.TimeoutCreate,
+	pluginsdk.TimeoutRead,
+	pluginsdk.TimeoutUpdate,
+	pluginsdk.TimeoutDelete,
+}
+
+func schemaDefaultTimeouts() *pluginsdk.Schema {
+	resourceSchema := map[string]*pluginsdk.Schema{
+		"type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+	for _, operation := range defaultTimeoutOperations {
+		resourceSchema[operation] = schemaDefaultTimeoutDuration()
+	}
+
+	defaultTimeoutsSchema := map[string]*pluginsdk.Schema{
+		"multiplier": {
+			Type:         pluginsdk.TypeFloat,
+			Optional:     true,
+			ValidateFunc: validation.FloatAtLeast(1),
+			Description:  "The multiplier applied to the timeouts defined by each Resource, where a timeout isn't otherwise specified for that operation.",
+		},
+
+		"resource": {
+			Type:        pluginsdk.TypeList,
+			Optional:    true,
+			Description: "The timeouts which should be used for a specific Resource Type.",
+			Elem: &pluginsdk.Resource{
+				Schema: resourceSchema,
+			},
+		},
+	}
+	for _, operation := range defaultTimeoutOperations {
+		defaultTimeoutsSchema[operation] = schemaDefaultTimeoutDuration()
+	}
+
+	return &pluginsdk.Schema{
+		Type:        pluginsdk.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "The timeouts which should be used by default for all Resources, in place of the timeouts defined by each Resource.",
+		Elem: &pluginsdk.Resource{
+			Schema: defaultTimeoutsSchema,
+		},
+	}
+}
+
+func schemaDefaultTimeoutDuration() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Optional:     true,
+		ValidateFunc: timeouts.ValidateDefaultDuration,
+	}
+}
+
+func expandDefaultTimeouts(input []interface{}) (*timeouts.Defaults, error) {
+	output := timeouts.Defaults{
+		Resources: map[string]timeouts.ResourceDefaults{},
+	}
+	if len(input) == 0 || input[0] == nil {
+		return &output, nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	durations, err := expandDefaultTimeoutDurations(raw)
+	if err != nil {
+		return nil, err
+	}
+	output.Create = durations[pluginsdk.TimeoutCreate]
+	output.Read = durations[pluginsdk.TimeoutRead]
+	output.Update = durations[pluginsdk.TimeoutUpdate]
+	output.Delete = durations[pluginsdk.TimeoutDelete]
+	if v, ok := raw["multiplier"].(float64); ok {
+		output.Multiplier = v
+	}
+
+	resources, _ := raw["resource"].([]interface{})
+	for _, item := range resources {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+
+		resourceType := v["type"].(string)
+		if _, exists := output.Resources[resourceType]; exists {
+			return nil, fmt.Errorf("`default_timeouts` contains multiple `resource` blocks for %q", resourceType)
+		}
+
+		durations, err := expandDefaultTimeoutDurations(v)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `default_timeouts` for %q: %+v", resourceType, err)
+		}
+		output.Resources[resourceType] = timeouts.ResourceDefaults{
+			Create: durations[pluginsdk.TimeoutCreate],
+			Read:   durations[pluginsdk.TimeoutRead],
+			Update: durations[pluginsdk.TimeoutUpdate],
+			Delete: durations[pluginsdk.TimeoutDelete],
+		}
+	}
+
+	return &output, nil
+}
+
+func expandDefaultTimeoutDurations(input map[string]interface{}) (map[string]*time.Duration, error) {
+	output := make(map[string]*time.Duration)
+	for _, operation := range defaultTimeoutOperations {
+		v, ok := input[operation].(string)
+		if !ok || v == "" {
+			continue
+		}
+
+		duration, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %q timeout %q: %+v", operation, v, err)
+		}
+		output[operation] = &duration
+	}
+
+	return output, nil
+}
+
+// builtInTimeouts returns a copy of the timeouts defined by each Resource, which are used as the basis for the
+// `default_timeouts` each time that the Provider is configured
+func builtInTimeouts(resources map[string]*pluginsdk.Resource) map[string]*pluginsdk.ResourceTimeout {
+	output := make(map[string]*pluginsdk.ResourceTimeout)
+	for resourceType, resource := range resources {
+		if resource == nil || resource.Timeouts == nil {
+			continue
+		}
+
+		v := *resource.Timeouts
+		output[resourceType] = &v
+	}
+
+	return output
+}
+
+// applyDefaultTimeouts replaces the timeouts for each Resource with those built from the `default_timeouts`.
+//
+// Since these are the defaults used by the Plugin SDK when building the ResourceData, they're picked up both by
+// `timeouts.ForCreate` (etc.) and the Typed SDK - whilst the `timeouts` block within a Resource takes precedence.
+func applyDefaultTimeouts(resources map[string]*pluginsdk.Resource, builtIn map[string]*pluginsdk.ResourceTimeout, defaults timeouts.Defaults) error {
+	for resourceType := range defaults.Resources {
+		if _, ok := resources[resourceType]; !ok {
+			return fmt.Errorf("`default_timeouts` contains a `resource` block for %q which isn't a Resource supported by this Provider", resourceType)
+		}
+	}
+
+	for resourceType, builtInTimeout := range builtIn {
+		resource, ok := resources[resourceType]
+		if !ok {
+			continue
+		}
+		resource.Timeouts = defaults.Apply(resourceType, builtInTimeout)
+	}
+
+	return nil
+}
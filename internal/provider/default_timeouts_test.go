@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestApplyDefaultTimeouts(t *testing.T) {
+	d := func(duration time.Duration) *time.Duration {
+		return &duration
+	}
+	builtIn := func() map[string]*pluginsdk.ResourceTimeout {
+		return map[string]*pluginsdk.ResourceTimeout{
+			"azurerm_example": {
+				Create: d(30 * time.Minute),
+				Read:   d(5 * time.Minute),
+				Update: d(30 * time.Minute),
+				Delete: d(30 * time.Minute),
+			},
+			"azurerm_other": {
+				Create: d(10 * time.Minute),
+				Read:   d(5 * time.Minute),
+				Delete: d(10 * time.Minute),
+			},
+		}
+	}
+
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		Expected map[string]pluginsdk.ResourceTimeout
+		Error    bool
+	}{
+		{
+			Name:  "Empty Block",
+			Input: []interface{}{},
+			Expected: map[string]pluginsdk.ResourceTimeout{
+				"azurerm_example": {Create: d(30 * time.Minute), Read: d(5 * time.Minute), Update: d(30 * time.Minute), Delete: d(30 * time.Minute)},
+				"azurerm_other":   {Create: d(10 * time.Minute), Read: d(5 * time.Minute), Delete: d(10 * time.Minute)},
+			},
+		},
+		{
+			Name: "Multiplier",
+			Input: []interface{}{
+				map[string]interface{}{
+					"multiplier": 2.5,
+					"resource":   []interface{}{},
+				},
+			},
+			Expected: map[string]pluginsdk.ResourceTimeout{
+				"azurerm_example": {Create: d(75 * time.Minute), Read: d(12*time.Minute + 30*time.Second), Update: d(75 * time.Minute), Delete: d(75 * time.Minute)},
+				"azurerm_other":   {Create: d(25 * time.Minute), Read: d(12*time.Minute + 30*time.Second), Delete: d(25 * time.Minute)},
+			},
+		},
+		{
+			Name: "All Resources and Resource Type",
+			Input: []interface{}{
+				map[string]interface{}{
+					"create":     "1h",
+					"update":     "2h",
+					"multiplier": 2.0,
+					"resource": []interface{}{
+						map[string]interface{}{
+							"type":   "azurerm_example",
+							"create": "3h",
+						},
+					},
+				},
+			},
+			Expected: map[string]pluginsdk.ResourceTimeout{
+				// the resource type takes precedence over all resources, which takes precedence over the multiplier
+				"azurerm_example": {Create: d(3 * time.Hour), Read: d(10 * time.Minute), Update: d(2 * time.Hour), Delete: d(60 * time.Minute)},
+				// Update isn't supported by this resource, so isn't set
+				"azurerm_other": {Create: d(time.Hour), Read: d(10 * time.Minute), Delete: d(20 * time.Minute)},
+			},
+		},
+		{
+			Name: "Unknown Resource Type",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource": []interface{}{
+						map[string]interface{}{
+							"type":   "azurerm_unknown",
+							"create": "3h",
+						},
+					},
+				},
+			},
+			Error: true,
+		},
+		{
+			Name: "Duplicate Resource Type",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource": []interface{}{
+						map[string]interface{}{
+							"type":   "azurerm_example",
+							"create": "3h",
+						},
+						map[string]interface{}{
+							"type":   "azurerm_example",
+							"delete": "3h",
+						},
+					},
+				},
+			},
+			Error: true,
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Run(testCase.Name, func(t *testing.T) {
+			resources := map[string]*pluginsdk.Resource{
+				"azurerm_example": {Timeouts: builtIn()["azurerm_example"]},
+				"azurerm_other":   {Timeouts: builtIn()["azurerm_other"]},
+			}
+
+			// applied twice to ensure that the timeouts are built from those defined by the Resource each time
+			for i := 0; i < 2; i++ {
+				defaults, err := expandDefaultTimeouts(testCase.Input)
+				if err == nil {
+					err = applyDefaultTimeouts(resources, builtIn(), *defaults)
+				}
+				if testCase.Error {
+					if err == nil {
+						t.Fatalf("expected an error but didn't get one")
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("unexpected error: %+v", err)
+				}
+			}
+
+			for resourceType, expected := range testCase.Expected {
+				actual := resources[resourceType].Timeouts
+				for operation, values := range map[string][2]*time.Duration{
+					pluginsdk.TimeoutCreate: {expected.Create, actual.Create},
+					pluginsdk.TimeoutRead:   {expected.Read, actual.Read},
+					pluginsdk.TimeoutUpdate: {expected.Update, actual.Update},
+					pluginsdk.TimeoutDelete: {expected.Delete, actual.Delete},
+				} {
+					if values[0] == nil || values[1] == nil {
+						if values[0] != values[1] {
+							t.Fatalf("expected %s timeout for %q to be %v but got %v", operation, resourceType, values[0], values[1])
+						}
+						continue
+					}
+					if *values[0] != *values[1] {
+						t.Fatalf("expected %s timeout for %q to be %s but got %s", operation, resourceType, *values[0], *values[1])
+					}
+				}
+			}
+		})
+	}
+}
@@ -372,6 +372,41 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 				},
 			},
 		},
+
+		"prevent_destroy_if": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"contains_data": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+					"management_lock_exists": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+
+		"refresh": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"use_resource_graph": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
 	}
 
 	// this is a temporary hack to enable us to gradually add provider blocks to test configurations
@@ -624,5 +659,28 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["prevent_destroy_if"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			preventDestroyIfRaw := items[0].(map[string]interface{})
+			if v, ok := preventDestroyIfRaw["contains_data"]; ok {
+				featuresMap.PreventDestroyIf.ContainsData = v.(bool)
+			}
+			if v, ok := preventDestroyIfRaw["management_lock_exists"]; ok {
+				featuresMap.PreventDestroyIf.ManagementLockExists = v.(bool)
+			}
+		}
+	}
+
+	if raw, ok := val["refresh"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			refreshRaw := items[0].(map[string]interface{})
+			if v, ok := refreshRaw["use_resource_graph"]; ok {
+				featuresMap.Refresh.UseResourceGraph = v.(bool)
+			}
+		}
+	}
+
 	return featuresMap
 }
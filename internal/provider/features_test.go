@@ -88,6 +88,13 @@ func TestExpandFeatures(t *testing.T) {
 					VMBackupStopProtectionAndRetainDataOnDestroy: false,
 					PurgeProtectedItemsFromVaultOnDestroy:        false,
 				},
+				PreventDestroyIf: features.PreventDestroyIfFeatures{
+					ContainsData:         false,
+					ManagementLockExists: false,
+				},
+				Refresh: features.RefreshFeatures{
+					UseResourceGraph: false,
+				},
 			},
 		},
 		{
@@ -193,6 +200,17 @@ func TestExpandFeatures(t *testing.T) {
 							"purge_protected_items_from_vault_on_destroy":          true,
 						},
 					},
+					"prevent_destroy_if": []interface{}{
+						map[string]interface{}{
+							"contains_data":          true,
+							"management_lock_exists": true,
+						},
+					},
+					"refresh": []interface{}{
+						map[string]interface{}{
+							"use_resource_graph": true,
+						},
+					},
 				},
 			},
 			Expected: features.UserFeatures{
@@ -263,6 +281,13 @@ func TestExpandFeatures(t *testing.T) {
 					VMBackupStopProtectionAndRetainDataOnDestroy: true,
 					PurgeProtectedItemsFromVaultOnDestroy:        true,
 				},
+				PreventDestroyIf: features.PreventDestroyIfFeatures{
+					ContainsData:         true,
+					ManagementLockExists: true,
+				},
+				Refresh: features.RefreshFeatures{
+					UseResourceGraph: true,
+				},
 			},
 		},
 		{
@@ -368,6 +393,17 @@ func TestExpandFeatures(t *testing.T) {
 							"purge_protected_items_from_vault_on_destroy":          false,
 						},
 					},
+					"prevent_destroy_if": []interface{}{
+						map[string]interface{}{
+							"contains_data":          false,
+							"management_lock_exists": false,
+						},
+					},
+					"refresh": []interface{}{
+						map[string]interface{}{
+							"use_resource_graph": false,
+						},
+					},
 				},
 			},
 			Expected: features.UserFeatures{
@@ -438,6 +474,13 @@ func TestExpandFeatures(t *testing.T) {
 					VMBackupStopProtectionAndRetainDataOnDestroy: false,
 					PurgeProtectedItemsFromVaultOnDestroy:        false,
 				},
+				PreventDestroyIf: features.PreventDestroyIfFeatures{
+					ContainsData:         false,
+					ManagementLockExists: false,
+				},
+				Refresh: features.RefreshFeatures{
+					UseResourceGraph: false,
+				},
 			},
 		},
 	}
@@ -1678,3 +1721,138 @@ func TestExpandFeaturesRecoveryService(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandFeaturesPreventDestroyIf(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"prevent_destroy_if": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				PreventDestroyIf: features.PreventDestroyIfFeatures{
+					ContainsData:         false,
+					ManagementLockExists: false,
+				},
+			},
+		},
+		{
+			Name: "Prevent Destroy If Contains Data",
+			Input: []interface{}{
+				map[string]interface{}{
+					"prevent_destroy_if": []interface{}{
+						map[string]interface{}{
+							"contains_data":          true,
+							"management_lock_exists": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				PreventDestroyIf: features.PreventDestroyIfFeatures{
+					ContainsData:         true,
+					ManagementLockExists: false,
+				},
+			},
+		},
+		{
+			Name: "Prevent Destroy If Management Lock Exists",
+			Input: []interface{}{
+				map[string]interface{}{
+					"prevent_destroy_if": []interface{}{
+						map[string]interface{}{
+							"contains_data":          false,
+							"management_lock_exists": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				PreventDestroyIf: features.PreventDestroyIfFeatures{
+					ContainsData:         false,
+					ManagementLockExists: true,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.PreventDestroyIf, testCase.Expected.PreventDestroyIf) {
+			t.Fatalf("Expected %+v but got %+v", result.PreventDestroyIf, testCase.Expected.PreventDestroyIf)
+		}
+	}
+}
+
+func TestExpandFeaturesRefresh(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"refresh": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				Refresh: features.RefreshFeatures{
+					UseResourceGraph: false,
+				},
+			},
+		},
+		{
+			Name: "Use Resource Graph Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"refresh": []interface{}{
+						map[string]interface{}{
+							"use_resource_graph": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				Refresh: features.RefreshFeatures{
+					UseResourceGraph: true,
+				},
+			},
+		},
+		{
+			Name: "Use Resource Graph Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"refresh": []interface{}{
+						map[string]interface{}{
+							"use_resource_graph": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				Refresh: features.RefreshFeatures{
+					UseResourceGraph: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.Refresh, testCase.Expected.Refresh) {
+			t.Fatalf("Expected %+v but got %+v", result.Refresh, testCase.Expected.Refresh)
+		}
+	}
+}
@@ -34,7 +34,20 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 		return
 	}
 
-	if metadataHost := getEnvStringOrDefault(data.MetaDataHost, "ARM_METADATA_HOSTNAME", ""); metadataHost != "" {
+	metadataHost := getEnvStringOrDefault(data.MetaDataHost, "ARM_METADATA_HOSTNAME", "")
+	metadataFile := getEnvStringOrDefault(data.MetaDataFile, "ARM_METADATA_FILE", "")
+	if metadataHost != "" && metadataFile != "" {
+		diags.Append(diag.NewErrorDiagnostic("Configuring metadata", "only one of `metadata_host` and `metadata_file` can be specified"))
+		return
+	}
+
+	if metadataFile != "" {
+		env, err = clients.EnvironmentFromMetadataFile(metadataFile)
+		if err != nil {
+			diags.Append(diag.NewErrorDiagnostic("Configuring metadata file", err.Error()))
+			return
+		}
+	} else if metadataHost != "" {
 		env, err = environments.FromEndpoint(ctx, metadataHost)
 		if err != nil {
 			diags.Append(diag.NewErrorDiagnostic("Configuring metadata host", err.Error()))
@@ -87,6 +100,32 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 		oidcReqToken = getEnvStringOrDefault(data.OIDCRequestToken, "ACTIONS_ID_TOKEN_REQUEST_TOKEN", "")
 	}
 
+	enableGitHubOIDC := enableOIDC
+	var azureDevOpsPipelineOIDC *clients.AzureDevOpsPipelineOIDCOptions
+	if serviceConnectionId := getADOPipelineServiceConnectionId(data); enableOIDC && serviceConnectionId != "" {
+		if *oidcToken != "" {
+			diags.Append(diag.NewErrorDiagnostic("configuring OIDC", "`ado_pipeline_service_connection_id` cannot be specified alongside `oidc_token` or `oidc_token_file_path`"))
+			return
+		}
+
+		// within an Azure DevOps Pipeline the Request URL and Token are exposed in the `SYSTEM_OIDCREQUESTURI` and `SYSTEM_ACCESSTOKEN` environment variables
+		azureDevOpsPipelineOIDC = &clients.AzureDevOpsPipelineOIDCOptions{
+			RequestURL:          oidcReqURL,
+			RequestToken:        oidcReqToken,
+			ServiceConnectionID: serviceConnectionId,
+		}
+		if azureDevOpsPipelineOIDC.RequestURL == "" {
+			azureDevOpsPipelineOIDC.RequestURL = os.Getenv("SYSTEM_OIDCREQUESTURI")
+		}
+		if azureDevOpsPipelineOIDC.RequestToken == "" {
+			azureDevOpsPipelineOIDC.RequestToken = os.Getenv("SYSTEM_ACCESSTOKEN")
+		}
+
+		// the Request URL and Token are for Azure DevOps rather than GitHub, so shouldn't be used to request a token from GitHub
+		enableGitHubOIDC = false
+	}
+	p.clientBuilder.AzureDevOpsPipelineOIDC = azureDevOpsPipelineOIDC
+
 	authConfig := &auth.Credentials{
 		Environment:        *env,
 		ClientID:           getEnvStringIfValueAbsent(data.ClientId, "ARM_CLIENT_ID"),
@@ -109,7 +148,7 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 		EnableAuthenticatingUsingClientCertificate: true,
 		EnableAuthenticatingUsingClientSecret:      true,
 		EnableAuthenticationUsingOIDC:              enableOIDC,
-		EnableAuthenticationUsingGitHubOIDC:        enableOIDC,
+		EnableAuthenticationUsingGitHubOIDC:        enableGitHubOIDC,
 		EnableAuthenticatingUsingAzureCLI:          getEnvBoolOrDefault(data.UseCLI, "ARM_USE_CLI", true),
 		EnableAuthenticatingUsingManagedIdentity:   getEnvBoolOrDefault(data.UseMSI, "ARM_USE_MSI", false),
 	}
@@ -468,6 +507,44 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 			f.RecoveryService.VMBackupStopProtectionAndRetainDataOnDestroy = false
 			f.RecoveryService.PurgeProtectedItemsFromVaultOnDestroy = false
 		}
+
+		if !features.PreventDestroyIf.IsNull() && !features.PreventDestroyIf.IsUnknown() {
+			var feature []PreventDestroyIf
+			d := features.PreventDestroyIf.ElementsAs(ctx, &feature, true)
+			diags.Append(d...)
+			if diags.HasError() {
+				return
+			}
+
+			f.PreventDestroyIf.ContainsData = false
+			if !feature[0].ContainsData.IsNull() && !feature[0].ContainsData.IsUnknown() {
+				f.PreventDestroyIf.ContainsData = feature[0].ContainsData.ValueBool()
+			}
+
+			f.PreventDestroyIf.ManagementLockExists = false
+			if !feature[0].ManagementLockExists.IsNull() && !feature[0].ManagementLockExists.IsUnknown() {
+				f.PreventDestroyIf.ManagementLockExists = feature[0].ManagementLockExists.ValueBool()
+			}
+		} else {
+			f.PreventDestroyIf.ContainsData = false
+			f.PreventDestroyIf.ManagementLockExists = false
+		}
+
+		if !features.Refresh.IsNull() && !features.Refresh.IsUnknown() {
+			var feature []Refresh
+			d := features.Refresh.ElementsAs(ctx, &feature, true)
+			diags.Append(d...)
+			if diags.HasError() {
+				return
+			}
+
+			f.Refresh.UseResourceGraph = false
+			if !feature[0].UseResourceGraph.IsNull() && !feature[0].UseResourceGraph.IsUnknown() {
+				f.Refresh.UseResourceGraph = feature[0].UseResourceGraph.ValueBool()
+			}
+		} else {
+			f.Refresh.UseResourceGraph = false
+		}
 	}
 
 	p.clientBuilder.Features = f
@@ -200,6 +200,18 @@ func TestProviderConfig_LoadDefault(t *testing.T) {
 	if features.RecoveryService.PurgeProtectedItemsFromVaultOnDestroy {
 		t.Errorf("expected recovery_service.PurgeProtectedItemsFromVaultOnDestroy to be false")
 	}
+
+	if features.PreventDestroyIf.ContainsData {
+		t.Errorf("expected prevent_destroy_if.contains_data to be false")
+	}
+
+	if features.PreventDestroyIf.ManagementLockExists {
+		t.Errorf("expected prevent_destroy_if.management_lock_exists to be false")
+	}
+
+	if features.Refresh.UseResourceGraph {
+		t.Errorf("expected refresh.use_resource_graph to be false")
+	}
 }
 
 // TODO - helper functions to make setting up test date more easily so we can add more configuration coverage
@@ -302,6 +314,17 @@ func defaultFeaturesList() types.List {
 	})
 	recoveryServicesVaultsList, _ := basetypes.NewListValue(types.ObjectType{}.WithAttributeTypes(RecoveryServiceVaultsAttributes), []attr.Value{recoveryServicesVaults})
 
+	preventDestroyIf, _ := basetypes.NewObjectValueFrom(context.Background(), PreventDestroyIfAttributes, map[string]attr.Value{
+		"contains_data":          basetypes.NewBoolNull(),
+		"management_lock_exists": basetypes.NewBoolNull(),
+	})
+	preventDestroyIfList, _ := basetypes.NewListValue(types.ObjectType{}.WithAttributeTypes(PreventDestroyIfAttributes), []attr.Value{preventDestroyIf})
+
+	refresh, _ := basetypes.NewObjectValueFrom(context.Background(), RefreshAttributes, map[string]attr.Value{
+		"use_resource_graph": basetypes.NewBoolNull(),
+	})
+	refreshList, _ := basetypes.NewListValue(types.ObjectType{}.WithAttributeTypes(RefreshAttributes), []attr.Value{refresh})
+
 	fData, d := basetypes.NewObjectValue(FeaturesAttributes, map[string]attr.Value{
 		"api_management":             apiManagementList,
 		"app_configuration":          appConfigurationList,
@@ -319,6 +342,8 @@ func defaultFeaturesList() types.List {
 		"machine_learning":           machineLearningList,
 		"recovery_service":           recoveryServicesList,
 		"recovery_services_vaults":   recoveryServicesVaultsList,
+		"prevent_destroy_if":         preventDestroyIfList,
+		"refresh":                    refreshList,
 	})
 
 	fmt.Printf("%+v", d)
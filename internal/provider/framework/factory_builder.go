@@ -34,7 +34,7 @@ func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.Provide
 	v2Provider := provider.AzureProvider()
 
 	providers := []func() tfprotov5.ProviderServer{
-		v2Provider.GRPCProvider,
+		newResourceMoveServer(v2Provider, provider.SupportedResourceMoves()),
 		providerserver.NewProtocol5(NewFrameworkProvider(v2Provider)),
 	}
 
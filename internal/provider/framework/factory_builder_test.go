@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+func TestProtoV5ProviderServerFactory_ProviderSchemasMatch(t *testing.T) {
+	// the Plugin SDK and Plugin Framework Providers are muxed together, which requires the Provider
+	// Schemas (including the `features` block) to be identical across both
+	ctx := context.TODO()
+	providerServerFactory, _, err := ProtoV5ProviderServerFactory(ctx)
+	if err != nil {
+		t.Fatalf("building provider server: %+v", err)
+	}
+
+	response, err := providerServerFactory().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("retrieving provider schema: %+v", err)
+	}
+
+	for _, diag := range response.Diagnostics {
+		if diag.Severity == tfprotov5.DiagnosticSeverityError {
+			t.Fatalf("%s: %s", diag.Summary, diag.Detail)
+		}
+	}
+}
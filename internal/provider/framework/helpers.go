@@ -48,6 +48,16 @@ func getClientSecret(d *ProviderModel) (*string, error) {
 	return &clientSecret, nil
 }
 
+func getADOPipelineServiceConnectionId(d *ProviderModel) string {
+	for _, env := range []string{"ARM_ADO_PIPELINE_SERVICE_CONNECTION_ID", "ARM_OIDC_AZURE_SERVICE_CONNECTION_ID", "AZURESUBSCRIPTION_SERVICE_CONNECTION_ID"} {
+		if v := getEnvStringOrDefault(d.ADOPipelineServiceConnectionID, env, ""); v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
+
 func getOidcToken(d *ProviderModel) (*string, error) {
 	idToken := getEnvStringOrDefault(d.OIDCToken, "ARM_OIDC_TOKEN", "")
 
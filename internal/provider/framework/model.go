@@ -9,35 +9,38 @@ import (
 )
 
 type ProviderModel struct {
-	SubscriptionId                types.String `tfsdk:"subscription_id"`
-	ClientId                      types.String `tfsdk:"client_id"`
-	ClientIdFilePath              types.String `tfsdk:"client_id_file_path"`
-	TenantId                      types.String `tfsdk:"tenant_id"`
-	AuxiliaryTenantIds            types.List   `tfsdk:"auxiliary_tenant_ids"`
-	Environment                   types.String `tfsdk:"environment"`
-	MetaDataHost                  types.String `tfsdk:"metadata_host"`
-	ClientCertificate             types.String `tfsdk:"client_certificate"`
-	ClientCertificatePath         types.String `tfsdk:"client_certificate_path"`
-	ClientCertificatePassword     types.String `tfsdk:"client_certificate_password"`
-	ClientSecret                  types.String `tfsdk:"client_secret"`
-	ClientSecretFilePath          types.String `tfsdk:"client_secret_file_path"`
-	OIDCRequestToken              types.String `tfsdk:"oidc_request_token"`
-	OIDCRequestURL                types.String `tfsdk:"oidc_request_url"`
-	OIDCToken                     types.String `tfsdk:"oidc_token"`
-	OIDCTokenFilePath             types.String `tfsdk:"oidc_token_file_path"`
-	UseOIDC                       types.Bool   `tfsdk:"use_oidc"`
-	UseMSI                        types.Bool   `tfsdk:"use_msi"`
-	MSIEndpoint                   types.String `tfsdk:"msi_endpoint"`
-	UseCLI                        types.Bool   `tfsdk:"use_cli"`
-	UseAKSWorkloadIdentity        types.Bool   `tfsdk:"use_aks_workload_identity"`
-	PartnerId                     types.String `tfsdk:"partner_id"`
-	DisableCorrelationRequestId   types.Bool   `tfsdk:"disable_correlation_request_id"`
-	DisableTerraformPartnerId     types.Bool   `tfsdk:"disable_terraform_partner_id"`
-	StorageUseAzureAD             types.Bool   `tfsdk:"storage_use_azuread"`
-	Features                      types.List   `tfsdk:"features"`
-	SkipProviderRegistration      types.Bool   `tfsdk:"skip_provider_registration"` // TODO - Remove in 5.0
-	ResourceProviderRegistrations types.String `tfsdk:"resource_provider_registrations"`
-	ResourceProvidersToRegister   types.List   `tfsdk:"resource_providers_to_register"`
+	SubscriptionId                 types.String `tfsdk:"subscription_id"`
+	ClientId                       types.String `tfsdk:"client_id"`
+	ClientIdFilePath               types.String `tfsdk:"client_id_file_path"`
+	TenantId                       types.String `tfsdk:"tenant_id"`
+	AuxiliaryTenantIds             types.List   `tfsdk:"auxiliary_tenant_ids"`
+	Environment                    types.String `tfsdk:"environment"`
+	MetaDataHost                   types.String `tfsdk:"metadata_host"`
+	MetaDataFile                   types.String `tfsdk:"metadata_file"`
+	ClientCertificate              types.String `tfsdk:"client_certificate"`
+	ClientCertificatePath          types.String `tfsdk:"client_certificate_path"`
+	ClientCertificatePassword      types.String `tfsdk:"client_certificate_password"`
+	ClientSecret                   types.String `tfsdk:"client_secret"`
+	ClientSecretFilePath           types.String `tfsdk:"client_secret_file_path"`
+	OIDCRequestToken               types.String `tfsdk:"oidc_request_token"`
+	OIDCRequestURL                 types.String `tfsdk:"oidc_request_url"`
+	ADOPipelineServiceConnectionID types.String `tfsdk:"ado_pipeline_service_connection_id"`
+	OIDCToken                      types.String `tfsdk:"oidc_token"`
+	OIDCTokenFilePath              types.String `tfsdk:"oidc_token_file_path"`
+	UseOIDC                        types.Bool   `tfsdk:"use_oidc"`
+	UseMSI                         types.Bool   `tfsdk:"use_msi"`
+	MSIEndpoint                    types.String `tfsdk:"msi_endpoint"`
+	UseCLI                         types.Bool   `tfsdk:"use_cli"`
+	UseAKSWorkloadIdentity         types.Bool   `tfsdk:"use_aks_workload_identity"`
+	PartnerId                      types.String `tfsdk:"partner_id"`
+	DisableCorrelationRequestId    types.Bool   `tfsdk:"disable_correlation_request_id"`
+	DisableTerraformPartnerId      types.Bool   `tfsdk:"disable_terraform_partner_id"`
+	StorageUseAzureAD              types.Bool   `tfsdk:"storage_use_azuread"`
+	Features                       types.List   `tfsdk:"features"`
+	DefaultTimeouts                types.List   `tfsdk:"default_timeouts"`
+	SkipProviderRegistration       types.Bool   `tfsdk:"skip_provider_registration"` // TODO - Remove in 5.0
+	ResourceProviderRegistrations  types.String `tfsdk:"resource_provider_registrations"`
+	ResourceProvidersToRegister    types.List   `tfsdk:"resource_providers_to_register"`
 }
 
 type Features struct {
@@ -57,6 +60,8 @@ type Features struct {
 	MachineLearning          types.List `tfsdk:"machine_learning"`
 	RecoveryService          types.List `tfsdk:"recovery_service"`
 	RecoveryServicesVaults   types.List `tfsdk:"recovery_services_vaults"`
+	PreventDestroyIf         types.List `tfsdk:"prevent_destroy_if"`
+	Refresh                  types.List `tfsdk:"refresh"`
 }
 
 // FeaturesAttributes and the other block attribute vars are required for unit testing on the Load func
@@ -78,6 +83,8 @@ var FeaturesAttributes = map[string]attr.Type{
 	"machine_learning":           types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(MachineLearningAttributes)),
 	"recovery_service":           types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(RecoveryServiceAttributes)),
 	"recovery_services_vaults":   types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(RecoveryServiceVaultsAttributes)),
+	"prevent_destroy_if":         types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(PreventDestroyIfAttributes)),
+	"refresh":                    types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(RefreshAttributes)),
 }
 
 type APIManagement struct {
@@ -245,3 +252,21 @@ type RecoveryServiceVaults struct {
 var RecoveryServiceVaultsAttributes = map[string]attr.Type{
 	"recover_soft_deleted_backup_protected_vm": types.BoolType,
 }
+
+type PreventDestroyIf struct {
+	ContainsData         types.Bool `tfsdk:"contains_data"`
+	ManagementLockExists types.Bool `tfsdk:"management_lock_exists"`
+}
+
+var PreventDestroyIfAttributes = map[string]attr.Type{
+	"contains_data":          types.BoolType,
+	"management_lock_exists": types.BoolType,
+}
+
+type Refresh struct {
+	UseResourceGraph types.Bool `tfsdk:"use_resource_graph"`
+}
+
+var RefreshAttributes = map[string]attr.Type{
+	"use_resource_graph": types.BoolType,
+}
@@ -6,18 +6,23 @@ package framework
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	azurermprovider "github.com/hashicorp/terraform-provider-azurerm/internal/provider"
 	providerfunction "github.com/hashicorp/terraform-provider-azurerm/internal/provider/function"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
 type azureRmFrameworkProvider struct {
@@ -29,10 +34,13 @@ var _ provider.Provider = &azureRmFrameworkProvider{}
 
 var _ provider.ProviderWithFunctions = &azureRmFrameworkProvider{}
 
+var _ provider.ProviderWithListResources = &azureRmFrameworkProvider{}
+
 func (p *azureRmFrameworkProvider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		providerfunction.NewNormaliseResourceIDFunction,
 		providerfunction.NewParseResourceIDFunction,
+		providerfunction.NewPrivateDnsZoneNameFunction,
 	}
 }
 
@@ -93,6 +101,11 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 				Description: "The Hostname which should be used for the Azure Metadata Service.",
 			},
 
+			"metadata_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "The path to a file defining the Cloud Environment, which should be used to configure the Cloud Environment without connecting to the Azure Metadata Service.",
+			},
+
 			// Client Certificate specific fields
 			"client_certificate": schema.StringAttribute{
 				Optional:    true,
@@ -130,6 +143,11 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 				Description: "The URL for the OIDC provider from which to request an ID token. For use when authenticating as a Service Principal using OpenID Connect.",
 			},
 
+			"ado_pipeline_service_connection_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The Azure DevOps Pipeline Service Connection ID. For use when authenticating as a Service Principal using OpenID Connect from within an Azure DevOps Pipeline.",
+			},
+
 			"oidc_token": schema.StringAttribute{
 				Optional:    true,
 				Description: "The OIDC ID token for use when authenticating as a Service Principal using OpenID Connect.",
@@ -424,6 +442,27 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 								},
 							},
 						},
+						"refresh": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"use_resource_graph": schema.BoolAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+						"prevent_destroy_if": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"contains_data": schema.BoolAttribute{
+										Optional: true,
+									},
+									"management_lock_exists": schema.BoolAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
 						"machine_learning": schema.ListNestedBlock{
 							NestedObject: schema.NestedBlockObject{
 								Attributes: map[string]schema.Attribute{
@@ -457,6 +496,86 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 					},
 				},
 			},
+			"default_timeouts": schema.ListNestedBlock{
+				Description: "The timeouts which should be used by default for all Resources, in place of the timeouts defined by each Resource.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"create": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								defaultTimeoutDurationValidator(),
+							},
+						},
+						"read": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								defaultTimeoutDurationValidator(),
+							},
+						},
+						"update": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								defaultTimeoutDurationValidator(),
+							},
+						},
+						"delete": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								defaultTimeoutDurationValidator(),
+							},
+						},
+						"multiplier": schema.Float64Attribute{
+							Optional:    true,
+							Description: "The multiplier applied to the timeouts defined by each Resource, where a timeout isn't otherwise specified for that operation.",
+							Validators: []validator.Float64{
+								float64validator.AtLeast(1),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"resource": schema.ListNestedBlock{
+							Description: "The timeouts which should be used for a specific Resource Type.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtLeast(1),
+										},
+									},
+									"create": schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											defaultTimeoutDurationValidator(),
+										},
+									},
+									"read": schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											defaultTimeoutDurationValidator(),
+										},
+									},
+									"update": schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											defaultTimeoutDurationValidator(),
+										},
+									},
+									"delete": schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											defaultTimeoutDurationValidator(),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -474,6 +593,7 @@ func (p *azureRmFrameworkProvider) Configure(ctx context.Context, request provid
 
 		response.ResourceData = v
 		response.DataSourceData = v
+		response.ListResourceData = v
 	} else {
 		p.Load(ctx, &data, request.TerraformVersion, &response.Diagnostics)
 
@@ -491,3 +611,26 @@ func (p *azureRmFrameworkProvider) Resources(_ context.Context) []func() resourc
 	// We do not currently support any Native framework Resources
 	return nil
 }
+
+func (p *azureRmFrameworkProvider) ListResources(_ context.Context) []func() list.ListResource {
+	// List Resources are backed by the Plugin SDKv2 Resources (and their clients), so are only available when muxed
+	if p.V2Provider == nil {
+		return nil
+	}
+
+	output := make([]func() list.ListResource, 0)
+	for _, v := range azurermprovider.SupportedListResources() {
+		output = append(output, sdk.NewListResourceWrapper(v))
+	}
+	return output
+}
+
+// defaultTimeoutDurationValidator validates the timeouts within the `default_timeouts` block, matching the
+// validation used by the Plugin SDK Provider
+func defaultTimeoutDurationValidator() validator.String {
+	return frameworkhelpers.WrappedStringValidator{
+		Func:         timeouts.ValidateDefaultDuration,
+		Desc:         "must be a duration greater than 0, such as `30m` or `2h`",
+		MarkdownDesc: "must be a duration greater than 0, such as `30m` or `2h`",
+	}
+}
@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// resourceMoveServer wraps the Plugin SDK Provider Server to support moving the state of an existing Resource
+// into another Resource Type (using a `moved` block) - which the Plugin SDK doesn't support natively.
+type resourceMoveServer struct {
+	*schema.GRPCProviderServer
+
+	provider *schema.Provider
+	moves    []sdk.ResourceMove
+}
+
+func newResourceMoveServer(provider *schema.Provider, moves []sdk.ResourceMove) func() tfprotov5.ProviderServer {
+	return func() tfprotov5.ProviderServer {
+		return &resourceMoveServer{
+			GRPCProviderServer: schema.NewGRPCProviderServer(provider),
+			provider:           provider,
+			moves:              moves,
+		}
+	}
+}
+
+func (s *resourceMoveServer) MoveResourceState(ctx context.Context, request *tfprotov5.MoveResourceStateRequest) (*tfprotov5.MoveResourceStateResponse, error) {
+	if request == nil {
+		return nil, fmt.Errorf("MoveResourceState request is nil")
+	}
+
+	move := s.resourceMove(request.SourceTypeName, request.TargetTypeName)
+	if move == nil || !isAzureRMProviderAddress(request.SourceProviderAddress) {
+		return s.GRPCProviderServer.MoveResourceState(ctx, request)
+	}
+
+	response := &tfprotov5.MoveResourceStateResponse{}
+	targetState, targetIdentity, err := s.moveResourceState(ctx, *move, request)
+	if err != nil {
+		response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Moving Resource State",
+			Detail:   fmt.Sprintf("moving %q to %q: %+v", request.SourceTypeName, request.TargetTypeName, err),
+		})
+		return response, nil
+	}
+
+	response.TargetState = targetState
+	response.TargetIdentity = targetIdentity
+	return response, nil
+}
+
+func (s *resourceMoveServer) resourceMove(sourceResourceType, targetResourceType string) *sdk.ResourceMove {
+	for _, move := range s.moves {
+		if move.SourceResourceType == sourceResourceType && move.TargetResourceType == targetResourceType {
+			return &move
+		}
+	}
+
+	return nil
+}
+
+func (s *resourceMoveServer) moveResourceState(ctx context.Context, move sdk.ResourceMove, request *tfprotov5.MoveResourceStateRequest) (*tfprotov5.DynamicValue, *tfprotov5.ResourceIdentityData, error) {
+	resource, ok := s.provider.ResourcesMap[request.TargetTypeName]
+	if !ok {
+		return nil, nil, fmt.Errorf("the Resource Type %q was not found", request.TargetTypeName)
+	}
+
+	if request.SourceState == nil || len(request.SourceState.JSON) == 0 {
+		return nil, nil, fmt.Errorf("the state for the %q was empty", request.SourceTypeName)
+	}
+
+	sourceState := sdk.ResourceMoveSourceState{}
+	if err := json.Unmarshal(request.SourceState.JSON, &sourceState); err != nil {
+		return nil, nil, fmt.Errorf("decoding the state for the %q: %+v", request.SourceTypeName, err)
+	}
+
+	targetState, err := move.MoveFunc(ctx, sourceState)
+	if err != nil {
+		return nil, nil, err
+	}
+	if targetState == nil || targetState.ResourceId == nil {
+		return nil, nil, fmt.Errorf("the Resource ID for the %q was not returned", request.TargetTypeName)
+	}
+
+	state, err := resourceMoveTargetStateValue(resource.ProtoSchema(ctx)(), *targetState)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	identitySchema := resource.ProtoIdentitySchema(ctx)
+	if identitySchema == nil {
+		return state, nil, nil
+	}
+
+	attributes, err := pluginsdk.IdentityAttributesFromResourceId(targetState.ResourceId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("building identity for %s: %+v", targetState.ResourceId, err)
+	}
+	identityType := identitySchema().ValueType()
+	identityValues := make(map[string]tftypes.Value)
+	for name, attributeType := range identityType.(tftypes.Object).AttributeTypes {
+		if v, ok := attributes[name]; ok {
+			identityValues[name] = tftypes.NewValue(attributeType, v)
+			continue
+		}
+		identityValues[name] = tftypes.NewValue(attributeType, nil)
+	}
+	identity, err := tfprotov5.NewDynamicValue(identityType, tftypes.NewValue(identityType, identityValues))
+	if err != nil {
+		return nil, nil, fmt.Errorf("encoding identity: %+v", err)
+	}
+
+	return state, &tfprotov5.ResourceIdentityData{
+		IdentityData: &identity,
+	}, nil
+}
+
+// resourceMoveTargetStateValue returns the state for the Target Resource, containing the Resource ID and the
+// specified attributes - the remaining attributes are populated when the Resource is subsequently refreshed.
+func resourceMoveTargetStateValue(resourceSchema *tfprotov5.Schema, input sdk.ResourceMoveTargetState) (*tfprotov5.DynamicValue, error) {
+	objectType := resourceSchema.ValueType().(tftypes.Object)
+
+	values := make(map[string]tftypes.Value)
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+
+	// the Plugin SDK represents an unset List/Set block as an empty collection, rather than null
+	for _, block := range resourceSchema.Block.BlockTypes {
+		switch block.Nesting {
+		case tfprotov5.SchemaNestedBlockNestingModeList, tfprotov5.SchemaNestedBlockNestingModeSet:
+			values[block.TypeName] = tftypes.NewValue(objectType.AttributeTypes[block.TypeName], []tftypes.Value{})
+		}
+	}
+
+	values["id"] = tftypes.NewValue(tftypes.String, input.ResourceId.ID())
+	for name, v := range input.Attributes {
+		attributeType, ok := objectType.AttributeTypes[name]
+		if !ok {
+			return nil, fmt.Errorf("the attribute %q was not found in the schema", name)
+		}
+		if err := tftypes.ValidateValue(attributeType, v); err != nil {
+			return nil, fmt.Errorf("setting %q: %+v", name, err)
+		}
+		values[name] = tftypes.NewValue(attributeType, v)
+	}
+
+	state, err := tfprotov5.NewDynamicValue(objectType, tftypes.NewValue(objectType, values))
+	if err != nil {
+		return nil, fmt.Errorf("encoding state: %+v", err)
+	}
+
+	return &state, nil
+}
+
+// isAzureRMProviderAddress returns whether the specified Provider Address is for this Provider, for
+// example `registry.terraform.io/hashicorp/azurerm`
+func isAzureRMProviderAddress(input string) bool {
+	return strings.HasSuffix(strings.ToLower(input), "hashicorp/azurerm")
+}
@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestResourceMoveServer(t *testing.T) {
+	provider := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"azurerm_example": {
+				Identity: pluginsdk.GenerateIdentitySchema(&commonids.ResourceGroupId{}),
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"password": {
+						Type:      schema.TypeString,
+						Optional:  true,
+						Sensitive: true,
+					},
+					"nested": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"value": {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	moves := []sdk.ResourceMove{
+		{
+			SourceResourceType: "azurerm_legacy_example",
+			TargetResourceType: "azurerm_example",
+			MoveFunc: func(ctx context.Context, sourceState sdk.ResourceMoveSourceState) (*sdk.ResourceMoveTargetState, error) {
+				id, err := commonids.ParseResourceGroupIDInsensitively(sourceState.String("id"))
+				if err != nil {
+					return nil, err
+				}
+				if sourceState.IsEmpty("profile.0.password") {
+					return nil, fmt.Errorf("the password was not found")
+				}
+
+				return &sdk.ResourceMoveTargetState{
+					ResourceId: id,
+					Attributes: map[string]interface{}{
+						"password": sourceState.String("profile.0.password"),
+					},
+				}, nil
+			},
+		},
+	}
+	server := newResourceMoveServer(provider, moves)()
+
+	testData := []struct {
+		name            string
+		providerAddress string
+		sourceTypeName  string
+		sourceState     string
+		expectError     bool
+		expected        map[string]string
+	}{
+		{
+			name:            "moved",
+			providerAddress: "registry.terraform.io/hashicorp/azurerm",
+			sourceTypeName:  "azurerm_legacy_example",
+			sourceState:     `{"id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example", "profile": [{"password": "p@ssw0rd"}]}`,
+			expected: map[string]string{
+				"id":       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example",
+				"password": "p@ssw0rd",
+			},
+		},
+		{
+			name:            "move func error",
+			providerAddress: "registry.terraform.io/hashicorp/azurerm",
+			sourceTypeName:  "azurerm_legacy_example",
+			sourceState:     `{"id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example", "profile": []}`,
+			expectError:     true,
+		},
+		{
+			name:            "unsupported source resource type",
+			providerAddress: "registry.terraform.io/hashicorp/azurerm",
+			sourceTypeName:  "azurerm_other_example",
+			sourceState:     `{"id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example"}`,
+			expectError:     true,
+		},
+		{
+			name:            "another provider",
+			providerAddress: "registry.terraform.io/hashicorp/random",
+			sourceTypeName:  "azurerm_legacy_example",
+			sourceState:     `{"id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example"}`,
+			expectError:     true,
+		},
+	}
+
+	ctx := context.TODO()
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		response, err := server.MoveResourceState(ctx, &tfprotov5.MoveResourceStateRequest{
+			SourceProviderAddress: v.providerAddress,
+			SourceTypeName:        v.sourceTypeName,
+			SourceState: &tfprotov5.RawState{
+				JSON: []byte(v.sourceState),
+			},
+			TargetTypeName: "azurerm_example",
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+
+		hasError := false
+		for _, diag := range response.Diagnostics {
+			if diag.Severity == tfprotov5.DiagnosticSeverityError {
+				hasError = true
+			}
+		}
+		if v.expectError {
+			if !hasError {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			continue
+		}
+		if hasError {
+			t.Fatalf("unexpected error diagnostics: %+v", response.Diagnostics)
+		}
+
+		stateType := provider.ResourcesMap["azurerm_example"].ProtoSchema(ctx)().ValueType()
+		state, err := response.TargetState.Unmarshal(stateType)
+		if err != nil {
+			t.Fatalf("unmarshaling state: %+v", err)
+		}
+		values := make(map[string]tftypes.Value)
+		if err := state.As(&values); err != nil {
+			t.Fatalf("converting state: %+v", err)
+		}
+		for key, expected := range v.expected {
+			var actual *string
+			if err := values[key].As(&actual); err != nil {
+				t.Fatalf("converting %q: %+v", key, err)
+			}
+			if pointer.From(actual) != expected {
+				t.Fatalf("expected %q to be %q but got %q", key, expected, pointer.From(actual))
+			}
+		}
+		if !values["name"].IsNull() {
+			t.Fatalf("expected `name` to be null but got %+v", values["name"])
+		}
+
+		if response.TargetIdentity == nil {
+			t.Fatalf("expected an identity but didn't get one")
+		}
+	}
+}
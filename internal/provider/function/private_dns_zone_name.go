@@ -0,0 +1,754 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type PrivateDnsZoneNameFunction struct{}
+
+var _ function.Function = PrivateDnsZoneNameFunction{}
+
+func NewPrivateDnsZoneNameFunction() function.Function {
+	return &PrivateDnsZoneNameFunction{}
+}
+
+func (p PrivateDnsZoneNameFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "private_dns_zone_name"
+}
+
+func (p PrivateDnsZoneNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "private_dns_zone_name",
+		Description:         "Returns the names of the Private DNS Zones used by a Private Link sub-resource within the Azure Environment",
+		MarkdownDescription: "Returns the names of the Private DNS Zones used by a Private Link sub-resource within the Azure Environment",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "subresource",
+				Description:         "The name of the Private Link sub-resource, optionally prefixed with the Resource Type, e.g. blob or Microsoft.DocumentDB/databaseAccounts/Sql",
+				MarkdownDescription: "The name of the Private Link sub-resource, optionally prefixed with the Resource Type, e.g. `blob` or `Microsoft.DocumentDB/databaseAccounts/Sql`",
+			},
+			function.StringParameter{
+				Name:                "location",
+				Description:         "The Azure Region of the Resource, used for regional Private DNS Zones",
+				MarkdownDescription: "The Azure Region of the Resource, used for regional Private DNS Zones",
+			},
+			function.StringParameter{
+				Name:                "environment",
+				Description:         "The Azure Environment, one of public, usgovernment or china",
+				MarkdownDescription: "The Azure Environment, one of `public`, `usgovernment` or `china`",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (p PrivateDnsZoneNameFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var subresource, loc, environmentName string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &subresource, &loc, &environmentName))
+
+	if response.Error != nil {
+		return
+	}
+
+	// Provider-defined Functions don't have access to the Provider configuration, so the Environment must be specified
+	env, err := environments.FromName(environmentName)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(2, err.Error())
+		return
+	}
+
+	result, err := privateDnsZoneNames(env, subresource, loc)
+	if err != nil {
+		response.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, result))
+}
+
+// privateDnsZoneNames returns the names of the Private DNS Zones for the sub-resource in the Environment
+func privateDnsZoneNames(env *environments.Environment, subresource string, loc string) ([]string, error) {
+	resourceType := ""
+	if index := strings.LastIndex(subresource, "/"); index != -1 {
+		resourceType = subresource[:index]
+		subresource = subresource[index+1:]
+	}
+
+	matches := make([]privateDnsZoneEntry, 0)
+	for _, entry := range privateDnsZoneEntries {
+		// the sub-resource names are case-sensitive, since e.g. Cosmos DB uses `Table` whereas Storage uses `table`
+		if entry.subresource != subresource || (resourceType != "" && !strings.EqualFold(entry.resourceType, resourceType)) {
+			continue
+		}
+		matches = append(matches, entry)
+	}
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("the Private Link sub-resource %q is not supported", subresource)
+	}
+
+	var result []string
+	for _, entry := range matches {
+		zones, err := entry.zoneNames(env, loc)
+		if err != nil {
+			return nil, err
+		}
+
+		if result != nil && strings.Join(result, ",") != strings.Join(zones, ",") {
+			resourceTypes := make([]string, 0)
+			for _, match := range matches {
+				resourceTypes = append(resourceTypes, fmt.Sprintf("%s/%s", match.resourceType, match.subresource))
+			}
+			sort.Strings(resourceTypes)
+			return nil, fmt.Errorf("the Private Link sub-resource %q is used by multiple Resource Types, specify one of: %s", subresource, strings.Join(resourceTypes, ", "))
+		}
+		result = zones
+	}
+
+	return result, nil
+}
+
+type privateDnsZoneEntry struct {
+	resourceType string
+	subresource  string
+	zones        []privateDnsZone
+}
+
+type privateDnsZone struct {
+	// name is the name of the Private DNS Zone, where `{suffix}` is replaced with the Domain Suffix and `{location}`
+	// with the Location
+	name         string
+	domainSuffix func(env *environments.Environment) (*string, bool)
+}
+
+func (e privateDnsZoneEntry) zoneNames(env *environments.Environment, loc string) ([]string, error) {
+	zones := make([]string, 0)
+	for _, zone := range e.zones {
+		suffix, ok := zone.domainSuffix(env)
+		if !ok || suffix == nil {
+			return nil, fmt.Errorf("the Private Link sub-resource %q of %q is not available in the %q Environment", e.subresource, e.resourceType, env.Name)
+		}
+
+		name := strings.ReplaceAll(zone.name, "{suffix}", *suffix)
+		if strings.Contains(name, "{location}") {
+			if loc == "" {
+				return nil, fmt.Errorf("a `location` must be specified for the Private Link sub-resource %q of %q since it uses regional Private DNS Zones", e.subresource, e.resourceType)
+			}
+			name = strings.ReplaceAll(name, "{location}", location.Normalize(loc))
+		}
+		zones = append(zones, name)
+	}
+
+	return zones, nil
+}
+
+// apiDomainSuffix uses the Domain Suffix of the data plane API defined by the Environment
+func apiDomainSuffix(api func(env *environments.Environment) environments.Api) func(env *environments.Environment) (*string, bool) {
+	return func(env *environments.Environment) (*string, bool) {
+		if v := api(env); v != nil {
+			return v.DomainSuffix()
+		}
+		return nil, false
+	}
+}
+
+// cloudDomainSuffix uses a Domain Suffix which isn't defined by the Environment, keyed by the name of the Environment
+func cloudDomainSuffix(suffixes map[string]string) func(env *environments.Environment) (*string, bool) {
+	return func(env *environments.Environment) (*string, bool) {
+		name := env.Name
+		switch name {
+		case "Canary":
+			name = environments.AzurePublicCloud
+		case "USGovernmentL5":
+			name = environments.AzureUSGovernmentCloud
+		}
+
+		v, ok := suffixes[name]
+		return &v, ok
+	}
+}
+
+func storagePrivateDnsZones(service string) []privateDnsZone {
+	return []privateDnsZone{
+		{
+			name: fmt.Sprintf("privatelink.%s.{suffix}", service),
+			domainSuffix: apiDomainSuffix(func(env *environments.Environment) environments.Api {
+				return env.Storage
+			}),
+		},
+	}
+}
+
+func cosmosDBPrivateDnsZones(api string) []privateDnsZone {
+	return []privateDnsZone{
+		{
+			name: fmt.Sprintf("privatelink.%s.cosmos.{suffix}", api),
+			domainSuffix: cloudDomainSuffix(map[string]string{
+				environments.AzurePublicCloud:       "azure.com",
+				environments.AzureUSGovernmentCloud: "azure.us",
+				environments.AzureChinaCloud:        "azure.cn",
+			}),
+		},
+	}
+}
+
+func synapsePrivateDnsZones(name string) []privateDnsZone {
+	return []privateDnsZone{
+		{
+			name: name,
+			domainSuffix: cloudDomainSuffix(map[string]string{
+				environments.AzurePublicCloud:       "azuresynapse.net",
+				environments.AzureUSGovernmentCloud: "azuresynapse.usgovcloudapi.net",
+				environments.AzureChinaCloud:        "azuresynapse.azure.cn",
+			}),
+		},
+	}
+}
+
+var (
+	keyVaultPrivateDnsZones = []privateDnsZone{
+		{
+			name: "privatelink.{suffix}",
+			domainSuffix: cloudDomainSuffix(map[string]string{
+				environments.AzurePublicCloud:       "vaultcore.azure.net",
+				environments.AzureUSGovernmentCloud: "vaultcore.usgovcloudapi.net",
+				environments.AzureChinaCloud:        "vaultcore.azure.cn",
+			}),
+		},
+	}
+
+	serviceBusPrivateDnsZones = []privateDnsZone{
+		{
+			name: "privatelink.{suffix}",
+			domainSuffix: apiDomainSuffix(func(env *environments.Environment) environments.Api {
+				return env.ServiceBus
+			}),
+		},
+	}
+
+	postgresqlPrivateDnsZones = []privateDnsZone{
+		{
+			name: "privatelink.{suffix}",
+			domainSuffix: apiDomainSuffix(func(env *environments.Environment) environments.Api {
+				return env.Postgresql
+			}),
+		},
+	}
+
+	mysqlPrivateDnsZones = []privateDnsZone{
+		{
+			name: "privatelink.{suffix}",
+			domainSuffix: apiDomainSuffix(func(env *environments.Environment) environments.Api {
+				return env.MySql
+			}),
+		},
+	}
+
+	eventGridPrivateDnsZones = []privateDnsZone{
+		{
+			name: "privatelink.eventgrid.{suffix}",
+			domainSuffix: cloudDomainSuffix(map[string]string{
+				environments.AzurePublicCloud:       "azure.net",
+				environments.AzureUSGovernmentCloud: "azure.us",
+				environments.AzureChinaCloud:        "azure.cn",
+			}),
+		},
+	}
+
+	automationPrivateDnsZones = []privateDnsZone{
+		{
+			name: "privatelink.{suffix}",
+			domainSuffix: cloudDomainSuffix(map[string]string{
+				environments.AzurePublicCloud:       "azure-automation.net",
+				environments.AzureUSGovernmentCloud: "azure-automation.us",
+				environments.AzureChinaCloud:        "azure-automation.cn",
+			}),
+		},
+	}
+
+	databricksPrivateDnsZones = []privateDnsZone{
+		{
+			name: "privatelink.{suffix}",
+			domainSuffix: cloudDomainSuffix(map[string]string{
+				environments.AzurePublicCloud:       "azuredatabricks.net",
+				environments.AzureUSGovernmentCloud: "databricks.azure.us",
+				environments.AzureChinaCloud:        "databricks.azure.cn",
+			}),
+		},
+	}
+)
+
+// privateDnsZoneEntries maps the Private Link sub-resources to the Private DNS Zones they use, see
+// https://learn.microsoft.com/azure/private-link/private-endpoint-dns
+var privateDnsZoneEntries = []privateDnsZoneEntry{
+	{
+		resourceType: "Microsoft.ApiManagement/service",
+		subresource:  "Gateway",
+		zones: []privateDnsZone{
+			{
+				name: "privatelink.{suffix}",
+				domainSuffix: apiDomainSuffix(func(env *environments.Environment) environments.Api {
+					return env.ApiManagement
+				}),
+			},
+		},
+	},
+	{
+		resourceType: "Microsoft.AppConfiguration/configurationStores",
+		subresource:  "configurationStores",
+		zones: []privateDnsZone{
+			{
+				name: "privatelink.{suffix}",
+				domainSuffix: apiDomainSuffix(func(env *environments.Environment) environments.Api {
+					return env.AppConfiguration
+				}),
+			},
+		},
+	},
+	{
+		resourceType: "Microsoft.Automation/automationAccounts",
+		subresource:  "Webhook",
+		zones:        automationPrivateDnsZones,
+	},
+	{
+		resourceType: "Microsoft.Automation/automationAccounts",
+		subresource:  "DSCAndHybridWorker",
+		zones:        automationPrivateDnsZones,
+	},
+	{
+		resourceType: "Microsoft.CognitiveServices/accounts",
+		subresource:  "account",
+		zones: []privateDnsZone{
+			{
+				name: "privatelink.cognitiveservices.{suffix}",
+				domainSuffix: cloudDomainSuffix(map[string]string{
+					environments.AzurePublicCloud:       "azure.com",
+					environments.AzureUSGovernmentCloud: "azure.us",
+					environments.AzureChinaCloud:        "azure.cn",
+				}),
+			},
+		},
+	},
+	{
+		resourceType: "Microsoft.ContainerRegistry/registries",
+		subresource:  "registry",
+		zones: []privateDnsZone{
+			{
+				name: "privatelink.{suffix}",
+				domainSuffix: apiDomainSuffix(func(env *environments.Environment) environments.Api {
+					return env.ContainerRegistry
+				}),
+			},
+			{
+				// used by the dedicated data endpoints of the Container Registry
+				name: "{location}.data.privatelink.{suffix}",
+				domainSuffix: apiDomainSuffix(func(env *environments.Environment) environments.Api {
+					return env.ContainerRegistry
+				}),
+			},
+		},
+	},
+	{
+		resourceType: "Microsoft.ContainerService/managedClusters",
+		subresource:  "management",
+		zones: []privateDnsZone{
+			{
+				name: "privatelink.{location}.{suffix}",
+				domainSuffix: cloudDomainSuffix(map[string]string{
+					environments.AzurePublicCloud:       "azmk8s.io",
+					environments.AzureUSGovernmentCloud: "cx.aks.containerservice.azure.us",
+					environments.AzureChinaCloud:        "cx.prod.service.azk8s.cn",
+				}),
+			},
+		},
+	},
+	{
+		resourceType: "Microsoft.DataFactory/factories",
+		subresource:  "dataFactory",
+		zones: []privateDnsZone{
+			{
+				name: "privatelink.datafactory.{suffix}",
+				domainSuffix: cloudDomainSuffix(map[string]string{
+					environments.AzurePublicCloud:       "azure.net",
+					environments.AzureUSGovernmentCloud: "azure.us",
+					environments.AzureChinaCloud:        "azure.cn",
+				}),
+			},
+		},
+	},
+	{
+		resourceType: "Microsoft.DataFactory/factories",
+		subresource:  "portal",
+		zones: []privateDnsZone{
+			{
+				name: "privatelink.adf.{suffix}",
+				domainSuffix: cloudDomainSuffix(map[string]string{
+					environments.AzurePublicCloud:       "azure.com",
+					environments.AzureUSGovernmentCloud: "azure.us",
+					environments.AzureChinaCloud:        "azure.cn",
+				}),
+			},
+		},
+	},
+	{
+		resourceType: "Microsoft.Databricks/workspaces",
+		subresource:  "databricks_ui_api",
+		zones:        databricksPrivateDnsZones,
+	},
+	{
+		resourceType: "Microsoft.Databricks/workspaces",
+		subresource:  "browser_authentication",
+		zones:        databricksPrivateDnsZones,
+	},
+	{
+		resourceType: "Microsoft.DBforMariaDB/servers",
+		subresource:  "mariadbServer",
+		zones: []privateDnsZone{
+			{
+				name: "privatelink.{suffix}",
+				domainSuffix: apiDomainSuffix(func(env *environments.Environment) environments.Api {
+					return env.MariaDB
+				}),
+			},
+		},
+	},
+	{
+		resourceType: "Microsoft.DBforMySQL/servers",
+		subresource:  "mysqlServer",
+		zones:        mysqlPrivateDnsZones,
+	},
+	{
+		resourceType: "Microsoft.DBforMySQL/flexibleServers",
+		subresource:  "mysqlServer",
+		zones:        mysqlPrivateDnsZones,
+	},
+	{
+		resourceType: "Microsoft.DBforPostgreSQL/servers",
+		subresource:  "postgresqlServer",
+		zones:        postgresqlPrivateDnsZones,
+	},
+	{
+		resourceType: "Microsoft.DBforPostgreSQL/flexibleServers",
+		subresource:  "postgresqlServer",
+		zones:        postgresqlPrivateDnsZones,
+	},
+	{
+		resourceType: "Microsoft.Devices/IotHubs",
+		subresource:  "iotHub",
+		zones: []privateDnsZone{
+			{
+				name: "privatelink.{suffix}",
+				domainSuffix: cloudDomainSuffix(map[string]string{
+					environments.AzurePublicCloud:       "azure-devices.net",
+					environments.AzureUSGovernmentCloud: "azure-devices.us",
+					environments.AzureChinaCloud:        "azure-devices.cn",
+				}),
+			},
+		},
+	},
+	{
+		resourceType: "Microsoft.DocumentDB/databaseAccounts",
+		subresource:  "Sql",
+		zones: []privateDnsZone{
+			{
+				name: "privatelink.{suffix}",
+				domainSuffix: apiDomainSuffix(func(env *environments.Environment) environments.Api {
+					return env.CosmosDB
+				}),
+			},
+		},
+	},
+	{
+		resourceType: "Microsoft.DocumentDB/databaseAccounts",
+		subresource:  "MongoDB",
+		zones:        cosmosDBPrivateDnsZones("mongo"),
+	},
+	{
+		resourceType: "Microsoft.DocumentDB/databaseAccounts",
+		subresource:  "Cassandra",
+		zones:        cosmosDBPrivateDnsZones("cassandra"),
+	},
+	{
+		resourceType: "Microsoft.DocumentDB/databaseAccounts",
+		subresource:  "Gremlin",
+		zones:        cosmosDBPrivateDnsZones("gremlin"),
+	},
+	{
+		resourceType: "Microsoft.DocumentDB/databaseAccounts",
+		subresource:  "Table",
+		zones:        cosmosDBPrivateDnsZones("table"),
+	},
+	{
+		resourceType: "Microsoft.EventGrid/topics",
+		subresource:  "topic",
+		zones:        eventGridPrivateDnsZones,
+	},
+	{
+		resourceType: "Microsoft.EventGrid/domains",
+		subresource:  "domain",
+		zones:        eventGridPrivateDnsZones,
+	},
+	{
+		resourceType: "Microsoft.EventHub/namespaces",
+		subresource:  "namespace",
+		zones:        serviceBusPrivateDnsZones,
+	},
+	{
+		resourceType: "Microsoft.Insights/privateLinkScopes",
+		subresource:  "azuremonitor",
+		zones: []privateDnsZone{
+			{
+				name: "privatelink.monitor.{suffix}",
+				domainSuffix: cloudDomainSuffix(map[string]string{
+					environments.AzurePublicCloud:       "azure.com",
+					environments.AzureUSGovernmentCloud: "azure.us",
+					environments.AzureChinaCloud:        "azure.cn",
+				}),
+			},
+			{
+				name: "privatelink.oms.opinsights.{suffix}",
+				domainSuffix: cloudDomainSuffix(map[string]string{
+					environments.AzurePublicCloud:       "azure.com",
+					environments.AzureUSGovernmentCloud: "azure.us",
+					environments.AzureChinaCloud:        "azure.cn",
+				}),
+			},
+			{
+				name: "privatelink.ods.opinsights.{suffix}",
+				domainSuffix: cloudDomainSuffix(map[string]string{
+					environments.AzurePublicCloud:       "azure.com",
+					environments.AzureUSGovernmentCloud: "azure.us",
+					environments.AzureChinaCloud:        "azure.cn",
+				}),
+			},
+			{
+				name: "privatelink.agentsvc.{suffix}",
+				domainSuffix: cloudDomainSuffix(map[string]string{
+					environments.AzurePublicCloud:       "azure-automation.net",
+					environments.AzureUSGovernmentCloud: "azure-automation.us",
+					environments.AzureChinaCloud:        "azure-automation.cn",
+				}),
+			},
+			storagePrivateDnsZones("blob")[0],
+		},
+	},
+	{
+		resourceType: "Microsoft.KeyVault/vaults",
+		subresource:  "vault",
+		zones:        keyVaultPrivateDnsZones,
+	},
+	{
+		resourceType: "Microsoft.KeyVault/managedHSMs",
+		subresource:  "managedhsm",
+		zones: []privateDnsZone{
+			{
+				name: "privatelink.{suffix}",
+				domainSuffix: apiDomainSuffix(func(env *environments.Environment) environments.Api {
+					return env.ManagedHSM
+				}),
+			},
+		},
+	},
+	{
+		resourceType: "Microsoft.Kusto/clusters",
+		subresource:  "cluster",
+		zones: []privateDnsZone{
+			{
+				name: "privatelink.{location}.{suffix}",
+				domainSuffix: cloudDomainSuffix(map[string]string{
+					environments.AzurePublicCloud:       "kusto.windows.net",
+					environments.AzureUSGovernmentCloud: "kusto.usgovcloudapi.net",
+					environments.AzureChinaCloud:        "kusto.chinacloudapi.cn",
+				}),
+			},
+		},
+	},
+	{
+		resourceType: "Microsoft.MachineLearningServices/workspaces",
+		subresource:  "amlworkspace",
+		zones: []privateDnsZone{
+			{
+				name: "privatelink.{suffix}",
+				domainSuffix: cloudDomainSuffix(map[string]string{
+					environments.AzurePublicCloud:       "api.azureml.ms",
+					environments.AzureUSGovernmentCloud: "api.ml.azure.us",
+					environments.AzureChinaCloud:        "api.ml.azure.cn",
+				}),
+			},
+			{
+				name: "privatelink.{suffix}",
+				domainSuffix: cloudDomainSuffix(map[string]string{
+					environments.AzurePublicCloud:       "notebooks.azure.net",
+					environments.AzureUSGovernmentCloud: "notebooks.usgovcloudapi.net",
+					environments.AzureChinaCloud:        "notebooks.chinacloudapi.cn",
+				}),
+			},
+		},
+	},
+	{
+		resourceType: "Microsoft.Relay/namespaces",
+		subresource:  "namespace",
+		zones:        serviceBusPrivateDnsZones,
+	},
+	{
+		resourceType: "Microsoft.Search/searchServices",
+		subresource:  "searchService",
+		zones: []privateDnsZone{
+			{
+				name: "privatelink.{suffix}",
+				domainSuffix: cloudDomainSuffix(map[string]string{
+					environments.AzurePublicCloud:       "search.windows.net",
+					environments.AzureUSGovernmentCloud: "search.windows.us",
+					environments.AzureChinaCloud:        "search.azure.cn",
+				}),
+			},
+		},
+	},
+	{
+		resourceType: "Microsoft.ServiceBus/namespaces",
+		subresource:  "namespace",
+		zones:        serviceBusPrivateDnsZones,
+	},
+	{
+		resourceType: "Microsoft.SignalRService/SignalR",
+		subresource:  "signalr",
+		zones: []privateDnsZone{
+			{
+				name: "privatelink.{suffix}",
+				domainSuffix: cloudDomainSuffix(map[string]string{
+					environments.AzurePublicCloud:       "service.signalr.net",
+					environments.AzureUSGovernmentCloud: "signalr.azure.us",
+					environments.AzureChinaCloud:        "signalr.azure.cn",
+				}),
+			},
+		},
+	},
+	{
+		resourceType: "Microsoft.SignalRService/WebPubSub",
+		subresource:  "webpubsub",
+		zones: []privateDnsZone{
+			{
+				name: "privatelink.webpubsub.{suffix}",
+				domainSuffix: cloudDomainSuffix(map[string]string{
+					environments.AzurePublicCloud:       "azure.com",
+					environments.AzureUSGovernmentCloud: "azure.us",
+					environments.AzureChinaCloud:        "azure.cn",
+				}),
+			},
+		},
+	},
+	{
+		resourceType: "Microsoft.Sql/servers",
+		subresource:  "sqlServer",
+		zones: []privateDnsZone{
+			{
+				name: "privatelink.{suffix}",
+				domainSuffix: apiDomainSuffix(func(env *environments.Environment) environments.Api {
+					return env.Sql
+				}),
+			},
+		},
+	},
+	{
+		resourceType: "Microsoft.Storage/storageAccounts",
+		subresource:  "blob",
+		zones:        storagePrivateDnsZones("blob"),
+	},
+	{
+		resourceType: "Microsoft.Storage/storageAccounts",
+		subresource:  "blob_secondary",
+		zones:        storagePrivateDnsZones("blob"),
+	},
+	{
+		resourceType: "Microsoft.Storage/storageAccounts",
+		subresource:  "dfs",
+		zones:        storagePrivateDnsZones("dfs"),
+	},
+	{
+		resourceType: "Microsoft.Storage/storageAccounts",
+		subresource:  "dfs_secondary",
+		zones:        storagePrivateDnsZones("dfs"),
+	},
+	{
+		resourceType: "Microsoft.Storage/storageAccounts",
+		subresource:  "file",
+		zones:        storagePrivateDnsZones("file"),
+	},
+	{
+		resourceType: "Microsoft.Storage/storageAccounts",
+		subresource:  "queue",
+		zones:        storagePrivateDnsZones("queue"),
+	},
+	{
+		resourceType: "Microsoft.Storage/storageAccounts",
+		subresource:  "queue_secondary",
+		zones:        storagePrivateDnsZones("queue"),
+	},
+	{
+		resourceType: "Microsoft.Storage/storageAccounts",
+		subresource:  "table",
+		zones:        storagePrivateDnsZones("table"),
+	},
+	{
+		resourceType: "Microsoft.Storage/storageAccounts",
+		subresource:  "table_secondary",
+		zones:        storagePrivateDnsZones("table"),
+	},
+	{
+		resourceType: "Microsoft.Storage/storageAccounts",
+		subresource:  "web",
+		zones:        storagePrivateDnsZones("web"),
+	},
+	{
+		resourceType: "Microsoft.Storage/storageAccounts",
+		subresource:  "web_secondary",
+		zones:        storagePrivateDnsZones("web"),
+	},
+	{
+		resourceType: "Microsoft.Synapse/workspaces",
+		subresource:  "Sql",
+		zones:        synapsePrivateDnsZones("privatelink.sql.{suffix}"),
+	},
+	{
+		resourceType: "Microsoft.Synapse/workspaces",
+		subresource:  "SqlOnDemand",
+		zones:        synapsePrivateDnsZones("privatelink.sql.{suffix}"),
+	},
+	{
+		resourceType: "Microsoft.Synapse/workspaces",
+		subresource:  "Dev",
+		zones:        synapsePrivateDnsZones("privatelink.dev.{suffix}"),
+	},
+	{
+		resourceType: "Microsoft.Synapse/privateLinkHubs",
+		subresource:  "Web",
+		zones:        synapsePrivateDnsZones("privatelink.{suffix}"),
+	},
+	{
+		resourceType: "Microsoft.Web/sites",
+		subresource:  "sites",
+		zones: []privateDnsZone{
+			{
+				name: "privatelink.{suffix}",
+				domainSuffix: cloudDomainSuffix(map[string]string{
+					environments.AzurePublicCloud:       "azurewebsites.net",
+					environments.AzureUSGovernmentCloud: "azurewebsites.us",
+					environments.AzureChinaCloud:        "chinacloudsites.cn",
+				}),
+			},
+		},
+	},
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestProviderFunctionPrivateDnsZoneName_basic(t *testing.T) {
+	if !features.FourPointOhBeta() {
+		t.Skipf("skipping test due to missing feature flag")
+	}
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "azurerm" {
+  features {}
+}
+
+output "blob" {
+  value = provider::azurerm::private_dns_zone_name("blob", "westeurope", "public")[0]
+}
+
+output "cosmos_sql" {
+  value = provider::azurerm::private_dns_zone_name("Microsoft.DocumentDB/databaseAccounts/Sql", "westeurope", "public")[0]
+}
+
+output "vault_china" {
+  value = provider::azurerm::private_dns_zone_name("vault", "chinanorth3", "china")[0]
+}
+
+output "registry_data" {
+  value = provider::azurerm::private_dns_zone_name("registry", "West Europe", "public")[1]
+}
+
+output "aks_usgovernment" {
+  value = provider::azurerm::private_dns_zone_name("management", "usgovvirginia", "usgovernment")[0]
+}
+`,
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("blob", "privatelink.blob.core.windows.net"),
+					acceptance.TestCheckOutput("cosmos_sql", "privatelink.documents.azure.com"),
+					acceptance.TestCheckOutput("vault_china", "privatelink.vaultcore.azure.cn"),
+					acceptance.TestCheckOutput("registry_data", "westeurope.data.privatelink.azurecr.io"),
+					acceptance.TestCheckOutput("aks_usgovernment", "privatelink.usgovvirginia.cx.aks.containerservice.azure.us"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionPrivateDnsZoneName_ambiguous(t *testing.T) {
+	if !features.FourPointOhBeta() {
+		t.Skipf("skipping test due to missing feature flag")
+	}
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "azurerm" {
+  features {}
+}
+
+output "sql" {
+  value = provider::azurerm::private_dns_zone_name("Sql", "westeurope", "public")
+}
+`,
+				ExpectError: regexp.MustCompile("is used by multiple Resource Types"),
+			},
+		},
+	})
+}
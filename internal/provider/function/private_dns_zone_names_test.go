@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+func TestPrivateDnsZoneNames(t *testing.T) {
+	testData := []struct {
+		name          string
+		environment   *environments.Environment
+		subresource   string
+		location      string
+		expected      []string
+		expectedError string
+	}{
+		{
+			name:        "storage",
+			environment: environments.AzurePublic(),
+			subresource: "blob",
+			location:    "westeurope",
+			expected:    []string{"privatelink.blob.core.windows.net"},
+		},
+		{
+			name:        "storage secondary",
+			environment: environments.AzurePublic(),
+			subresource: "dfs_secondary",
+			expected:    []string{"privatelink.dfs.core.windows.net"},
+		},
+		{
+			name:        "storage in china",
+			environment: environments.AzureChina(),
+			subresource: "file",
+			expected:    []string{"privatelink.file.core.chinacloudapi.cn"},
+		},
+		{
+			name:        "key vault in us government",
+			environment: environments.AzureUSGovernment(),
+			subresource: "vault",
+			expected:    []string{"privatelink.vaultcore.usgovcloudapi.net"},
+		},
+		{
+			name:        "resource type prefix",
+			environment: environments.AzurePublic(),
+			subresource: "Microsoft.DocumentDB/databaseAccounts/Sql",
+			expected:    []string{"privatelink.documents.azure.com"},
+		},
+		{
+			name:        "resource type prefix is case-insensitive",
+			environment: environments.AzurePublic(),
+			subresource: "microsoft.synapse/workspaces/Sql",
+			expected:    []string{"privatelink.sql.azuresynapse.net"},
+		},
+		{
+			name:        "same zones for multiple resource types",
+			environment: environments.AzurePublic(),
+			subresource: "namespace",
+			expected:    []string{"privatelink.servicebus.windows.net"},
+		},
+		{
+			name:        "regional",
+			environment: environments.AzurePublic(),
+			subresource: "registry",
+			location:    "West Europe",
+			expected:    []string{"privatelink.azurecr.io", "westeurope.data.privatelink.azurecr.io"},
+		},
+		{
+			name:        "regional in us government",
+			environment: environments.AzureUSGovernment(),
+			subresource: "management",
+			location:    "usgovvirginia",
+			expected:    []string{"privatelink.usgovvirginia.cx.aks.containerservice.azure.us"},
+		},
+		{
+			name:          "regional without a location",
+			environment:   environments.AzurePublic(),
+			subresource:   "management",
+			expectedError: "a `location` must be specified",
+		},
+		{
+			name:          "sub-resource names are case-sensitive",
+			environment:   environments.AzurePublic(),
+			subresource:   "Blob",
+			expectedError: "is not supported",
+		},
+		{
+			name:          "unsupported",
+			environment:   environments.AzurePublic(),
+			subresource:   "unknown",
+			expectedError: "is not supported",
+		},
+		{
+			name:          "ambiguous",
+			environment:   environments.AzurePublic(),
+			subresource:   "Sql",
+			expectedError: "is used by multiple Resource Types",
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.name, func(t *testing.T) {
+			actual, err := privateDnsZoneNames(v.environment, v.subresource, v.location)
+			if v.expectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), v.expectedError) {
+					t.Fatalf("expected an error containing %q but got: %+v", v.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("retrieving the Private DNS Zone names: %+v", err)
+			}
+
+			if !reflect.DeepEqual(actual, v.expected) {
+				t.Fatalf("expected %v but got %v", v.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+// SupportedListResources returns the List Resources exposed by both the Typed and Untyped Services
+func SupportedListResources() []sdk.ListResource {
+	output := make([]sdk.ListResource, 0)
+	resourceTypes := make(map[string]struct{})
+	for _, service := range supportedServices() {
+		v, ok := service.(sdk.ServiceRegistrationWithListResources)
+		if !ok {
+			continue
+		}
+
+		for _, listResource := range v.ListResources() {
+			if _, exists := resourceTypes[listResource.ResourceType]; exists {
+				panic(fmt.Sprintf("An existing List Resource exists for %q", listResource.ResourceType))
+			}
+			resourceTypes[listResource.ResourceType] = struct{}{}
+
+			output = append(output, listResource)
+		}
+	}
+
+	return output
+}
+
+// supportedServices returns each of the Typed and Untyped Services, ordered by type
+func supportedServices() []interface{} {
+	// the same Service Registration can be both a Typed and an Untyped Service, so these are keyed by type
+	services := make(map[string]interface{})
+	for _, service := range SupportedTypedServices() {
+		services[fmt.Sprintf("%T", service)] = service
+	}
+	for _, service := range SupportedUntypedServices() {
+		services[fmt.Sprintf("%T", service)] = service
+	}
+
+	names := make([]string, 0)
+	for name := range services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	output := make([]interface{}, 0)
+	for _, name := range names {
+		output = append(output, services[name])
+	}
+	return output
+}
@@ -178,6 +178,13 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				Description: "The Hostname which should be used for the Azure Metadata Service.",
 			},
 
+			"metadata_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_METADATA_FILE", nil),
+				Description: "The path to a file defining the Cloud Environment, which should be used to configure the Cloud Environment without connecting to the Azure Metadata Service.",
+			},
+
 			"client_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -250,6 +257,13 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				Description: "The URL for the OIDC provider from which to request an ID token. For use when authenticating as a Service Principal using OpenID Connect.",
 			},
 
+			"ado_pipeline_service_connection_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"ARM_ADO_PIPELINE_SERVICE_CONNECTION_ID", "ARM_OIDC_AZURE_SERVICE_CONNECTION_ID", "AZURESUBSCRIPTION_SERVICE_CONNECTION_ID"}, nil),
+				Description: "The Azure DevOps Pipeline Service Connection ID. For use when authenticating as a Service Principal using OpenID Connect from within an Azure DevOps Pipeline.",
+			},
+
 			"oidc_token": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -321,6 +335,8 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 
 			"features": schemaFeatures(supportLegacyTestSuite),
 
+			"default_timeouts": schemaDefaultTimeouts(),
+
 			// Advanced feature flags
 			"resource_provider_registrations": {
 				Type:        schema.TypeString,
@@ -384,6 +400,10 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 // To configure behavioral aspects of the provider, use the buildClient function instead.
 // This separation allows us to robustly test different authentication scenarios.
 func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
+	// the `default_timeouts` are applied to the timeouts defined by each Resource/Data Source
+	resourceTimeouts := builtInTimeouts(p.ResourcesMap)
+	dataSourceTimeouts := builtInTimeouts(p.DataSourcesMap)
+
 	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		subscriptionId := d.Get("subscription_id").(string)
 		if subscriptionId == "" {
@@ -435,9 +455,19 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 
 			envName      = d.Get("environment").(string)
 			metadataHost = d.Get("metadata_host").(string)
+			metadataFile = d.Get("metadata_file").(string)
 		)
 
-		if metadataHost != "" {
+		if metadataHost != "" && metadataFile != "" {
+			return nil, diag.Errorf("only one of `metadata_host` and `metadata_file` can be specified")
+		}
+
+		if metadataFile != "" {
+			logEntry("[DEBUG] Configuring cloud environment from Metadata file at %q", metadataFile)
+			if env, err = clients.EnvironmentFromMetadataFile(metadataFile); err != nil {
+				return nil, diag.FromErr(err)
+			}
+		} else if metadataHost != "" {
 			logEntry("[DEBUG] Configuring cloud environment from Metadata Service at %q", metadataHost)
 			if env, err = environments.FromEndpoint(ctx, fmt.Sprintf("https://%s", metadataHost)); err != nil {
 				return nil, diag.FromErr(err)
@@ -453,8 +483,36 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			enableAzureCli        = d.Get("use_cli").(bool)
 			enableManagedIdentity = d.Get("use_msi").(bool)
 			enableOidc            = d.Get("use_oidc").(bool) || d.Get("use_aks_workload_identity").(bool)
+			enableGitHubOidc      = enableOidc
 		)
 
+		var azureDevOpsPipelineOIDC *clients.AzureDevOpsPipelineOIDCOptions
+		if serviceConnectionId := d.Get("ado_pipeline_service_connection_id").(string); enableOidc && serviceConnectionId != "" {
+			if *oidcToken != "" {
+				return nil, diag.Errorf("`ado_pipeline_service_connection_id` cannot be specified alongside `oidc_token` or `oidc_token_file_path`")
+			}
+
+			// within an Azure DevOps Pipeline the Request URL and Token are exposed in the `SYSTEM_OIDCREQUESTURI` and `SYSTEM_ACCESSTOKEN` environment variables
+			requestUrl := d.Get("oidc_request_url").(string)
+			if requestUrl == "" {
+				requestUrl = os.Getenv("SYSTEM_OIDCREQUESTURI")
+			}
+			requestToken := d.Get("oidc_request_token").(string)
+			if requestToken == "" {
+				requestToken = os.Getenv("SYSTEM_ACCESSTOKEN")
+			}
+
+			logEntry("[DEBUG] Authenticating using the Azure DevOps Service Connection %q", serviceConnectionId)
+			azureDevOpsPipelineOIDC = &clients.AzureDevOpsPipelineOIDCOptions{
+				RequestURL:          requestUrl,
+				RequestToken:        requestToken,
+				ServiceConnectionID: serviceConnectionId,
+			}
+
+			// the Request URL and Token are for Azure DevOps rather than GitHub, so shouldn't be used to request a token from GitHub
+			enableGitHubOidc = false
+		}
+
 		authConfig := &auth.Credentials{
 			Environment:        *env,
 			ClientID:           *clientId,
@@ -479,16 +537,30 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			EnableAuthenticatingUsingAzureCLI:          enableAzureCli,
 			EnableAuthenticatingUsingManagedIdentity:   enableManagedIdentity,
 			EnableAuthenticationUsingOIDC:              enableOidc,
-			EnableAuthenticationUsingGitHubOIDC:        enableOidc,
+			EnableAuthenticationUsingGitHubOIDC:        enableGitHubOidc,
+		}
+
+		defaultTimeouts, err := expandDefaultTimeouts(d.Get("default_timeouts").([]interface{}))
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		if err := applyDefaultTimeouts(p.ResourcesMap, resourceTimeouts, *defaultTimeouts); err != nil {
+			return nil, diag.FromErr(err)
+		}
+		// Data Sources share their names with Resources, so only the timeouts for all Resources are used
+		dataSourceDefaultTimeouts := *defaultTimeouts
+		dataSourceDefaultTimeouts.Resources = nil
+		if err := applyDefaultTimeouts(p.DataSourcesMap, dataSourceTimeouts, dataSourceDefaultTimeouts); err != nil {
+			return nil, diag.FromErr(err)
 		}
 
-		return buildClient(ctx, p, d, authConfig)
+		return buildClient(ctx, p, d, authConfig, azureDevOpsPipelineOIDC)
 	}
 }
 
 // buildClient is used to configure behavioral aspects of the provider. To configure the
 // cloud environment and authentication-related settings, use the providerConfigure function.
-func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData, authConfig *auth.Credentials) (*clients.Client, diag.Diagnostics) {
+func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData, authConfig *auth.Credentials, azureDevOpsPipelineOIDC *clients.AzureDevOpsPipelineOIDCOptions) (*clients.Client, diag.Diagnostics) {
 	// TODO: This hardcoded default is for v3.x, where `resource_provider_registrations` is not defined. Remove this hardcoded default in v4.0
 	providerRegistrations := resourceproviders.ProviderRegistrationsLegacy
 	if features.FourPointOhBeta() {
@@ -518,6 +590,7 @@ func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData
 
 	clientBuilder := clients.ClientBuilder{
 		AuthConfig:                  authConfig,
+		AzureDevOpsPipelineOIDC:     azureDevOpsPipelineOIDC,
 		DisableCorrelationRequestID: d.Get("disable_correlation_request_id").(bool),
 		DisableTerraformPartnerID:   d.Get("disable_terraform_partner_id").(bool),
 		Features:                    expandFeatures(d.Get("features").([]interface{})),
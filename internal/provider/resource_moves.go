@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+// SupportedResourceMoves returns the Resource Moves defined by both the Typed and Untyped Services
+func SupportedResourceMoves() []sdk.ResourceMove {
+	output := make([]sdk.ResourceMove, 0)
+	moves := make(map[string]struct{})
+	for _, service := range supportedServices() {
+		v, ok := service.(sdk.ServiceRegistrationWithResourceMoves)
+		if !ok {
+			continue
+		}
+
+		for _, move := range v.ResourceMoves() {
+			key := fmt.Sprintf("%s/%s", move.SourceResourceType, move.TargetResourceType)
+			if _, exists := moves[key]; exists {
+				panic(fmt.Sprintf("An existing Resource Move exists from %q to %q", move.SourceResourceType, move.TargetResourceType))
+			}
+			moves[key] = struct{}{}
+
+			output = append(output, move)
+		}
+	}
+
+	return output
+}
@@ -88,6 +88,40 @@ func TestUntypedResourcesContainImporters(t *testing.T) {
 	}
 }
 
+func TestListResourcesDefineAnIdentity(t *testing.T) {
+	// List Resources emit the Identity of each Resource found, so the Resource being listed
+	// must both exist and define an Identity (which must also be supported at import time)
+	resources := AzureProvider().ResourcesMap
+	for _, listResource := range SupportedListResources() {
+		t.Logf("- List Resource %q..", listResource.ResourceType)
+		resource, ok := resources[listResource.ResourceType]
+		if !ok {
+			t.Fatalf("the List Resource %q has no matching Resource", listResource.ResourceType)
+		}
+
+		if listResource.Resource().Identity == nil || resource.Identity == nil {
+			t.Fatalf("the Resource %q must define an Identity to be listed", listResource.ResourceType)
+		}
+	}
+}
+
+func TestResourceMovesReferenceExistingTargetResources(t *testing.T) {
+	// NOTE: the Source Resource may have been removed from the Provider, since the raw state is moved
+	resources := AzureProvider().ResourcesMap
+	for _, move := range SupportedResourceMoves() {
+		t.Logf("- Resource Move from %q to %q..", move.SourceResourceType, move.TargetResourceType)
+		if move.SourceResourceType == move.TargetResourceType {
+			t.Fatalf("the Resource Move for %q must be into another Resource Type", move.SourceResourceType)
+		}
+		if _, ok := resources[move.TargetResourceType]; !ok {
+			t.Fatalf("the Target Resource %q for the Resource Move from %q was not found", move.TargetResourceType, move.SourceResourceType)
+		}
+		if move.MoveFunc == nil {
+			t.Fatalf("the Resource Move from %q to %q must define a MoveFunc", move.SourceResourceType, move.TargetResourceType)
+		}
+	}
+}
+
 func TestResourcesAreNamedConsistently(t *testing.T) {
 	t.Logf("Validating Typed Services..")
 	for _, service := range SupportedTypedServices() {
@@ -0,0 +1,255 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+// armTargetSegmentRegex matches a segment of the Target of an ARM Error, optionally containing an index,
+// for example `addressPrefixes` or `ipConfigurations[0]`
+var armTargetSegmentRegex = regexp.MustCompile(`^([A-Za-z0-9_]+)(?:\[(\d+)\])?$`)
+
+// armTargetSegmentsToJoin is the maximum number of segments of the Target of an ARM Error which are joined together
+// when looking up the field in the model, since (for example) `sku.name` is commonly exposed as `sku_name`
+const armTargetSegmentsToJoin = 3
+
+type armTargetSegment struct {
+	name  string
+	index *int
+}
+
+// errorDiagnostics returns the Diagnostics for an error returned from a Create/Read/Update/Delete function.
+//
+// Where this error was caused by an error returned from Azure Resource Manager which references a field within the
+// request (the Target), this field is mapped to the corresponding Attribute Path using the `tfschema` tags defined
+// on the model - so that Terraform can highlight the offending attribute in the configuration.
+func errorDiagnostics(ctx context.Context, err error, model interface{}, resourceSchema map[string]*schema.Schema) diag.Diagnostics {
+	output := diag.Diagnostics{
+		{
+			Severity: diag.Error,
+			Summary:  err.Error(),
+			Detail:   err.Error(),
+		},
+	}
+
+	armError := common.LastArmError(ctx)
+	if armError == nil || model == nil {
+		return output
+	}
+
+	// the recorded ARM Error is only used if it caused this error, rather than (for example) being an expected
+	// `404 Not Found` returned from an earlier existence check
+	if armError.Message == "" || !strings.Contains(err.Error(), armError.Message) {
+		return output
+	}
+
+	modelType := reflect.TypeOf(model)
+	for modelType.Kind() == reflect.Ptr {
+		modelType = modelType.Elem()
+	}
+	if modelType.Kind() != reflect.Struct {
+		return output
+	}
+
+	output[0].AttributePath = attributePathForArmTarget(modelType, resourceSchema, armError.Target)
+	for _, detail := range armError.Details {
+		path := attributePathForArmTarget(modelType, resourceSchema, detail.Target)
+		if path == nil {
+			continue
+		}
+
+		if output[0].AttributePath == nil {
+			output[0].AttributePath = path
+			continue
+		}
+		if output[0].AttributePath.Equals(path) {
+			continue
+		}
+
+		output = append(output, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("%s: %s", detail.Code, detail.Message),
+			AttributePath: path,
+		})
+	}
+
+	return output
+}
+
+// attributePathForArmTarget returns the Attribute Path for the field within the model which corresponds to the
+// Target of an ARM Error (for example `properties.addressSpace.addressPrefixes[0]`) - or nil if this can't be found.
+//
+// Since the model is a flattened representation of the API, segments of the Target which aren't exposed at the
+// top-level of the model (such as `properties`) are skipped - however once the Target references a nested block,
+// each segment (other than `properties`) must match a field, since otherwise the path would be misleading.
+func attributePathForArmTarget(modelType reflect.Type, resourceSchema map[string]*schema.Schema, target string) cty.Path {
+	segments := parseArmTarget(target)
+
+	path := cty.Path{}
+	current := modelType
+	currentSchema := resourceSchema
+	for i := 0; i < len(segments); i++ {
+		hclPath, fieldType, consumed := fieldForArmTargetSegments(current, segments[i:])
+		if consumed == 0 {
+			if len(path) > 0 && !strings.EqualFold(segments[i].name, "properties") {
+				return nil
+			}
+			continue
+		}
+
+		path = path.GetAttr(hclPath)
+		i += consumed - 1
+
+		fieldSchema, ok := currentSchema[hclPath]
+		if !ok {
+			return nil
+		}
+
+		// the elements within a Set are referenced by their value rather than an index, which isn't known here,
+		// so the path can only reference the Set itself
+		if fieldSchema.Type == schema.TypeSet {
+			break
+		}
+
+		for fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		if fieldType.Kind() == reflect.Slice {
+			fieldType = fieldType.Elem()
+			for fieldType.Kind() == reflect.Ptr {
+				fieldType = fieldType.Elem()
+			}
+
+			// for a list of values flattened from a nested object (e.g. `addressSpace.addressPrefixes[0]`) the
+			// index is the last one within the Target
+			index := segments[i].index
+			if index == nil && fieldType.Kind() != reflect.Struct {
+				index = segments[len(segments)-1].index
+			}
+			if index != nil {
+				path = path.IndexInt(*index)
+			}
+
+			if fieldType.Kind() == reflect.Struct && segments[i].index == nil && i < len(segments)-1 {
+				// the nested field can't be referenced without the index of the block
+				break
+			}
+		}
+
+		if fieldType.Kind() != reflect.Struct {
+			break
+		}
+
+		nested, ok := fieldSchema.Elem.(*schema.Resource)
+		if !ok {
+			break
+		}
+		current = fieldType
+		currentSchema = nested.Schema
+	}
+
+	if len(path) == 0 {
+		return nil
+	}
+
+	return path
+}
+
+// fieldForArmTargetSegments returns the hclPath and type of the field within the model which matches the next
+// segment(s) of the Target of an ARM Error, along with the number of segments which were consumed (or 0 if
+// no field matches).
+func fieldForArmTargetSegments(modelType reflect.Type, segments []armTargetSegment) (string, reflect.Type, int) {
+	for consumed := 1; consumed <= armTargetSegmentsToJoin && consumed <= len(segments); consumed++ {
+		names := make([]string, 0)
+		for _, segment := range segments[:consumed] {
+			names = append(names, camelCaseToSnakeCase(segment.name))
+		}
+		name := strings.Join(names, "_")
+
+		for i := 0; i < modelType.NumField(); i++ {
+			field := modelType.Field(i)
+			structTags, err := parseStructTags(field.Tag)
+			if err != nil || structTags == nil {
+				continue
+			}
+
+			// Terraform uses the singular name for blocks, where the API uses the plural name
+			if strings.EqualFold(structTags.hclPath, name) || strings.EqualFold(structTags.hclPath, strings.TrimSuffix(name, "s")) {
+				return structTags.hclPath, field.Type, consumed
+			}
+		}
+	}
+
+	return "", nil, 0
+}
+
+// parseArmTarget parses the Target of an ARM Error, for example `properties.ipConfigurations[0].subnet` or
+// `/properties/ipConfigurations/0/subnet`, into its segments
+func parseArmTarget(target string) []armTargetSegment {
+	target = strings.TrimPrefix(strings.TrimSpace(target), "$")
+
+	// Resource IDs are returned as the Target for some errors, which don't reference a field
+	if strings.HasPrefix(strings.ToLower(strings.TrimPrefix(target, "/")), "subscriptions/") {
+		return nil
+	}
+
+	output := make([]armTargetSegment, 0)
+	for _, v := range strings.FieldsFunc(target, func(r rune) bool {
+		return r == '.' || r == '/'
+	}) {
+		if index, err := strconv.Atoi(v); err == nil && len(output) > 0 {
+			output[len(output)-1].index = &index
+			continue
+		}
+
+		match := armTargetSegmentRegex.FindStringSubmatch(v)
+		if match == nil {
+			continue
+		}
+
+		segment := armTargetSegment{
+			name: match[1],
+		}
+		if match[2] != "" {
+			if index, err := strconv.Atoi(match[2]); err == nil {
+				segment.index = &index
+			}
+		}
+		output = append(output, segment)
+	}
+
+	return output
+}
+
+// camelCaseToSnakeCase converts a field name used in the API (e.g. `addressPrefixes` or `enableIPForwarding`) into
+// the format used by Terraform (e.g. `address_prefixes` or `enable_ip_forwarding`)
+func camelCaseToSnakeCase(input string) string {
+	runes := []rune(input)
+
+	var sb strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			previous := runes[i-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(previous) || unicode.IsDigit(previous) || (unicode.IsUpper(previous) && nextIsLower) {
+				sb.WriteRune('_')
+			}
+		}
+		sb.WriteRune(unicode.ToLower(r))
+	}
+
+	return sb.String()
+}
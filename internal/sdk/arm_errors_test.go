@@ -0,0 +1,276 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type armErrorsSubnetModel struct {
+	Name            string   `tfschema:"name"`
+	AddressPrefixes []string `tfschema:"address_prefixes"`
+}
+
+type armErrorsRuleModel struct {
+	Name string `tfschema:"name"`
+}
+
+type armErrorsModel struct {
+	Name               string                 `tfschema:"name"`
+	Location           string                 `tfschema:"location"`
+	SkuName            string                 `tfschema:"sku_name"`
+	AddressSpace       []string               `tfschema:"address_space"`
+	DnsServers         []string               `tfschema:"dns_servers"`
+	EnableIPForwarding bool                   `tfschema:"enable_ip_forwarding"`
+	Subnet             []armErrorsSubnetModel `tfschema:"subnet"`
+	Rule               []armErrorsRuleModel   `tfschema:"rule"`
+}
+
+func armErrorsSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+		"location": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+		"sku_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+		"address_space": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+		"dns_servers": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+		"enable_ip_forwarding": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+		"subnet": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+					"address_prefixes": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+		"rule": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestAttributePathForArmTarget(t *testing.T) {
+	testData := []struct {
+		target   string
+		expected cty.Path
+	}{
+		{
+			target: "",
+		},
+		{
+			// a Resource ID doesn't reference a field
+			target: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/name",
+		},
+		{
+			target: "properties.unknownField",
+		},
+		{
+			target:   "location",
+			expected: cty.GetAttrPath("location"),
+		},
+		{
+			target:   "sku.name",
+			expected: cty.GetAttrPath("sku_name"),
+		},
+		{
+			target:   "properties.enableIPForwarding",
+			expected: cty.GetAttrPath("enable_ip_forwarding"),
+		},
+		{
+			target:   "properties.addressSpace.addressPrefixes[1]",
+			expected: cty.GetAttrPath("address_space").IndexInt(1),
+		},
+		{
+			target:   "properties.subnets",
+			expected: cty.GetAttrPath("subnet"),
+		},
+		{
+			// the nested field can't be referenced without the index of the block
+			target:   "properties.subnets.properties.addressPrefixes",
+			expected: cty.GetAttrPath("subnet"),
+		},
+		{
+			target:   "properties.subnets[2].properties.addressPrefixes[0]",
+			expected: cty.GetAttrPath("subnet").IndexInt(2).GetAttr("address_prefixes").IndexInt(0),
+		},
+		{
+			target:   "/properties/subnets/2/properties/name",
+			expected: cty.GetAttrPath("subnet").IndexInt(2).GetAttr("name"),
+		},
+		{
+			// a field within a nested block which isn't exposed shouldn't be mapped to the block
+			target: "properties.subnets[2].properties.serviceEndpoints[0]",
+		},
+		{
+			// the elements within a Set can't be referenced by index
+			target:   "properties.dhcpOptions.dnsServers[1]",
+			expected: cty.GetAttrPath("dns_servers"),
+		},
+		{
+			target:   "properties.rules[0].name",
+			expected: cty.GetAttrPath("rule"),
+		},
+	}
+
+	modelType := reflect.TypeOf(armErrorsModel{})
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.target)
+
+		actual := attributePathForArmTarget(modelType, armErrorsSchema(), v.target)
+		if v.expected == nil {
+			if actual != nil {
+				t.Fatalf("expected no path but got %#v", actual)
+			}
+			continue
+		}
+
+		if !actual.Equals(v.expected) {
+			t.Fatalf("expected %#v but got %#v", v.expected, actual)
+		}
+	}
+}
+
+func TestCamelCaseToSnakeCase(t *testing.T) {
+	testData := map[string]string{
+		"name":               "name",
+		"addressPrefixes":    "address_prefixes",
+		"enableIPForwarding": "enable_ip_forwarding",
+		"enableHttp2":        "enable_http2",
+		"IPAddress":          "ip_address",
+	}
+
+	for input, expected := range testData {
+		if actual := camelCaseToSnakeCase(input); actual != expected {
+			t.Fatalf("expected %q to be %q but got %q", input, expected, actual)
+		}
+	}
+}
+
+func TestErrorDiagnostics(t *testing.T) {
+	body := `{
+  "error": {
+    "code": "InvalidRequestFormat",
+    "message": "Cannot parse the request.",
+    "details": [
+      {
+        "code": "InvalidJson",
+        "message": "Error converting value \"foo\" to type 'Boolean'.",
+        "target": "properties.enableIPForwarding"
+      },
+      {
+        "code": "InvalidAddressPrefix",
+        "message": "The address prefix is invalid.",
+        "target": "properties.subnets[0].properties.addressPrefixes[0]"
+      }
+    ]
+  }
+}`
+	ctx := common.WithArmErrorRecorder(context.TODO())
+	recordArmError(t, ctx, body)
+
+	err := fmt.Errorf("creating Example: unexpected status 400 (400 Bad Request) with error: InvalidRequestFormat: Cannot parse the request.")
+	diags := errorDiagnostics(ctx, err, &armErrorsModel{}, armErrorsSchema())
+	if len(diags) != 2 {
+		t.Fatalf("expected 2 diagnostics but got %d: %+v", len(diags), diags)
+	}
+	if diags[0].Summary != err.Error() {
+		t.Fatalf("expected the summary to be %q but got %q", err.Error(), diags[0].Summary)
+	}
+	if expected := cty.GetAttrPath("enable_ip_forwarding"); !diags[0].AttributePath.Equals(expected) {
+		t.Fatalf("expected the first path to be %#v but got %#v", expected, diags[0].AttributePath)
+	}
+	if expected := cty.GetAttrPath("subnet").IndexInt(0).GetAttr("address_prefixes").IndexInt(0); !diags[1].AttributePath.Equals(expected) {
+		t.Fatalf("expected the second path to be %#v but got %#v", expected, diags[1].AttributePath)
+	}
+
+	// an unrelated ARM Error (e.g. from an earlier existence check) shouldn't be used
+	otherErr := fmt.Errorf("polling after Create: polling failed: the operation timed out")
+	diags = errorDiagnostics(ctx, otherErr, &armErrorsModel{}, armErrorsSchema())
+	if len(diags) != 1 || diags[0].AttributePath != nil {
+		t.Fatalf("expected a single diagnostic without a path but got %+v", diags)
+	}
+}
+
+func recordArmError(t *testing.T, ctx context.Context, body string) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPut, "https://management.azure.com/example", nil)
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	response := &http.Response{
+		StatusCode: http.StatusBadRequest,
+		Header: http.Header{
+			"Content-Type": []string{"application/json; charset=utf-8"},
+		},
+		Body: io.NopCloser(strings.NewReader(body)),
+	}
+
+	// the middleware is registered by ClientOptions.Configure, so is retrieved by configuring a client
+	c := client.NewClient("https://management.azure.com", "example", "2020-01-01")
+	common.ClientOptions{DisableCorrelationRequestID: true}.Configure(c, nil)
+	for _, middleware := range *c.ResponseMiddlewares {
+		if response, err = middleware(request, response); err != nil {
+			t.Fatalf("running middleware: %+v", err)
+		}
+	}
+
+	// the body must remain readable by the SDK
+	remaining, err := io.ReadAll(response.Body)
+	if err != nil || string(remaining) != body {
+		t.Fatalf("expected the response body to be retained")
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// ListResource defines a List Resource for an existing Resource, allowing the existing instances of that
+// Resource to be discovered (and subsequently imported, using their Identity) via `terraform query`.
+type ListResource struct {
+	// ResourceType is the Terraform Resource Type which this List Resource lists, e.g. `azurerm_resource_group`
+	ResourceType string
+
+	// Resource returns the Plugin SDK representation of the Resource being listed, which is used to source
+	// both the Resource Schema and the Resource Identity Schema - as such this must define an Identity.
+	Resource func() *pluginsdk.Resource
+
+	// ResourceGroupScoped specifies whether the instances of this Resource can be listed within a single
+	// Resource Group, in which case the `resource_group_name` argument is exposed.
+	ResourceGroupScoped bool
+
+	// List returns the Resource IDs for each instance of this Resource within the Subscription that the
+	// client is configured for, optionally limited to the specified Resource Group.
+	List func(ctx context.Context, client *clients.Client, resourceGroupName *string) ([]resourceids.ResourceId, error)
+}
+
+var (
+	_ list.ListResource                 = &listResourceWrapper{}
+	_ list.ListResourceWithConfigure    = &listResourceWrapper{}
+	_ list.ListResourceWithRawV5Schemas = &listResourceWrapper{}
+)
+
+type listResourceWrapper struct {
+	listResource ListResource
+	client       *clients.Client
+}
+
+// NewListResourceWrapper returns a function which creates a Plugin Framework List Resource for the specified ListResource
+func NewListResourceWrapper(input ListResource) func() list.ListResource {
+	return func() list.ListResource {
+		return &listResourceWrapper{
+			listResource: input,
+		}
+	}
+}
+
+func (w *listResourceWrapper) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = w.listResource.ResourceType
+}
+
+func (w *listResourceWrapper) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"subscription_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the Subscription which should be searched. Defaults to the Subscription configured on the Provider.",
+			},
+		},
+	}
+
+	if w.listResource.ResourceGroupScoped {
+		response.Schema.Attributes["resource_group_name"] = schema.StringAttribute{
+			Optional:    true,
+			Description: "The name of the Resource Group which should be searched. Defaults to searching the entire Subscription.",
+		}
+	}
+}
+
+func (w *listResourceWrapper) RawV5Schemas(ctx context.Context, _ list.RawV5SchemaRequest, response *list.RawV5SchemaResponse) {
+	res := w.listResource.Resource()
+	response.ProtoV5Schema = res.ProtoSchema(ctx)()
+	if identitySchema := res.ProtoIdentitySchema(ctx); identitySchema != nil {
+		response.ProtoV5IdentitySchema = identitySchema()
+	}
+}
+
+func (w *listResourceWrapper) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*clients.Client)
+	if !ok {
+		response.Diagnostics.AddError("Unexpected Provider Data", fmt.Sprintf("expected `*clients.Client` but got %T", request.ProviderData))
+		return
+	}
+
+	w.client = client
+}
+
+func (w *listResourceWrapper) List(ctx context.Context, request list.ListRequest, response *list.ListResultsStream) {
+	if w.client == nil {
+		response.Results = listResultsStreamError("listing %s: the Provider has not been configured", w.listResource.ResourceType)
+		return
+	}
+
+	client := w.client
+	var subscriptionId types.String
+	if diags := request.Config.GetAttribute(ctx, path.Root("subscription_id"), &subscriptionId); diags.HasError() {
+		response.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	if v := subscriptionId.ValueString(); v != "" {
+		scoped, err := client.ForSubscription(v)
+		if err != nil {
+			response.Results = listResultsStreamError("building clients for Subscription %q: %+v", v, err)
+			return
+		}
+		client = scoped
+	}
+
+	var resourceGroupName *string
+	if w.listResource.ResourceGroupScoped {
+		var v types.String
+		if diags := request.Config.GetAttribute(ctx, path.Root("resource_group_name"), &v); diags.HasError() {
+			response.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+		if v.ValueString() != "" {
+			resourceGroupName = pointer.To(v.ValueString())
+		}
+	}
+
+	ids, err := w.listResource.List(ctx, client, resourceGroupName)
+	if err != nil {
+		response.Results = listResultsStreamError("listing %s: %+v", w.listResource.ResourceType, err)
+		return
+	}
+
+	resourceSchema := w.listResource.Resource().Schema
+
+	response.Results = func(push func(list.ListResult) bool) {
+		for i, id := range ids {
+			if request.Limit > 0 && int64(i) >= request.Limit {
+				return
+			}
+
+			result := request.NewListResult(ctx)
+			result.DisplayName = id.ID()
+
+			attributes, err := pluginsdk.IdentityAttributesFromResourceId(id)
+			if err != nil {
+				result.Diagnostics.AddError("Building Identity", fmt.Sprintf("building identity for %s: %+v", id, err))
+				if !push(result) {
+					return
+				}
+				continue
+			}
+
+			for k, v := range attributes {
+				result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root(k), v)...)
+			}
+
+			// only the identifying attributes are populated here, the remaining attributes are populated
+			// by the subsequent import/refresh of the Resource
+			if request.IncludeResource {
+				result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root("id"), id.ID())...)
+				for k, v := range attributes {
+					if _, ok := resourceSchema[k]; ok {
+						result.Diagnostics.Append(result.Resource.SetAttribute(ctx, path.Root(k), v)...)
+					}
+				}
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+func listResultsStreamError(format string, a ...any) func(func(list.ListResult) bool) {
+	result := list.ListResult{}
+	result.Diagnostics.AddError("Listing Resources", fmt.Sprintf(format, a...))
+	return list.ListResultsStreamDiagnostics(result.Diagnostics)
+}
@@ -108,6 +108,17 @@ type ResourceWithCustomImporter interface {
 	CustomImporter() ResourceRunFunc
 }
 
+// ResourceWithIdentityType is an optional interface
+//
+// Resources implementing this interface support a Resource Identity, which is generated from
+// the Resource ID type - allowing the Resource to be imported without specifying the Resource ID.
+type ResourceWithIdentityType interface {
+	Resource
+
+	// IdentityType returns the Resource ID type for this Resource, e.g. `&commonids.KeyVaultId{}`
+	IdentityType() resourceids.ResourceId
+}
+
 // ResourceWithUpdate is an optional interface
 //
 // Notably the Arguments for Resources implementing this interface
@@ -156,6 +167,20 @@ type ResourceWithCustomizeDiff interface {
 	CustomizeDiff() ResourceFunc
 }
 
+// ResourceWithSubscriptionOverride is an optional interface
+//
+// Resources implementing this interface gain an Optional `subscription_id` argument, allowing
+// the Resource to be provisioned into a different Subscription to the one configured for the
+// Provider, using the same credentials. The Client within the ResourceMetaData is then scoped
+// to this Subscription - as such these Resources must use `metadata.Client.Account.SubscriptionId`
+// when building the Resource ID during Create.
+type ResourceWithSubscriptionOverride interface {
+	Resource
+
+	// SupportsSubscriptionOverride is used to opt this Resource into the `subscription_id` argument
+	SupportsSubscriptionOverride()
+}
+
 // ResourceRunFunc is the function which can be run
 // ctx provides a Context instance with the user-provided timeout
 // metadata is a reference to an object containing the Client, ResourceData and a Logger
@@ -190,6 +215,9 @@ type ResourceMetaData struct {
 
 	// serializationDebugLogger is used for testing purposes
 	serializationDebugLogger Logger
+
+	// refreshing specifies whether the Resource is being refreshed, rather than being read following a Create/Update
+	refreshing bool
 }
 
 // MarkAsGone marks this resource as removed in the Remote API, so this is no longer available
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+// ReadFromResourceGraph populates the model with the representation of this Resource from Azure Resource Graph,
+// which allows each of the Resources of a given Resource Type to be refreshed using a single query, rather than
+// retrieving each Resource individually.
+//
+// This returns false when the Resource should be retrieved from the API instead - which is the case unless the
+// `refresh.use_resource_graph` feature is enabled and the Resource is being refreshed (rather than being read
+// following a Create/Update), or when the Resource isn't present within the snapshot from Resource Graph.
+//
+// NOTE: Resource Graph only contains the top-level representation of the Resource as returned from the API - as
+// such this must only be used where the Read function uses only this (and doesn't, for example, retrieve secrets
+// or call other APIs).
+func (rmd ResourceMetaData) ReadFromResourceGraph(ctx context.Context, id resourceids.ResourceId, model interface{}) (bool, error) {
+	if !rmd.refreshing || rmd.Client == nil || !rmd.Client.Features.Refresh.UseResourceGraph || rmd.Client.ResourceGraph == nil {
+		return false, nil
+	}
+
+	raw, ok := rmd.Client.ResourceGraph.Get(ctx, id.ID())
+	if !ok {
+		return false, nil
+	}
+
+	if err := json.Unmarshal(raw, model); err != nil {
+		return false, fmt.Errorf("unmarshaling %s from Resource Graph: %+v", id, err)
+	}
+
+	rmd.Logger.Infof("[DEBUG] Read %s from Resource Graph", id)
+
+	return true, nil
+}
+
+// markAsModifiedInResourceGraph records that this Resource has been created/updated/deleted, so that any subsequent
+// Read retrieves the Resource from the API, rather than from the (now stale) snapshot from Resource Graph
+func markAsModifiedInResourceGraph(metaData ResourceMetaData) {
+	if metaData.Client == nil || metaData.Client.ResourceGraph == nil || metaData.ResourceData == nil || metaData.ResourceData.Id() == "" {
+		return
+	}
+
+	metaData.Client.ResourceGraph.MarkAsModified(metaData.ResourceData.Id())
+}
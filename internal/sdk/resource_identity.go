@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// setIdentityFromResourceId sets the Resource Identity for the Resource ID of this Resource, which is parsed as
+// the specified Resource ID type
+func setIdentityFromResourceId(d *pluginsdk.ResourceData, idType resourceids.ResourceId) error {
+	parsed, err := resourceids.NewParserFromResourceIdType(idType).Parse(d.Id(), true)
+	if err != nil {
+		// the Resource ID not matching the Resource ID type shouldn't block the Resource from being read
+		log.Printf("[WARN] unable to set the Identity for %q: %+v", d.Id(), err)
+		return nil
+	}
+
+	id := reflect.New(reflect.TypeOf(idType).Elem()).Interface().(resourceids.ResourceId)
+	if err := id.FromParseResult(*parsed); err != nil {
+		return fmt.Errorf("parsing %q: %+v", d.Id(), err)
+	}
+
+	return pluginsdk.SetResourceIdentityData(d, id)
+}
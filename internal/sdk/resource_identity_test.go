@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type identityTestResource struct{}
+
+func (identityTestResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+	}
+}
+
+func (identityTestResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (identityTestResource) ModelObject() interface{} {
+	return nil
+}
+
+func (identityTestResource) ResourceType() string {
+	return "azurerm_identity_test"
+}
+
+func (identityTestResource) Create() ResourceFunc {
+	return identityTestResourceFunc()
+}
+
+func (identityTestResource) Read() ResourceFunc {
+	return identityTestResourceFunc()
+}
+
+func (identityTestResource) Delete() ResourceFunc {
+	return identityTestResourceFunc()
+}
+
+func (identityTestResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validation.StringIsNotEmpty
+}
+
+type identityTypeTestResource struct {
+	identityTestResource
+}
+
+func (identityTypeTestResource) IdentityType() resourceids.ResourceId {
+	return &commonids.KeyVaultId{}
+}
+
+func identityTestResourceFunc() ResourceFunc {
+	return ResourceFunc{
+		Func: func(ctx context.Context, metadata ResourceMetaData) error {
+			return nil
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func TestResourceWrapperIdentity(t *testing.T) {
+	testData := []struct {
+		name     string
+		input    Resource
+		expected []string
+	}{
+		{
+			name:     "without identity type",
+			input:    identityTestResource{},
+			expected: nil,
+		},
+		{
+			name:     "with identity type",
+			input:    identityTypeTestResource{},
+			expected: []string{"name", "resource_group_name", "subscription_id"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		wrapper := NewResourceWrapper(v.input)
+		resource, err := wrapper.Resource()
+		if err != nil {
+			t.Fatalf("building resource: %+v", err)
+		}
+
+		if v.expected == nil {
+			if resource.Identity != nil {
+				t.Fatalf("expected no Identity but got one")
+			}
+			continue
+		}
+
+		if resource.Identity == nil {
+			t.Fatalf("expected an Identity but didn't get one")
+		}
+		identitySchema := resource.Identity.SchemaFunc()
+		if len(identitySchema) != len(v.expected) {
+			t.Fatalf("expected %d Identity attributes but got %d", len(v.expected), len(identitySchema))
+		}
+		for _, key := range v.expected {
+			if _, ok := identitySchema[key]; !ok {
+				t.Fatalf("expected the Identity attribute %q but it wasn't present", key)
+			}
+		}
+	}
+}
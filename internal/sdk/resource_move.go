@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+// ResourceMove defines how the state for an existing Resource of one Resource Type (typically a Resource which
+// has been superseded) can be moved into another Resource Type using a `moved` block - rather than removing the
+// existing Resource from the state and importing it again.
+type ResourceMove struct {
+	// SourceResourceType is the Resource Type being moved from, e.g. `azurerm_virtual_machine`
+	SourceResourceType string
+
+	// TargetResourceType is the Resource Type being moved to, e.g. `azurerm_linux_virtual_machine`
+	TargetResourceType string
+
+	// MoveFunc returns the state for the Target Resource Type from the state of the Source Resource Type, or
+	// an error when this Resource can't be moved into the Target Resource Type.
+	MoveFunc func(ctx context.Context, sourceState ResourceMoveSourceState) (*ResourceMoveTargetState, error)
+}
+
+// ResourceMoveSourceState is the state of the Resource being moved, as decoded from the raw JSON state
+type ResourceMoveSourceState map[string]interface{}
+
+// ResourceMoveTargetState is the state of the Resource after it's been moved.
+//
+// Only the Resource ID and any attributes which can't be retrieved from the Azure API (such as secrets) need
+// to be returned, since the remaining attributes are populated when the Resource is subsequently refreshed.
+type ResourceMoveTargetState struct {
+	// ResourceId is the Resource ID for the Target Resource Type
+	ResourceId resourceids.ResourceId
+
+	// Attributes are the values for any top-level attributes of the Target Resource Type which can't be
+	// retrieved from the Azure API - only primitive values (bools, numbers and strings) are supported.
+	Attributes map[string]interface{}
+}
+
+// Value returns the value at the specified path within the state (for example `os_profile.0.admin_password`)
+// or nil when this isn't present.
+func (s ResourceMoveSourceState) Value(path string) interface{} {
+	var current interface{} = map[string]interface{}(s)
+	for _, key := range strings.Split(path, ".") {
+		switch v := current.(type) {
+		case map[string]interface{}:
+			current = v[key]
+
+		case []interface{}:
+			index, err := strconv.Atoi(key)
+			if err != nil || index < 0 || index >= len(v) {
+				return nil
+			}
+			current = v[index]
+
+		default:
+			return nil
+		}
+	}
+
+	return current
+}
+
+// String returns the string value at the specified path within the state, or an empty string when this isn't present.
+func (s ResourceMoveSourceState) String(path string) string {
+	if v, ok := s.Value(path).(string); ok {
+		return v
+	}
+	return ""
+}
+
+// IsEmpty returns whether there's no value (or an empty value) at the specified path within the state.
+func (s ResourceMoveSourceState) IsEmpty(path string) bool {
+	switch v := s.Value(path).(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
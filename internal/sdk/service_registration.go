@@ -64,3 +64,24 @@ type UntypedServiceRegistrationWithAGitHubLabel interface {
 
 	AssociatedGitHubLabel() string
 }
+
+// ServiceRegistrationWithListResources is a superset of either a TypedServiceRegistration or an
+// UntypedServiceRegistration allowing the Service to expose List Resources, which can be used to
+// discover existing Resources via `terraform query`.
+//
+// NOTE: this is intentionally an optional interface since List Resources are opt-in per Resource
+type ServiceRegistrationWithListResources interface {
+	// ListResources returns a list of List Resources supported by this Service
+	ListResources() []ListResource
+}
+
+// ServiceRegistrationWithResourceMoves is a superset of either a TypedServiceRegistration or an
+// UntypedServiceRegistration allowing the Service to define how the state of an existing Resource can
+// be moved into a Resource within this Service, using a `moved` block.
+//
+// NOTE: this is intentionally an optional interface since moving state between Resource Types is only
+// supported where the Resources represent the same Azure Resource.
+type ServiceRegistrationWithResourceMoves interface {
+	// ResourceMoves returns a list of the Resource Moves into the Resources supported by this Service
+	ResourceMoves() []ResourceMove
+}
@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const subscriptionOverrideArgumentName = "subscription_id"
+
+// subscriptionOverrideSchema returns the schema for the `subscription_id` argument added to Resources
+// implementing ResourceWithSubscriptionOverride
+func subscriptionOverrideSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.IsUUID,
+		Description:  "The ID of the Subscription where this Resource should exist. Defaults to the Subscription configured for the Provider.",
+	}
+}
+
+// subscriptionIdFromResourceId returns the Subscription ID from the specified Resource ID, or an empty string
+// when this isn't a Subscription-scoped Resource ID
+func subscriptionIdFromResourceId(input string) string {
+	segments := strings.Split(strings.TrimPrefix(input, "/"), "/")
+	if len(segments) < 2 || !strings.EqualFold(segments[0], "subscriptions") {
+		return ""
+	}
+
+	return segments[1]
+}
@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import "testing"
+
+func TestSubscriptionIdFromResourceId(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected string
+	}{
+		{
+			// empty, e.g. during Create
+			input:    "",
+			expected: "",
+		},
+		{
+			input:    "/subscriptions/12345678-1234-9876-4563-123456789012",
+			expected: "12345678-1234-9876-4563-123456789012",
+		},
+		{
+			input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example-resources/providers/Microsoft.Communication/communicationServices/example",
+			expected: "12345678-1234-9876-4563-123456789012",
+		},
+		{
+			// mixed casing
+			input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/resourcegroups/example-resources",
+			expected: "12345678-1234-9876-4563-123456789012",
+		},
+		{
+			// not Subscription-scoped
+			input:    "/providers/Microsoft.Management/managementGroups/example",
+			expected: "",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.input)
+
+		actual := subscriptionIdFromResourceId(v.input)
+		if actual != v.expected {
+			t.Fatalf("expected %q but got %q", v.expected, actual)
+		}
+	}
+}
@@ -8,9 +8,12 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/operations"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -19,6 +22,9 @@ import (
 type ResourceWrapper struct {
 	logger   Logger
 	resource Resource
+
+	// identityType is the Resource ID type used for the Resource Identity, when the Resource implements ResourceWithIdentityType
+	identityType resourceids.ResourceId
 }
 
 // NewResourceWrapper returns a ResourceWrapper for this Resource implementation
@@ -36,6 +42,13 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 		return nil, fmt.Errorf("building Schema: %+v", err)
 	}
 
+	if _, ok := rw.resource.(ResourceWithSubscriptionOverride); ok {
+		if _, exists := (*resourceSchema)[subscriptionOverrideArgumentName]; exists {
+			return nil, fmt.Errorf("Resource %q implements ResourceWithSubscriptionOverride but already defines %q in the schema", rw.resource.ResourceType(), subscriptionOverrideArgumentName)
+		}
+		(*resourceSchema)[subscriptionOverrideArgumentName] = subscriptionOverrideSchema()
+	}
+
 	modelObj := rw.resource.ModelObject()
 	if modelObj != nil {
 		if err := ValidateModelObject(modelObj); err != nil {
@@ -50,26 +63,46 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 	resource := schema.Resource{
 		Schema: *resourceSchema,
 
-		CreateContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-			metaData := runArgs(d, meta, rw.logger)
-			err := rw.resource.Create().Func(ctx, metaData)
+		CreateContext: rw.diagnosticsWrapper(*resourceSchema, func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+			metaData, err := rw.runArgs(d, meta)
+			if err != nil {
+				return err
+			}
+			// any in-flight operation recorded for this Resource is only resumed if the configuration is unchanged
+			ctx = operations.WithConfigHash(ctx, d)
+			err = rw.resource.Create().Func(ctx, metaData)
 			if err != nil {
 				return err
 			}
+			markAsModifiedInResourceGraph(metaData)
 			// NOTE: whilst this may look like we should use the Read
 			// functions timeout here, we're still /technically/ in the
 			// Create function so reusing that timeout should be sufficient
-			return rw.resource.Read().Func(ctx, metaData)
+			return rw.read(ctx, metaData)
 		}),
 
 		// looks like these could be reused, easiest if they're not
-		ReadContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-			metaData := runArgs(d, meta, rw.logger)
-			return rw.resource.Read().Func(ctx, metaData)
+		ReadContext: rw.diagnosticsWrapper(*resourceSchema, func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+			metaData, err := rw.runArgs(d, meta)
+			if err != nil {
+				return err
+			}
+			metaData.refreshing = true
+			return rw.read(ctx, metaData)
 		}),
-		DeleteContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-			metaData := runArgs(d, meta, rw.logger)
-			return rw.resource.Delete().Func(ctx, metaData)
+		DeleteContext: rw.diagnosticsWrapper(*resourceSchema, func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+			metaData, err := rw.runArgs(d, meta)
+			if err != nil {
+				return err
+			}
+			if err := rw.resource.Delete().Func(ctx, metaData); err != nil {
+				return err
+			}
+			markAsModifiedInResourceGraph(metaData)
+
+			// any in-flight operation recorded for this Resource no longer applies
+			operations.Forget(d.Id())
+			return nil
 		}),
 
 		Timeouts: &schema.ResourceTimeout{
@@ -90,17 +123,21 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 				for _, err := range errors {
 					out += err.Error()
 				}
-				return fmt.Errorf(out)
+				return fmt.Errorf("%s", out)
 			}
 
 			return nil
 		}, func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
 			if v, ok := rw.resource.(ResourceWithCustomImporter); ok {
-				metaData := runArgs(d, meta, rw.logger)
+				metaData, err := rw.runArgs(d, meta)
+				if err != nil {
+					return nil, err
+				}
 
-				ctx, cancel := context.WithTimeout(ctx, rw.resource.Read().Timeout)
+				// the Read timeout is taken from the ResourceData so that the Provider's `default_timeouts` are used
+				ctx, cancel := context.WithTimeout(ctx, d.Timeout(pluginsdk.TimeoutRead))
 				defer cancel()
-				err := v.CustomImporter()(ctx, metaData)
+				err = v.CustomImporter()(ctx, metaData)
 				if err != nil {
 					return nil, err
 				}
@@ -115,17 +152,21 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 	// Not all resources support update - so this is an separate interface
 	// implementations can opt to interface
 	if v, ok := rw.resource.(ResourceWithUpdate); ok {
-		resource.UpdateContext = rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-			metaData := runArgs(d, meta, rw.logger)
+		resource.UpdateContext = rw.diagnosticsWrapper(*resourceSchema, func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+			metaData, err := rw.runArgs(d, meta)
+			if err != nil {
+				return err
+			}
 
-			err := v.Update().Func(ctx, metaData)
+			err = v.Update().Func(ctx, metaData)
 			if err != nil {
 				return err
 			}
+			markAsModifiedInResourceGraph(metaData)
 			// whilst this may look like we should use the Update timeout here
 			// we're still "technically" in the update method, so reusing the
 			// Update's timeout should be fine
-			return rw.resource.Read().Func(ctx, metaData)
+			return rw.read(ctx, metaData)
 		})
 		resource.Timeouts.Update = d(v.Update().Timeout)
 	}
@@ -142,6 +183,19 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 				serializationDebugLogger: NullLogger{},
 			}
 
+			if _, ok := rw.resource.(ResourceWithSubscriptionOverride); ok {
+				subscriptionId := subscriptionIdFromResourceId(d.Id())
+				if subscriptionId == "" {
+					subscriptionId = d.Get(subscriptionOverrideArgumentName).(string)
+				}
+
+				subscriptionClient, err := client.ForSubscription(subscriptionId)
+				if err != nil {
+					return err
+				}
+				metaData.Client = subscriptionClient
+			}
+
 			return v.CustomizeDiff().Func(ctx, metaData)
 		}
 	}
@@ -172,6 +226,17 @@ and we recommend using the %[2]q resource instead.
 `, rw.resource.ResourceType(), replacementResourceType)
 	}
 
+	if v, ok := rw.resource.(ResourceWithIdentityType); ok {
+		idType := v.IdentityType()
+		if idType == nil {
+			return nil, fmt.Errorf("Resource %q must return a non-nil IdentityType if implementing ResourceWithIdentityType", rw.resource.ResourceType())
+		}
+
+		resource.Identity = pluginsdk.GenerateIdentitySchema(idType)
+		resource.Importer = pluginsdk.ImporterSupportingIdentity(idType, resource.Importer)
+		rw.identityType = idType
+	}
+
 	if v, ok := rw.resource.(ResourceWithStateMigration); ok {
 		stateUpgradeData := v.StateUpgraders()
 		resource.SchemaVersion = stateUpgradeData.SchemaVersion
@@ -182,20 +247,61 @@ and we recommend using the %[2]q resource instead.
 	return &resource, nil
 }
 
-func (rw *ResourceWrapper) diagnosticsWrapper(in func(ctx context.Context, d *schema.ResourceData, meta interface{}) error) func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	return diagnosticsWrapper(in, rw.logger)
+// runArgs returns the ResourceMetaData for this Resource - when the Resource implements ResourceWithSubscriptionOverride
+// the Client is scoped to the Subscription the Resource exists in (or should be provisioned in)
+func (rw *ResourceWrapper) runArgs(d *schema.ResourceData, meta interface{}) (ResourceMetaData, error) {
+	metaData := runArgs(d, meta, rw.logger)
+
+	if _, ok := rw.resource.(ResourceWithSubscriptionOverride); ok {
+		// the Subscription within the Resource ID takes precedence, since this is where the Resource exists
+		subscriptionId := subscriptionIdFromResourceId(d.Id())
+		if subscriptionId == "" {
+			subscriptionId = d.Get(subscriptionOverrideArgumentName).(string)
+		}
+
+		client, err := metaData.Client.ForSubscription(subscriptionId)
+		if err != nil {
+			return metaData, err
+		}
+		metaData.Client = client
+	}
+
+	return metaData, nil
 }
 
-func diagnosticsWrapper(in func(ctx context.Context, d *schema.ResourceData, meta interface{}) error, logger Logger) func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+// read calls the Read function for this Resource, and then sets the Resource Identity (where supported) and the
+// `subscription_id` when the Resource implements ResourceWithSubscriptionOverride
+func (rw *ResourceWrapper) read(ctx context.Context, metaData ResourceMetaData) error {
+	if err := rw.resource.Read().Func(ctx, metaData); err != nil {
+		return err
+	}
+
+	if rw.identityType != nil && metaData.ResourceData.Id() != "" {
+		if err := setIdentityFromResourceId(metaData.ResourceData, rw.identityType); err != nil {
+			return err
+		}
+	}
+
+	if _, ok := rw.resource.(ResourceWithSubscriptionOverride); ok && metaData.ResourceData.Id() != "" {
+		return metaData.ResourceData.Set(subscriptionOverrideArgumentName, subscriptionIdFromResourceId(metaData.ResourceData.Id()))
+	}
+
+	return nil
+}
+
+func (rw *ResourceWrapper) diagnosticsWrapper(resourceSchema map[string]*schema.Schema, in func(ctx context.Context, d *schema.ResourceData, meta interface{}) error) func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return diagnosticsWrapper(in, rw.logger, rw.resource.ModelObject(), resourceSchema)
+}
+
+// diagnosticsWrapper converts the error returned from the function into Diagnostics - where the model and schema are
+// used to map any errors returned from Azure Resource Manager to the Attribute Path of the offending field
+func diagnosticsWrapper(in func(ctx context.Context, d *schema.ResourceData, meta interface{}) error, logger Logger, model interface{}, resourceSchema map[string]*schema.Schema) func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		ctx = common.WithArmErrorRecorder(ctx)
+
 		out := make([]diag.Diagnostic, 0)
 		if err := in(ctx, d, meta); err != nil {
-			out = append(out, diag.Diagnostic{
-				Severity:      diag.Error,
-				Summary:       err.Error(),
-				Detail:        err.Error(),
-				AttributePath: nil,
-			})
+			out = append(out, errorDiagnostics(ctx, err, model, resourceSchema)...)
 		}
 
 		if diagsLogger, ok := logger.(*DiagnosticsLogger); ok {
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"strconv"
 	"strings"
 	"time"
@@ -34,6 +35,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/operations"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
 	apimValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -685,15 +687,19 @@ func resourceApiManagementServiceCreate(d *pluginsdk.ResourceData, meta interfac
 
 	id := apimanagementservice.NewServiceID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
-	existing, err := client.Get(ctx, id)
-	if err != nil {
+	// provisioning an API Management Service can take over an hour, if a previous run was interrupted whilst
+	// this was being created we resume polling the existing operation rather than erroring
+	if !operations.InFlight(ctx, id) {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of an existing %s: %+v", id, err)
+			}
+		}
 		if !response.WasNotFound(existing.HttpResponse) {
-			return fmt.Errorf("checking for presence of an existing %s: %+v", id, err)
+			return tf.ImportAsExistsError("azurerm_api_management", id.ID())
 		}
 	}
-	if !response.WasNotFound(existing.HttpResponse) {
-		return tf.ImportAsExistsError("azurerm_api_management", id.ID())
-	}
 
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	t := d.Get("tags").(map[string]interface{})
@@ -849,7 +855,10 @@ func resourceApiManagementServiceCreate(d *pluginsdk.ResourceData, meta interfac
 		properties.Zones = &zones
 	}
 
-	if err := client.CreateOrUpdateThenPoll(ctx, id, properties); err != nil {
+	if err := operations.ResumeOrStart(ctx, client.Client, id, func() (*http.Response, error) {
+		resp, err := client.CreateOrUpdate(ctx, id, properties)
+		return resp.HttpResponse, err
+	}); err != nil {
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
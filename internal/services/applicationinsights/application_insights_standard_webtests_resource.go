@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	components "github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2020-02-02/componentsapis"
 	webtests "github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2022-06-15/webtestsapis"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -539,6 +540,10 @@ func (ApplicationInsightsStandardWebTestResource) IDValidationFunc() pluginsdk.S
 	return webtests.ValidateWebTestID
 }
 
+func (ApplicationInsightsStandardWebTestResource) IdentityType() resourceids.ResourceId {
+	return &webtests.WebTestId{}
+}
+
 func expandApplicationInsightsStandardWebTestRequest(input []RequestModel) (request *webtests.WebTestPropertiesRequest) {
 	if len(input) == 0 {
 		return nil
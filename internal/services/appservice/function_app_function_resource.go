@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/webapps"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -59,6 +60,10 @@ func (r FunctionAppFunctionResource) IDValidationFunc() pluginsdk.SchemaValidate
 	return webapps.ValidateFunctionID
 }
 
+func (r FunctionAppFunctionResource) IdentityType() resourceids.ResourceId {
+	return &webapps.FunctionId{}
+}
+
 func (r FunctionAppFunctionResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
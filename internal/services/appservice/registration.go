@@ -7,7 +7,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 )
 
-var _ sdk.TypedServiceRegistrationWithAGitHubLabel = Registration{}
+var (
+	_ sdk.TypedServiceRegistrationWithAGitHubLabel = Registration{}
+	_ sdk.ServiceRegistrationWithResourceMoves     = Registration{}
+)
 
 type Registration struct{}
 
@@ -61,3 +64,13 @@ func (r Registration) Resources() []sdk.Resource {
 		WindowsWebAppSlotResource{},
 	}
 }
+
+// ResourceMoves returns a list of the Resource Moves into the Resources supported by this Service
+func (r Registration) ResourceMoves() []sdk.ResourceMove {
+	return []sdk.ResourceMove{
+		functionAppResourceMove("azurerm_linux_function_app", true),
+		functionAppResourceMove("azurerm_windows_function_app", false),
+		webAppResourceMove("azurerm_linux_web_app", true),
+		webAppResourceMove("azurerm_windows_web_app", false),
+	}
+}
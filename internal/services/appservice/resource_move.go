@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+// webAppResourceMove returns a Resource Move from the (superseded) `azurerm_app_service` resource into the
+// `azurerm_linux_web_app` or `azurerm_windows_web_app` resources.
+func webAppResourceMove(targetResourceType string, linux bool) sdk.ResourceMove {
+	return sdk.ResourceMove{
+		SourceResourceType: "azurerm_app_service",
+		TargetResourceType: targetResourceType,
+		MoveFunc: func(ctx context.Context, sourceState sdk.ResourceMoveSourceState) (*sdk.ResourceMoveTargetState, error) {
+			id, err := commonids.ParseAppServiceIDInsensitively(sourceState.String("id"))
+			if err != nil {
+				return nil, err
+			}
+
+			// the `linux_fx_version` is only specified for Linux App Services
+			if isLinux := !sourceState.IsEmpty("site_config.0.linux_fx_version"); isLinux != linux {
+				return nil, fmt.Errorf("%s is not a %s App Service and cannot be moved to %q", id, webAppOperatingSystem(linux), targetResourceType)
+			}
+
+			return &sdk.ResourceMoveTargetState{
+				ResourceId: id,
+			}, nil
+		},
+	}
+}
+
+// functionAppResourceMove returns a Resource Move from the (superseded) `azurerm_function_app` resource into the
+// `azurerm_linux_function_app` or `azurerm_windows_function_app` resources.
+func functionAppResourceMove(targetResourceType string, linux bool) sdk.ResourceMove {
+	return sdk.ResourceMove{
+		SourceResourceType: "azurerm_function_app",
+		TargetResourceType: targetResourceType,
+		MoveFunc: func(ctx context.Context, sourceState sdk.ResourceMoveSourceState) (*sdk.ResourceMoveTargetState, error) {
+			id, err := commonids.ParseFunctionAppIDInsensitively(sourceState.String("id"))
+			if err != nil {
+				return nil, err
+			}
+
+			if isLinux := strings.EqualFold(sourceState.String("os_type"), "linux"); isLinux != linux {
+				return nil, fmt.Errorf("%s is not a %s Function App and cannot be moved to %q", id, webAppOperatingSystem(linux), targetResourceType)
+			}
+
+			return &sdk.ResourceMoveTargetState{
+				ResourceId: id,
+			}, nil
+		},
+	}
+}
+
+func webAppOperatingSystem(linux bool) string {
+	if linux {
+		return "Linux"
+	}
+	return "Windows"
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/staticsites"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -38,6 +39,10 @@ func (r StaticWebAppFunctionAppRegistrationResource) IDValidationFunc() pluginsd
 	return staticsites.ValidateUserProvidedFunctionAppID
 }
 
+func (r StaticWebAppFunctionAppRegistrationResource) IdentityType() resourceids.ResourceId {
+	return &staticsites.UserProvidedFunctionAppId{}
+}
+
 func (r StaticWebAppFunctionAppRegistrationResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"static_web_app_id": {
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/resourceproviders"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/staticsites"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -132,6 +133,10 @@ func (r StaticWebAppResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return staticsites.ValidateStaticSiteID
 }
 
+func (r StaticWebAppResource) IdentityType() resourceids.ResourceId {
+	return &staticsites.StaticSiteId{}
+}
+
 func (r StaticWebAppResource) ResourceType() string {
 	return "azurerm_static_web_app"
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/webapps"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -41,6 +42,10 @@ func (r WebAppActiveSlotResource) IDValidationFunc() pluginsdk.SchemaValidateFun
 	return commonids.ValidateAppServiceID
 }
 
+func (r WebAppActiveSlotResource) IdentityType() resourceids.ResourceId {
+	return &commonids.AppServiceId{}
+}
+
 func (r WebAppActiveSlotResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"slot_id": {
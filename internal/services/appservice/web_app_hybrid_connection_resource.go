@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/relay/2021-11-01/hybridconnections"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/relay/2021-11-01/namespaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/webapps"
@@ -53,6 +54,10 @@ func (r WebAppHybridConnectionResource) IDValidationFunc() pluginsdk.SchemaValid
 	return webapps.ValidateRelayID
 }
 
+func (r WebAppHybridConnectionResource) IdentityType() resourceids.ResourceId {
+	return &webapps.RelayId{}
+}
+
 func (r WebAppHybridConnectionResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"web_app_id": {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/automationaccount"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/connectiontype"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -220,3 +221,7 @@ func (m AutomationConnectionTypeResource) Delete() sdk.ResourceFunc {
 func (m AutomationConnectionTypeResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return connectiontype.ValidateConnectionTypeID
 }
+
+func (m AutomationConnectionTypeResource) IdentityType() resourceids.ResourceId {
+	return &connectiontype.ConnectionTypeId{}
+}
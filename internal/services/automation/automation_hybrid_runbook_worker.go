@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/hybridrunbookworker"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -206,3 +207,7 @@ func (m HybridRunbookWorkerResource) Delete() sdk.ResourceFunc {
 func (m HybridRunbookWorkerResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return hybridrunbookworker.ValidateHybridRunbookWorkerID
 }
+
+func (m HybridRunbookWorkerResource) IdentityType() resourceids.ResourceId {
+	return &hybridrunbookworker.HybridRunbookWorkerId{}
+}
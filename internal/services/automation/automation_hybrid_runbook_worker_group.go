@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/hybridrunbookworkergroup"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -192,3 +193,7 @@ func (m HybridRunbookWorkerGroupResource) Delete() sdk.ResourceFunc {
 func (m HybridRunbookWorkerGroupResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return hybridrunbookworkergroup.ValidateHybridRunbookWorkerGroupID
 }
+
+func (m HybridRunbookWorkerGroupResource) IdentityType() resourceids.ResourceId {
+	return &hybridrunbookworkergroup.HybridRunbookWorkerGroupId{}
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/module"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -106,6 +107,10 @@ func (r PowerShell72ModuleResource) IDValidationFunc() pluginsdk.SchemaValidateF
 	return module.ValidatePowerShell72ModuleID
 }
 
+func (r PowerShell72ModuleResource) IdentityType() resourceids.ResourceId {
+	return &module.PowerShell72ModuleId{}
+}
+
 func (r PowerShell72ModuleResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/python3package"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -252,3 +253,7 @@ func (m Python3PackageResource) Delete() sdk.ResourceFunc {
 func (m Python3PackageResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return python3package.ValidatePython3PackageID
 }
+
+func (m Python3PackageResource) IdentityType() resourceids.ResourceId {
+	return &python3package.Python3PackageId{}
+}
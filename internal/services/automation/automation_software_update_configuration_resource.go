@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2019-06-01/softwareupdateconfiguration"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/automationaccount"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -1192,6 +1193,10 @@ func (m SoftwareUpdateConfigurationResource) IDValidationFunc() pluginsdk.Schema
 	return softwareupdateconfiguration.ValidateSoftwareUpdateConfigurationID
 }
 
+func (m SoftwareUpdateConfigurationResource) IdentityType() resourceids.ResourceId {
+	return &softwareupdateconfiguration.SoftwareUpdateConfigurationId{}
+}
+
 func expandUpdateConfig(input SoftwareUpdateConfigurationModel) *softwareupdateconfiguration.SoftwareUpdateConfiguration {
 	result := &softwareupdateconfiguration.SoftwareUpdateConfiguration{
 		Properties: softwareupdateconfiguration.SoftwareUpdateConfigurationProperties{
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/sourcecontrol"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/migration"
@@ -335,3 +336,7 @@ func (m SourceControlResource) Delete() sdk.ResourceFunc {
 func (m SourceControlResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return sourcecontrol.ValidateSourceControlID
 }
+
+func (m SourceControlResource) IdentityType() resourceids.ResourceId {
+	return &sourcecontrol.SourceControlId{}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/logicalnetworks"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/extendedlocation/2021-08-15/customlocations"
@@ -32,6 +33,10 @@ func (StackHCILogicalNetworkResource) IDValidationFunc() pluginsdk.SchemaValidat
 	return logicalnetworks.ValidateLogicalNetworkID
 }
 
+func (StackHCILogicalNetworkResource) IdentityType() resourceids.ResourceId {
+	return &logicalnetworks.LogicalNetworkId{}
+}
+
 func (StackHCILogicalNetworkResource) ResourceType() string {
 	return "azurerm_stack_hci_logical_network"
 }
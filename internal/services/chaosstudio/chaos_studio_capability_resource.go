@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/chaosstudio/2023-11-01/capabilities"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/chaosstudio/2023-11-01/capabilitytypes"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -36,6 +37,10 @@ func (r ChaosStudioCapabilityResource) IDValidationFunc() pluginsdk.SchemaValida
 	return commonids.ValidateChaosStudioCapabilityID
 }
 
+func (r ChaosStudioCapabilityResource) IdentityType() resourceids.ResourceId {
+	return &commonids.ChaosStudioCapabilityId{}
+}
+
 func (r ChaosStudioCapabilityResource) ResourceType() string {
 	return "azurerm_chaos_studio_capability"
 }
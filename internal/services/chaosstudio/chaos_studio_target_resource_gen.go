@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/chaosstudio/2023-11-01/targets"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -35,6 +36,10 @@ type ChaosStudioTargetResourceSchema struct {
 func (r ChaosStudioTargetResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return commonids.ValidateChaosStudioTargetID
 }
+
+func (r ChaosStudioTargetResource) IdentityType() resourceids.ResourceId {
+	return &commonids.ChaosStudioTargetId{}
+}
 func (r ChaosStudioTargetResource) ResourceType() string {
 	return "azurerm_chaos_studio_target"
 }
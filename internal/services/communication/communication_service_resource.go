@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/communication/2023-03-31/communicationservices"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/migration"
@@ -21,6 +22,7 @@ import (
 )
 
 var _ sdk.ResourceWithUpdate = CommunicationServiceResource{}
+var _ sdk.ResourceWithSubscriptionOverride = CommunicationServiceResource{}
 var _ sdk.ResourceWithStateMigration = CommunicationServiceResource{}
 
 type CommunicationServiceResource struct{}
@@ -123,6 +125,8 @@ func (CommunicationServiceResource) ResourceType() string {
 	return "azurerm_communication_service"
 }
 
+func (CommunicationServiceResource) SupportsSubscriptionOverride() {}
+
 func (r CommunicationServiceResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -283,3 +287,7 @@ func (CommunicationServiceResource) Delete() sdk.ResourceFunc {
 func (CommunicationServiceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return communicationservices.ValidateCommunicationServiceID
 }
+
+func (CommunicationServiceResource) IdentityType() resourceids.ResourceId {
+	return &communicationservices.CommunicationServiceId{}
+}
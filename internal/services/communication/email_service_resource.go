@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/communication/2023-03-31/emailservices"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/validate"
@@ -20,6 +21,7 @@ import (
 )
 
 var _ sdk.ResourceWithUpdate = EmailCommunicationServiceResource{}
+var _ sdk.ResourceWithSubscriptionOverride = EmailCommunicationServiceResource{}
 
 type EmailCommunicationServiceResource struct{}
 
@@ -81,6 +83,8 @@ func (EmailCommunicationServiceResource) ResourceType() string {
 	return "azurerm_email_communication_service"
 }
 
+func (EmailCommunicationServiceResource) SupportsSubscriptionOverride() {}
+
 func (r EmailCommunicationServiceResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -229,3 +233,7 @@ func (EmailCommunicationServiceResource) Delete() sdk.ResourceFunc {
 func (EmailCommunicationServiceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return emailservices.ValidateEmailServiceID
 }
+
+func (EmailCommunicationServiceResource) IdentityType() resourceids.ResourceId {
+	return &emailservices.EmailServiceId{}
+}
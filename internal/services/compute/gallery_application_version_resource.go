@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-03/galleryapplications"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-03/galleryapplicationversions"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -217,6 +218,10 @@ func (r GalleryApplicationVersionResource) IDValidationFunc() pluginsdk.SchemaVa
 	return galleryapplicationversions.ValidateApplicationVersionID
 }
 
+func (r GalleryApplicationVersionResource) IdentityType() resourceids.ResourceId {
+	return &galleryapplicationversions.ApplicationVersionId{}
+}
+
 func (r GalleryApplicationVersionResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
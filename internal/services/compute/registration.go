@@ -4,10 +4,16 @@
 package compute
 
 import (
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+var (
+	_ sdk.ServiceRegistrationWithListResources = Registration{}
+	_ sdk.ServiceRegistrationWithResourceMoves = Registration{}
+)
+
 type Registration struct{}
 
 // Name is the name of this Service
@@ -98,3 +104,19 @@ func (r Registration) Resources() []sdk.Resource {
 		VirtualMachineGalleryApplicationAssignmentResource{},
 	}
 }
+
+// ListResources returns a list of List Resources supported by this Service
+func (r Registration) ListResources() []sdk.ListResource {
+	return []sdk.ListResource{
+		virtualMachineListResource("azurerm_linux_virtual_machine", resourceLinuxVirtualMachine, virtualmachines.OperatingSystemTypesLinux),
+		virtualMachineListResource("azurerm_windows_virtual_machine", resourceWindowsVirtualMachine, virtualmachines.OperatingSystemTypesWindows),
+	}
+}
+
+// ResourceMoves returns a list of the Resource Moves into the Resources supported by this Service
+func (r Registration) ResourceMoves() []sdk.ResourceMove {
+	return []sdk.ResourceMove{
+		virtualMachineResourceMove("azurerm_linux_virtual_machine", virtualmachines.OperatingSystemTypesLinux),
+		virtualMachineResourceMove("azurerm_windows_virtual_machine", virtualmachines.OperatingSystemTypesWindows),
+	}
+}
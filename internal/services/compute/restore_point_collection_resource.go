@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/restorepointcollections"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -45,6 +46,10 @@ func (r RestorePointCollectionResource) IDValidationFunc() pluginsdk.SchemaValid
 	return restorepointcollections.ValidateRestorePointCollectionID
 }
 
+func (r RestorePointCollectionResource) IdentityType() resourceids.ResourceId {
+	return &restorepointcollections.RestorePointCollectionId{}
+}
+
 func (r RestorePointCollectionResource) ResourceType() string {
 	return "azurerm_restore_point_collection"
 }
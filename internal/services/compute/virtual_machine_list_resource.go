@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// virtualMachineListResource returns a List Resource for the Virtual Machines using the specified Operating System,
+// since both the `azurerm_linux_virtual_machine` and `azurerm_windows_virtual_machine` resources share the same API
+func virtualMachineListResource(resourceType string, resource func() *pluginsdk.Resource, osType virtualmachines.OperatingSystemTypes) sdk.ListResource {
+	return sdk.ListResource{
+		ResourceType:        resourceType,
+		Resource:            resource,
+		ResourceGroupScoped: true,
+		List: func(ctx context.Context, client *clients.Client, resourceGroupName *string) ([]resourceids.ResourceId, error) {
+			var items []virtualmachines.VirtualMachine
+			if resourceGroupName != nil {
+				resourceGroupId := commonids.NewResourceGroupID(client.Account.SubscriptionId, *resourceGroupName)
+				resp, err := client.Compute.VirtualMachinesClient.ListComplete(ctx, resourceGroupId, virtualmachines.DefaultListOperationOptions())
+				if err != nil {
+					return nil, fmt.Errorf("listing Virtual Machines within %s: %+v", resourceGroupId, err)
+				}
+				items = resp.Items
+			} else {
+				subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
+				resp, err := client.Compute.VirtualMachinesClient.ListAllComplete(ctx, subscriptionId, virtualmachines.DefaultListAllOperationOptions())
+				if err != nil {
+					return nil, fmt.Errorf("listing Virtual Machines within %s: %+v", subscriptionId, err)
+				}
+				items = resp.Items
+			}
+
+			output := make([]resourceids.ResourceId, 0)
+			for _, item := range items {
+				if !virtualMachineHasOsType(item, osType) {
+					continue
+				}
+
+				id, err := virtualmachines.ParseVirtualMachineIDInsensitively(pointer.From(item.Id))
+				if err != nil {
+					return nil, err
+				}
+				output = append(output, id)
+			}
+
+			return output, nil
+		},
+	}
+}
+
+func virtualMachineHasOsType(input virtualmachines.VirtualMachine, osType virtualmachines.OperatingSystemTypes) bool {
+	if props := input.Properties; props != nil && props.StorageProfile != nil && props.StorageProfile.OsDisk != nil {
+		return pointer.From(props.StorageProfile.OsDisk.OsType) == osType
+	}
+	return false
+}
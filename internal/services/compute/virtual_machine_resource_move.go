@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+// virtualMachineResourceMove returns a Resource Move from the (superseded) `azurerm_virtual_machine` resource into
+// the specified Resource Type, for Virtual Machines using the specified Operating System.
+func virtualMachineResourceMove(targetResourceType string, osType virtualmachines.OperatingSystemTypes) sdk.ResourceMove {
+	return sdk.ResourceMove{
+		SourceResourceType: "azurerm_virtual_machine",
+		TargetResourceType: targetResourceType,
+		MoveFunc: func(ctx context.Context, sourceState sdk.ResourceMoveSourceState) (*sdk.ResourceMoveTargetState, error) {
+			id, err := virtualmachines.ParseVirtualMachineIDInsensitively(sourceState.String("id"))
+			if err != nil {
+				return nil, err
+			}
+
+			// the `azurerm_linux_virtual_machine` and `azurerm_windows_virtual_machine` resources only support Managed Disks
+			if sourceState.IsEmpty("storage_os_disk.0.managed_disk_id") {
+				return nil, fmt.Errorf("only Virtual Machines using a Managed Disk for the OS Disk can be moved - %s uses an Unmanaged Disk", id)
+			}
+
+			sourceOsType := sourceState.String("storage_os_disk.0.os_type")
+			if !sourceState.IsEmpty("os_profile_linux_config") {
+				sourceOsType = string(virtualmachines.OperatingSystemTypesLinux)
+			}
+			if !sourceState.IsEmpty("os_profile_windows_config") {
+				sourceOsType = string(virtualmachines.OperatingSystemTypesWindows)
+			}
+			if !strings.EqualFold(sourceOsType, string(osType)) {
+				return nil, fmt.Errorf("only %s Virtual Machines can be moved to %q but %s uses the Operating System %q", osType, targetResourceType, id, sourceOsType)
+			}
+
+			output := sdk.ResourceMoveTargetState{
+				ResourceId: id,
+				Attributes: map[string]interface{}{},
+			}
+
+			// the Admin Password isn't returned from the API, so must be retained from the existing state
+			if v := sourceState.String("os_profile.0.admin_password"); v != "" {
+				output.Attributes["admin_password"] = v
+			}
+
+			return &output, nil
+		},
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/restorepointcollections"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/restorepoints"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -37,6 +38,10 @@ func (r VirtualMachineRestorePointResource) IDValidationFunc() pluginsdk.SchemaV
 	return restorepoints.ValidateRestorePointID
 }
 
+func (r VirtualMachineRestorePointResource) IdentityType() resourceids.ResourceId {
+	return &restorepoints.RestorePointId{}
+}
+
 func (r VirtualMachineRestorePointResource) ResourceType() string {
 	return "azurerm_virtual_machine_restore_point"
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/certificates"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2024-03-01/managedenvironments"
@@ -53,6 +54,10 @@ func (r ContainerAppEnvironmentCertificateResource) IDValidationFunc() pluginsdk
 	return certificates.ValidateCertificateID
 }
 
+func (r ContainerAppEnvironmentCertificateResource) IdentityType() resourceids.ResourceId {
+	return &certificates.CertificateId{}
+}
+
 func (r ContainerAppEnvironmentCertificateResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
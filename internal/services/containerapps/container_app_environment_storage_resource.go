@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/managedenvironmentsstorages"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
@@ -43,6 +44,10 @@ func (r ContainerAppEnvironmentStorageResource) IDValidationFunc() pluginsdk.Sch
 	return managedenvironmentsstorages.ValidateStorageID
 }
 
+func (r ContainerAppEnvironmentStorageResource) IdentityType() resourceids.ResourceId {
+	return &managedenvironmentsstorages.StorageId{}
+}
+
 func (r ContainerAppEnvironmentStorageResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
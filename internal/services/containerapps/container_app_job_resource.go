@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/certificates"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/jobs"
@@ -66,6 +67,10 @@ func (r ContainerAppJobResource) IDValidationFunc() pluginsdk.SchemaValidateFunc
 	return jobs.ValidateJobID
 }
 
+func (r ContainerAppJobResource) IdentityType() resourceids.ResourceId {
+	return &jobs.JobId{}
+}
+
 func (r ContainerAppJobResource) Arguments() map[string]*schema.Schema {
 	schema := map[string]*pluginsdk.Schema{
 		"name": {
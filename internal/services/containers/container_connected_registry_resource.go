@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-06-01-preview/connectedregistries"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-06-01-preview/registries"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-06-01-preview/tokens"
@@ -196,6 +197,10 @@ func (r ContainerConnectedRegistryResource) IDValidationFunc() pluginsdk.SchemaV
 	return connectedregistries.ValidateConnectedRegistryID
 }
 
+func (r ContainerConnectedRegistryResource) IdentityType() resourceids.ResourceId {
+	return &connectedregistries.ConnectedRegistryId{}
+}
+
 func (r ContainerConnectedRegistryResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-06-01-preview/registries"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-07-01/cacherules"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-07-01/credentialsets"
@@ -244,6 +245,10 @@ func (ContainerRegistryCacheRule) IDValidationFunc() pluginsdk.SchemaValidateFun
 	return cacherules.ValidateCacheRuleID
 }
 
+func (ContainerRegistryCacheRule) IdentityType() resourceids.ResourceId {
+	return &cacherules.CacheRuleId{}
+}
+
 func (ContainerRegistryCacheRule) CustomizeDiff() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 5 * time.Minute,
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-03-02-preview/trustedaccess"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -34,6 +35,10 @@ type KubernetesClusterTrustedAccessRoleBindingResourceSchema struct {
 func (r KubernetesClusterTrustedAccessRoleBindingResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return trustedaccess.ValidateTrustedAccessRoleBindingID
 }
+
+func (r KubernetesClusterTrustedAccessRoleBindingResource) IdentityType() resourceids.ResourceId {
+	return &trustedaccess.TrustedAccessRoleBindingId{}
+}
 func (r KubernetesClusterTrustedAccessRoleBindingResource) ResourceType() string {
 	return "azurerm_kubernetes_cluster_trusted_access_role_binding"
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2024-04-01/fleetmembers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -35,6 +36,10 @@ type KubernetesFleetMemberResourceSchema struct {
 func (r KubernetesFleetMemberResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return fleetmembers.ValidateMemberID
 }
+
+func (r KubernetesFleetMemberResource) IdentityType() resourceids.ResourceId {
+	return &fleetmembers.MemberId{}
+}
 func (r KubernetesFleetMemberResource) ResourceType() string {
 	return "azurerm_kubernetes_fleet_member"
 }
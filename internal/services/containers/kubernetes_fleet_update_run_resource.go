@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2024-04-01/updateruns"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -63,6 +64,10 @@ func (r KubernetesFleetUpdateRunResource) IDValidationFunc() pluginsdk.SchemaVal
 	return updateruns.ValidateUpdateRunID
 }
 
+func (r KubernetesFleetUpdateRunResource) IdentityType() resourceids.ResourceId {
+	return &updateruns.UpdateRunId{}
+}
+
 func (r KubernetesFleetUpdateRunResource) ResourceType() string {
 	return "azurerm_kubernetes_fleet_update_run"
 }
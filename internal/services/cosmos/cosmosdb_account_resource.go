@@ -32,6 +32,7 @@ import (
 	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
 	keyVaultSuppress "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/suppress"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/preventdestroy"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -1712,6 +1713,12 @@ func resourceCosmosDbAccountDelete(d *pluginsdk.ResourceData, meta interface{})
 		return err
 	}
 
+	if err := preventdestroy.Check(ctx, meta.(*clients.Client), id, func(ctx context.Context) ([]string, error) {
+		return cosmosDbAccountDataItems(ctx, client, *id)
+	}); err != nil {
+		return err
+	}
+
 	if err := client.DatabaseAccountsDeleteThenPoll(ctx, *id); err != nil {
 		return fmt.Errorf("deleting CosmosDB Account %q (Resource Group %q): %+v", id.DatabaseAccountName, id.ResourceGroupName, err)
 	}
@@ -1742,6 +1749,90 @@ func resourceCosmosDbAccountDelete(d *pluginsdk.ResourceData, meta interface{})
 	return nil
 }
 
+// cosmosDbAccountDataItems returns the Databases, Keyspaces or Tables which exist within the CosmosDB Account,
+// using the API which the Account has been configured to use
+func cosmosDbAccountDataItems(ctx context.Context, client *cosmosdb.CosmosDBClient, id cosmosdb.DatabaseAccountId) ([]string, error) {
+	existing, err := client.DatabaseAccountsGet(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	capabilities := make(map[string]struct{})
+	kind := ""
+	if model := existing.Model; model != nil {
+		kind = string(pointer.From(model.Kind))
+		if props := model.Properties; props != nil && props.Capabilities != nil {
+			for _, v := range *props.Capabilities {
+				capabilities[strings.ToLower(pointer.From(v.Name))] = struct{}{}
+			}
+		}
+	}
+	hasCapability := func(capability databaseAccountCapabilities) bool {
+		_, ok := capabilities[strings.ToLower(string(capability))]
+		return ok
+	}
+
+	items := make([]string, 0)
+	switch {
+	case strings.EqualFold(kind, string(cosmosdb.DatabaseAccountKindMongoDB)):
+		resp, err := client.MongoDBResourcesListMongoDBDatabases(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("listing MongoDB Databases: %+v", err)
+		}
+		if resp.Model != nil && resp.Model.Value != nil {
+			for _, v := range *resp.Model.Value {
+				items = append(items, fmt.Sprintf("MongoDB Database %q", pointer.From(v.Name)))
+			}
+		}
+
+	case hasCapability(databaseAccountCapabilitiesEnableCassandra):
+		resp, err := client.CassandraResourcesListCassandraKeyspaces(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("listing Cassandra Keyspaces: %+v", err)
+		}
+		if resp.Model != nil && resp.Model.Value != nil {
+			for _, v := range *resp.Model.Value {
+				items = append(items, fmt.Sprintf("Cassandra Keyspace %q", pointer.From(v.Name)))
+			}
+		}
+
+	case hasCapability(databaseAccountCapabilitiesEnableGremlin):
+		resp, err := client.GremlinResourcesListGremlinDatabases(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("listing Gremlin Databases: %+v", err)
+		}
+		if resp.Model != nil && resp.Model.Value != nil {
+			for _, v := range *resp.Model.Value {
+				items = append(items, fmt.Sprintf("Gremlin Database %q", pointer.From(v.Name)))
+			}
+		}
+
+	case hasCapability(databaseAccountCapabilitiesEnableTable):
+		resp, err := client.TableResourcesListTables(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("listing Tables: %+v", err)
+		}
+		if resp.Model != nil && resp.Model.Value != nil {
+			for _, v := range *resp.Model.Value {
+				items = append(items, fmt.Sprintf("Table %q", pointer.From(v.Name)))
+			}
+		}
+
+	default:
+		resp, err := client.SqlResourcesListSqlDatabases(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("listing SQL Databases: %+v", err)
+		}
+		if resp.Model != nil && resp.Model.Value != nil {
+			for _, v := range *resp.Model.Value {
+				items = append(items, fmt.Sprintf("SQL Database %q", pointer.From(v.Name)))
+			}
+		}
+	}
+
+	return items, nil
+}
+
 func resourceCosmosDbAccountApiUpdate(client *cosmosdb.CosmosDBClient, ctx context.Context, id cosmosdb.DatabaseAccountId, account cosmosdb.DatabaseAccountUpdateParameters, d *pluginsdk.ResourceData) error {
 	if err := client.DatabaseAccountsUpdateThenPoll(ctx, id, account); err != nil {
 		return fmt.Errorf("updating CosmosDB Account %q (Resource Group %q): %+v", id.DatabaseAccountName, id.ResourceGroupName, err)
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresqlhsc/2022-11-08/configurations"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -38,6 +39,10 @@ func (r CosmosDbPostgreSQLCoordinatorConfigurationResource) IDValidationFunc() p
 	return configurations.ValidateCoordinatorConfigurationID
 }
 
+func (r CosmosDbPostgreSQLCoordinatorConfigurationResource) IdentityType() resourceids.ResourceId {
+	return &configurations.CoordinatorConfigurationId{}
+}
+
 func (r CosmosDbPostgreSQLCoordinatorConfigurationResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresqlhsc/2022-11-08/firewallrules"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/validate"
@@ -39,6 +40,10 @@ func (r CosmosDbPostgreSQLFirewallRuleResource) IDValidationFunc() pluginsdk.Sch
 	return firewallrules.ValidateFirewallRuleID
 }
 
+func (r CosmosDbPostgreSQLFirewallRuleResource) IdentityType() resourceids.ResourceId {
+	return &firewallrules.FirewallRuleId{}
+}
+
 func (r CosmosDbPostgreSQLFirewallRuleResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
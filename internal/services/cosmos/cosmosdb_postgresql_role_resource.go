@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresqlhsc/2022-11-08/roles"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/validate"
@@ -37,6 +38,10 @@ func (r CosmosDbPostgreSQLRoleResource) IDValidationFunc() pluginsdk.SchemaValid
 	return roles.ValidateRoleID
 }
 
+func (r CosmosDbPostgreSQLRoleResource) IdentityType() resourceids.ResourceId {
+	return &roles.RoleId{}
+}
+
 func (r CosmosDbPostgreSQLRoleResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
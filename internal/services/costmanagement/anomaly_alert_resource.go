@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/costmanagement/2022-06-01-preview/scheduledactions"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/costmanagement/2022-10-01/views"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -268,3 +269,7 @@ func (AnomalyAlertResource) Delete() sdk.ResourceFunc {
 func (AnomalyAlertResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return scheduledactions.ValidateScopedScheduledActionID
 }
+
+func (AnomalyAlertResource) IdentityType() resourceids.ResourceId {
+	return &scheduledactions.ScopedScheduledActionId{}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dashboard/2023-09-01/grafanaresource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -73,6 +74,10 @@ func (r DashboardGrafanaResource) IDValidationFunc() pluginsdk.SchemaValidateFun
 	return grafanaresource.ValidateGrafanaID
 }
 
+func (r DashboardGrafanaResource) IdentityType() resourceids.ResourceId {
+	return &grafanaresource.GrafanaId{}
+}
+
 func (r DashboardGrafanaResource) Arguments() map[string]*pluginsdk.Schema {
 	arguments := map[string]*pluginsdk.Schema{
 		"name": {
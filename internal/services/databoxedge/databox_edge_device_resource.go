@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/databoxedge/2022-03-01/devices"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -60,6 +61,10 @@ func (r EdgeDeviceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return devices.ValidateDataBoxEdgeDeviceID
 }
 
+func (r EdgeDeviceResource) IdentityType() resourceids.ResourceId {
+	return &devices.DataBoxEdgeDeviceId{}
+}
+
 func (r EdgeDeviceResource) Arguments() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"name": {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/credentials"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -131,6 +132,10 @@ func (DataFactoryCredentialServicePrincipalResource) IDValidationFunc() pluginsd
 	return credentials.ValidateCredentialID
 }
 
+func (DataFactoryCredentialServicePrincipalResource) IdentityType() resourceids.ResourceId {
+	return &credentials.CredentialId{}
+}
+
 func (r DataFactoryCredentialServicePrincipalResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 5 * time.Minute,
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2024-04-01/backuppolicies"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -71,6 +72,10 @@ func (r DataProtectionBackupPolicyKubernatesClusterResource) IDValidationFunc()
 	return backuppolicies.ValidateBackupPolicyID
 }
 
+func (r DataProtectionBackupPolicyKubernatesClusterResource) IdentityType() resourceids.ResourceId {
+	return &backuppolicies.BackupPolicyId{}
+}
+
 func (r DataProtectionBackupPolicyKubernatesClusterResource) Arguments() map[string]*pluginsdk.Schema {
 	arguments := map[string]*pluginsdk.Schema{
 		"name": {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2023-04-01/devboxdefinitions"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2023-04-01/images"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -42,6 +43,10 @@ func (r DevCenterDevBoxDefinitionResource) IDValidationFunc() pluginsdk.SchemaVa
 	return devboxdefinitions.ValidateDevCenterDevBoxDefinitionID
 }
 
+func (r DevCenterDevBoxDefinitionResource) IdentityType() resourceids.ResourceId {
+	return &devboxdefinitions.DevCenterDevBoxDefinitionId{}
+}
+
 func (r DevCenterDevBoxDefinitionResource) ResourceType() string {
 	return "azurerm_dev_center_dev_box_definition"
 }
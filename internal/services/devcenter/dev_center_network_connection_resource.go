@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2023-04-01/networkconnections"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/validate"
@@ -46,6 +47,10 @@ func (r DevCenterNetworkConnectionResource) IDValidationFunc() pluginsdk.SchemaV
 	return networkconnections.ValidateNetworkConnectionID
 }
 
+func (r DevCenterNetworkConnectionResource) IdentityType() resourceids.ResourceId {
+	return &networkconnections.NetworkConnectionId{}
+}
+
 func (r DevCenterNetworkConnectionResource) ResourceType() string {
 	return "azurerm_dev_center_network_connection"
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2023-04-01/projects"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -41,6 +42,10 @@ type DevCenterProjectResourceSchema struct {
 func (r DevCenterProjectResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return projects.ValidateProjectID
 }
+
+func (r DevCenterProjectResource) IdentityType() resourceids.ResourceId {
+	return &projects.ProjectId{}
+}
 func (r DevCenterProjectResource) ResourceType() string {
 	return "azurerm_dev_center_project"
 }
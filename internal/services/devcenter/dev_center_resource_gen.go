@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2023-04-01/devcenters"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -40,6 +41,10 @@ type DevCenterResourceSchema struct {
 func (r DevCenterResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return devcenters.ValidateDevCenterID
 }
+
+func (r DevCenterResource) IdentityType() resourceids.ResourceId {
+	return &devcenters.DevCenterId{}
+}
 func (r DevCenterResource) ResourceType() string {
 	return "azurerm_dev_center"
 }
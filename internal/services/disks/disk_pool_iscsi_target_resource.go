@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagepool/2021-08-01/iscsitargets"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -232,6 +233,10 @@ func (d DisksPoolIscsiTargetResource) IDValidationFunc() pluginsdk.SchemaValidat
 	return iscsitargets.ValidateIscsiTargetID
 }
 
+func (d DisksPoolIscsiTargetResource) IdentityType() resourceids.ResourceId {
+	return &iscsitargets.IscsiTargetId{}
+}
+
 func (DisksPoolIscsiTargetResource) retryError(action string, id string, err error) *pluginsdk.RetryError {
 	if err == nil {
 		return nil
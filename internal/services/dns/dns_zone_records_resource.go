@@ -0,0 +1,485 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/zonefile"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourceDnsZoneRecords() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceDnsZoneRecordsCreateUpdate,
+		Read:   resourceDnsZoneRecordsRead,
+		Update: resourceDnsZoneRecordsCreateUpdate,
+		Delete: resourceDnsZoneRecordsDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := recordsets.ParseDnsZoneID(id)
+			return err
+		}),
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceDnsZoneRecordsCustomizeDiff),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"dns_zone_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: recordsets.ValidateDnsZoneID,
+			},
+
+			"zone_file": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+
+			"preserve_unmanaged_records": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"record_set": {
+				Type:     pluginsdk.TypeSet,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"ttl": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"records": {
+							Type:     pluginsdk.TypeSet,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceDnsZoneRecordsCustomizeDiff parses the zone file during the plan, so that the changes to each Record Set
+// are shown individually rather than as a change to the zone file as a whole
+func resourceDnsZoneRecordsCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("zone_file") || !diff.NewValueKnown("dns_zone_id") {
+		return diff.SetNewComputed("record_set")
+	}
+
+	zoneId, err := recordsets.ParseDnsZoneID(diff.Get("dns_zone_id").(string))
+	if err != nil {
+		return err
+	}
+
+	recordSets, err := zonefile.Parse(diff.Get("zone_file").(string), zoneId.DnsZoneName)
+	if err != nil {
+		return fmt.Errorf("parsing `zone_file`: %+v", err)
+	}
+
+	return diff.SetNew("record_set", flattenDnsZoneRecordSets(recordSets))
+}
+
+func resourceDnsZoneRecordsCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Dns.RecordSets
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	zoneId, err := recordsets.ParseDnsZoneID(d.Get("dns_zone_id").(string))
+	if err != nil {
+		return err
+	}
+
+	desired, err := zonefile.Parse(d.Get("zone_file").(string), zoneId.DnsZoneName)
+	if err != nil {
+		return fmt.Errorf("parsing `zone_file`: %+v", err)
+	}
+
+	existing, err := listDnsZoneRecordSets(ctx, client, *zoneId)
+	if err != nil {
+		return err
+	}
+
+	// the Record Sets within the zone are only taken over by importing the resource, so that existing Record Sets aren't
+	// overwritten or deleted unexpectedly
+	if d.IsNewResource() {
+		for _, recordSet := range desired {
+			if _, exists := existing[dnsZoneRecordSetKey(recordSet.Name, recordSet.Type)]; exists {
+				return tf.ImportAsExistsError("azurerm_dns_zone_records", zoneId.ID())
+			}
+		}
+		if !d.Get("preserve_unmanaged_records").(bool) && len(existing) > 0 {
+			return tf.ImportAsExistsError("azurerm_dns_zone_records", zoneId.ID())
+		}
+	}
+
+	// when unmanaged records are preserved only the Record Sets which were previously defined in the zone file are removed
+	var managed map[string]bool
+	if d.Get("preserve_unmanaged_records").(bool) {
+		old, _ := d.GetChange("record_set")
+		managed = dnsZoneRecordSetKeys(old.(*pluginsdk.Set).List())
+	}
+
+	desiredKeys := make(map[string]bool)
+	for _, recordSet := range desired {
+		desiredKeys[dnsZoneRecordSetKey(recordSet.Name, recordSet.Type)] = true
+	}
+
+	// Record Sets are removed first, since a CNAME Record Set can't coexist with other Record Sets of the same name
+	for key, recordSet := range existing {
+		if desiredKeys[key] || (managed != nil && !managed[key]) {
+			continue
+		}
+
+		id := recordsets.NewRecordTypeID(zoneId.SubscriptionId, zoneId.ResourceGroupName, zoneId.DnsZoneName, recordsets.RecordType(recordSet.recordSet.Type), recordSet.recordSet.Name)
+		if resp, err := client.Delete(ctx, id, recordsets.DefaultDeleteOperationOptions()); err != nil && !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting %s: %+v", id, err)
+		}
+	}
+
+	for _, recordSet := range desired {
+		current, exists := existing[dnsZoneRecordSetKey(recordSet.Name, recordSet.Type)]
+		if exists && dnsZoneRecordSetsEqual(current.recordSet, recordSet) {
+			continue
+		}
+
+		id := recordsets.NewRecordTypeID(zoneId.SubscriptionId, zoneId.ResourceGroupName, zoneId.DnsZoneName, recordsets.RecordType(recordSet.Type), recordSet.Name)
+		parameters, err := expandDnsZoneRecordSet(recordSet)
+		if err != nil {
+			return fmt.Errorf("expanding %s: %+v", id, err)
+		}
+		if exists {
+			parameters.Properties.Metadata = current.metadata
+		}
+
+		if _, err := client.CreateOrUpdate(ctx, id, *parameters, recordsets.DefaultCreateOrUpdateOperationOptions()); err != nil {
+			return fmt.Errorf("creating/updating %s: %+v", id, err)
+		}
+	}
+
+	d.SetId(zoneId.ID())
+
+	return resourceDnsZoneRecordsRead(d, meta)
+}
+
+func resourceDnsZoneRecordsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Dns.RecordSets
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := recordsets.ParseDnsZoneID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.ListAllByDnsZoneComplete(ctx, *id, recordsets.DefaultListAllByDnsZoneOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.LatestHttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("listing Record Sets within %s: %+v", *id, err)
+	}
+
+	var managed map[string]bool
+	if d.Get("preserve_unmanaged_records").(bool) {
+		managed = dnsZoneRecordSetKeys(d.Get("record_set").(*pluginsdk.Set).List())
+	}
+
+	recordSets := make([]zonefile.RecordSet, 0)
+	for _, item := range resp.Items {
+		recordSet, ok := flattenDnsZoneRecordSet(item)
+		if !ok || (managed != nil && !managed[dnsZoneRecordSetKey(recordSet.Name, recordSet.Type)]) {
+			continue
+		}
+		recordSets = append(recordSets, recordSet)
+	}
+
+	d.Set("dns_zone_id", id.ID())
+
+	if err := d.Set("record_set", flattenDnsZoneRecordSets(recordSets)); err != nil {
+		return fmt.Errorf("setting `record_set`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceDnsZoneRecordsDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Dns.RecordSets
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	zoneId, err := recordsets.ParseDnsZoneID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	for _, item := range d.Get("record_set").(*pluginsdk.Set).List() {
+		recordSet := item.(map[string]interface{})
+
+		id := recordsets.NewRecordTypeID(zoneId.SubscriptionId, zoneId.ResourceGroupName, zoneId.DnsZoneName, recordsets.RecordType(recordSet["type"].(string)), recordSet["name"].(string))
+		if resp, err := client.Delete(ctx, id, recordsets.DefaultDeleteOperationOptions()); err != nil && !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting %s: %+v", id, err)
+		}
+	}
+
+	return nil
+}
+
+type dnsZoneExistingRecordSet struct {
+	recordSet zonefile.RecordSet
+	metadata  *map[string]string
+}
+
+// listDnsZoneRecordSets returns the Record Sets within the DNS Zone which can be defined in a zone file, keyed by their
+// name and type
+func listDnsZoneRecordSets(ctx context.Context, client *recordsets.RecordSetsClient, zoneId recordsets.DnsZoneId) (map[string]dnsZoneExistingRecordSet, error) {
+	resp, err := client.ListAllByDnsZoneComplete(ctx, zoneId, recordsets.DefaultListAllByDnsZoneOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("listing Record Sets within %s: %+v", zoneId, err)
+	}
+
+	output := make(map[string]dnsZoneExistingRecordSet)
+	for _, item := range resp.Items {
+		recordSet, ok := flattenDnsZoneRecordSet(item)
+		if !ok {
+			continue
+		}
+
+		var metadata *map[string]string
+		if item.Properties != nil {
+			metadata = item.Properties.Metadata
+		}
+
+		output[dnsZoneRecordSetKey(recordSet.Name, recordSet.Type)] = dnsZoneExistingRecordSet{
+			recordSet: recordSet,
+			metadata:  metadata,
+		}
+	}
+
+	return output, nil
+}
+
+func dnsZoneRecordSetKey(name, recordType string) string {
+	return strings.ToLower(name) + "/" + strings.ToUpper(recordType)
+}
+
+func dnsZoneRecordSetKeys(input []interface{}) map[string]bool {
+	output := make(map[string]bool)
+	for _, item := range input {
+		recordSet := item.(map[string]interface{})
+		output[dnsZoneRecordSetKey(recordSet["name"].(string), recordSet["type"].(string))] = true
+	}
+	return output
+}
+
+func dnsZoneRecordSetsEqual(first, second zonefile.RecordSet) bool {
+	if first.TTL != second.TTL || len(first.Records) != len(second.Records) {
+		return false
+	}
+
+	values := make(map[string]bool)
+	for _, record := range first.Records {
+		values[record.Value] = true
+	}
+	for _, record := range second.Records {
+		if !values[record.Value] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func flattenDnsZoneRecordSets(input []zonefile.RecordSet) []interface{} {
+	output := make([]interface{}, 0)
+	for _, recordSet := range input {
+		records := make([]interface{}, 0)
+		for _, record := range recordSet.Records {
+			records = append(records, record.Value)
+		}
+
+		output = append(output, map[string]interface{}{
+			"name":    recordSet.Name,
+			"type":    recordSet.Type,
+			"ttl":     int(recordSet.TTL),
+			"records": records,
+		})
+	}
+	return output
+}
+
+// flattenDnsZoneRecordSet converts a Record Set retrieved from Azure into the form parsed from a zone file, returning
+// false for the Record Sets which are managed by Azure or can't be defined in a zone file
+func flattenDnsZoneRecordSet(input recordsets.RecordSet) (zonefile.RecordSet, bool) {
+	props := input.Properties
+	if input.Name == nil || input.Type == nil || props == nil {
+		return zonefile.RecordSet{}, false
+	}
+
+	name := strings.ToLower(*input.Name)
+	recordType := strings.ToUpper((*input.Type)[strings.LastIndex(*input.Type, "/")+1:])
+
+	// alias Record Sets are targeting an Azure resource rather than containing records
+	if recordType == "SOA" || (recordType == "NS" && name == "@") || (props.TargetResource != nil && props.TargetResource.Id != nil) {
+		return zonefile.RecordSet{}, false
+	}
+
+	fields := make([][]string, 0)
+	switch recordType {
+	case "A":
+		for _, v := range pointer.From(props.ARecords) {
+			fields = append(fields, []string{pointer.From(v.IPv4Address)})
+		}
+	case "AAAA":
+		for _, v := range pointer.From(props.AAAARecords) {
+			fields = append(fields, []string{pointer.From(v.IPv6Address)})
+		}
+	case "CAA":
+		for _, v := range pointer.From(props.CaaRecords) {
+			fields = append(fields, []string{strconv.FormatInt(pointer.From(v.Flags), 10), pointer.From(v.Tag), pointer.From(v.Value)})
+		}
+	case "CNAME":
+		if props.CNAMERecord != nil {
+			fields = append(fields, []string{pointer.From(props.CNAMERecord.Cname)})
+		}
+	case "MX":
+		for _, v := range pointer.From(props.MXRecords) {
+			fields = append(fields, []string{strconv.FormatInt(pointer.From(v.Preference), 10), pointer.From(v.Exchange)})
+		}
+	case "NS":
+		for _, v := range pointer.From(props.NSRecords) {
+			fields = append(fields, []string{pointer.From(v.Nsdname)})
+		}
+	case "PTR":
+		for _, v := range pointer.From(props.PTRRecords) {
+			fields = append(fields, []string{pointer.From(v.Ptrdname)})
+		}
+	case "SRV":
+		for _, v := range pointer.From(props.SRVRecords) {
+			fields = append(fields, []string{strconv.FormatInt(pointer.From(v.Priority), 10), strconv.FormatInt(pointer.From(v.Weight), 10), strconv.FormatInt(pointer.From(v.Port), 10), pointer.From(v.Target)})
+		}
+	case "TXT":
+		for _, v := range pointer.From(props.TXTRecords) {
+			fields = append(fields, pointer.From(v.Value))
+		}
+	default:
+		return zonefile.RecordSet{}, false
+	}
+
+	output := zonefile.RecordSet{
+		Name: name,
+		Type: recordType,
+		TTL:  pointer.From(props.TTL),
+	}
+	for _, v := range fields {
+		output.Records = append(output.Records, zonefile.NewRecord(recordType, v))
+	}
+
+	return output, true
+}
+
+func expandDnsZoneRecordSet(input zonefile.RecordSet) (*recordsets.RecordSet, error) {
+	props := recordsets.RecordSetProperties{
+		TTL: pointer.To(input.TTL),
+	}
+
+	parseInt := func(input string) int64 {
+		v, _ := strconv.ParseInt(input, 10, 64)
+		return v
+	}
+
+	for _, record := range input.Records {
+		fields := record.Fields
+		switch input.Type {
+		case "A":
+			props.ARecords = pointer.To(append(pointer.From(props.ARecords), recordsets.ARecord{
+				IPv4Address: pointer.To(fields[0]),
+			}))
+		case "AAAA":
+			props.AAAARecords = pointer.To(append(pointer.From(props.AAAARecords), recordsets.AaaaRecord{
+				IPv6Address: pointer.To(fields[0]),
+			}))
+		case "CAA":
+			props.CaaRecords = pointer.To(append(pointer.From(props.CaaRecords), recordsets.CaaRecord{
+				Flags: pointer.To(parseInt(fields[0])),
+				Tag:   pointer.To(fields[1]),
+				Value: pointer.To(fields[2]),
+			}))
+		case "CNAME":
+			props.CNAMERecord = &recordsets.CnameRecord{
+				Cname: pointer.To(fields[0]),
+			}
+		case "MX":
+			props.MXRecords = pointer.To(append(pointer.From(props.MXRecords), recordsets.MxRecord{
+				Preference: pointer.To(parseInt(fields[0])),
+				Exchange:   pointer.To(fields[1]),
+			}))
+		case "NS":
+			props.NSRecords = pointer.To(append(pointer.From(props.NSRecords), recordsets.NsRecord{
+				Nsdname: pointer.To(fields[0]),
+			}))
+		case "PTR":
+			props.PTRRecords = pointer.To(append(pointer.From(props.PTRRecords), recordsets.PtrRecord{
+				Ptrdname: pointer.To(fields[0]),
+			}))
+		case "SRV":
+			props.SRVRecords = pointer.To(append(pointer.From(props.SRVRecords), recordsets.SrvRecord{
+				Priority: pointer.To(parseInt(fields[0])),
+				Weight:   pointer.To(parseInt(fields[1])),
+				Port:     pointer.To(parseInt(fields[2])),
+				Target:   pointer.To(fields[3]),
+			}))
+		case "TXT":
+			props.TXTRecords = pointer.To(append(pointer.From(props.TXTRecords), recordsets.TxtRecord{
+				Value: pointer.To(fields),
+			}))
+		default:
+			return nil, fmt.Errorf("the record type %q is not supported", input.Type)
+		}
+	}
+
+	return &recordsets.RecordSet{
+		Name:       pointer.To(input.Name),
+		Properties: &props,
+	}, nil
+}
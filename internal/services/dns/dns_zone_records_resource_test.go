@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DnsZoneRecordsResource struct{}
+
+func TestAccDnsZoneRecords_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_zone_records", "test")
+	r := DnsZoneRecordsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record_set.#").HasValue("8"),
+			),
+		},
+		data.ImportStep("zone_file", "preserve_unmanaged_records"),
+	})
+}
+
+func TestAccDnsZoneRecords_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_zone_records", "test")
+	r := DnsZoneRecordsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record_set.#").HasValue("8"),
+			),
+		},
+		data.ImportStep("zone_file", "preserve_unmanaged_records"),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record_set.#").HasValue("3"),
+			),
+		},
+		data.ImportStep("zone_file", "preserve_unmanaged_records"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record_set.#").HasValue("8"),
+			),
+		},
+		data.ImportStep("zone_file", "preserve_unmanaged_records"),
+	})
+}
+
+func TestAccDnsZoneRecords_preserveUnmanagedRecords(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_zone_records", "test")
+	r := DnsZoneRecordsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.preserveUnmanagedRecords(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record_set.#").HasValue("3"),
+			),
+		},
+	})
+}
+
+func (DnsZoneRecordsResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := recordsets.ParseDnsZoneID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Dns.RecordSets.ListAllByDnsZoneComplete(ctx, *id, recordsets.DefaultListAllByDnsZoneOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("listing Record Sets within %s: %+v", *id, err)
+	}
+
+	return utils.Bool(len(resp.Items) > 0), nil
+}
+
+func (DnsZoneRecordsResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_dns_zone" "test" {
+  name                = "acctestzone%[1]d.com"
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r DnsZoneRecordsResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_zone_records" "test" {
+  dns_zone_id = azurerm_dns_zone.test.id
+  zone_file   = <<ZONE
+$TTL 3600
+@         IN SOA   ns1.registrar.net. hostmaster.example.com. ( 1 7200 3600 1209600 3600 )
+@         IN NS    ns1.registrar.net.
+@         IN A     192.0.2.1
+@         IN MX    10 mail
+@         IN TXT   "v=spf1 mx -all"
+@         IN CAA   0 issue "letsencrypt.org"
+mail  300 IN A     192.0.2.2
+mail  300 IN A     192.0.2.3
+mail      IN AAAA  2001:db8::2
+www       IN CNAME @
+_sip._tcp IN SRV   10 5 5060 sip.example.net.
+ZONE
+}
+`, r.template(data))
+}
+
+func (r DnsZoneRecordsResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_zone_records" "test" {
+  dns_zone_id = azurerm_dns_zone.test.id
+  zone_file   = <<ZONE
+$TTL 1h
+@         IN A     192.0.2.10
+mail  600 IN A     192.0.2.2
+www       IN A     192.0.2.10
+ZONE
+}
+`, r.template(data))
+}
+
+func (r DnsZoneRecordsResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_zone_records" "import" {
+  dns_zone_id = azurerm_dns_zone_records.test.dns_zone_id
+  zone_file   = azurerm_dns_zone_records.test.zone_file
+}
+`, r.basic(data))
+}
+
+func (r DnsZoneRecordsResource) preserveUnmanagedRecords(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_a_record" "test" {
+  name                = "unmanaged"
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_dns_zone.test.name
+  ttl                 = 300
+  records             = ["192.0.2.100"]
+}
+
+resource "azurerm_dns_zone_records" "test" {
+  dns_zone_id                = azurerm_dns_zone.test.id
+  preserve_unmanaged_records = true
+  zone_file                  = <<ZONE
+@    IN A     192.0.2.1
+mail IN A     192.0.2.2
+www  IN CNAME @
+ZONE
+
+  depends_on = [azurerm_dns_a_record.test]
+}
+`, r.template(data))
+}
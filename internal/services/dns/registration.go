@@ -58,5 +58,6 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_dns_srv_record":   resourceDnsSrvRecord(),
 		"azurerm_dns_txt_record":   resourceDnsTxtRecord(),
 		"azurerm_dns_zone":         resourceDnsZone(),
+		"azurerm_dns_zone_records": resourceDnsZoneRecords(),
 	}
 }
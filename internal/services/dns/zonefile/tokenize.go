@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package zonefile
+
+import (
+	"fmt"
+	"strings"
+)
+
+type token struct {
+	value  string
+	quoted bool
+}
+
+// entry is a single logical line of a zone file, which can span multiple lines when using parentheses
+type entry struct {
+	line   int
+	tokens []token
+
+	// blankOwner is whether the entry starts with whitespace, in which case the owner of the previous record is used
+	blankOwner bool
+}
+
+func tokenize(input string) ([]entry, error) {
+	entries := make([]entry, 0)
+
+	line := 1
+	depth := 0
+	inQuote := false
+	hasToken := false
+	var current strings.Builder
+	var pending entry
+	startOfLine := true
+
+	endToken := func(quoted bool) {
+		if hasToken || quoted {
+			pending.tokens = append(pending.tokens, token{
+				value:  current.String(),
+				quoted: quoted,
+			})
+		}
+		current.Reset()
+		hasToken = false
+	}
+	endEntry := func() {
+		if len(pending.tokens) > 0 {
+			entries = append(entries, pending)
+		}
+		pending = entry{}
+	}
+
+	for i := 0; i < len(input); i++ {
+		c := input[i]
+
+		if startOfLine && depth == 0 && !inQuote {
+			pending = entry{
+				line:       line,
+				blankOwner: c == ' ' || c == '\t',
+			}
+		}
+		startOfLine = false
+
+		switch {
+		case c == '\\':
+			if i+1 >= len(input) {
+				return nil, fmt.Errorf("line %d: unterminated escape sequence", line)
+			}
+			if i+3 < len(input) && isDigit(input[i+1]) && isDigit(input[i+2]) && isDigit(input[i+3]) {
+				v := int(input[i+1]-'0')*100 + int(input[i+2]-'0')*10 + int(input[i+3]-'0')
+				if v > 255 {
+					return nil, fmt.Errorf("line %d: invalid escape sequence `\\%s`", line, input[i+1:i+4])
+				}
+				current.WriteByte(byte(v))
+				i += 3
+			} else {
+				current.WriteByte(input[i+1])
+				i++
+			}
+			hasToken = true
+
+		case inQuote:
+			if c == '"' {
+				inQuote = false
+				endToken(true)
+				continue
+			}
+			if c == '\n' {
+				return nil, fmt.Errorf("line %d: unterminated quoted string", line)
+			}
+			current.WriteByte(c)
+
+		case c == '"':
+			endToken(false)
+			inQuote = true
+
+		case c == ';':
+			for i+1 < len(input) && input[i+1] != '\n' {
+				i++
+			}
+
+		case c == '(':
+			endToken(false)
+			depth++
+
+		case c == ')':
+			endToken(false)
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("line %d: unbalanced parentheses", line)
+			}
+
+		case c == '\n':
+			endToken(false)
+			if depth == 0 {
+				endEntry()
+			}
+			line++
+			startOfLine = true
+
+		case c == ' ' || c == '\t' || c == '\r':
+			endToken(false)
+
+		default:
+			current.WriteByte(c)
+			hasToken = true
+		}
+	}
+
+	if inQuote {
+		return nil, fmt.Errorf("line %d: unterminated quoted string", line)
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", line)
+	}
+	endToken(false)
+	endEntry()
+
+	return entries, nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,421 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package zonefile
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DefaultTTL is the TTL used for records when neither the record nor a `$TTL` directive specify one
+const DefaultTTL = 3600
+
+// RecordSet is the set of records of the same type with the same name within a zone
+type RecordSet struct {
+	// Name is the name of the Record Set relative to the zone, where `@` is the apex of the zone
+	Name string
+
+	// Type is the type of the records within the Record Set, e.g. `A` or `MX`
+	Type string
+
+	TTL int64
+
+	Records []Record
+}
+
+// Record is a single record within a Record Set
+type Record struct {
+	// Fields are the RDATA fields of the record, where any domain names are fully qualified without a trailing dot
+	Fields []string
+
+	// Value is the RDATA of the record in presentation format
+	Value string
+}
+
+// NewRecord returns a Record of the specified type for the specified RDATA fields, normalizing any domain names
+// and IP Addresses so that records parsed from a zone file can be compared with those retrieved from Azure
+func NewRecord(recordType string, fields []string) Record {
+	normalized := make([]string, len(fields))
+	copy(normalized, fields)
+
+	for _, i := range domainNameFields[recordType] {
+		if i < len(normalized) {
+			normalized[i] = normalizeDomainName(normalized[i])
+		}
+	}
+
+	if recordType == "AAAA" && len(normalized) == 1 {
+		if ip := net.ParseIP(normalized[0]); ip != nil {
+			normalized[0] = ip.String()
+		}
+	}
+
+	values := make([]string, 0)
+	for i, field := range normalized {
+		if recordType == "TXT" || (recordType == "CAA" && i == 2) {
+			field = quote(field)
+		}
+		values = append(values, field)
+	}
+
+	return Record{
+		Fields: normalized,
+		Value:  strings.Join(values, " "),
+	}
+}
+
+// domainNameFields are the indexes of the RDATA fields containing domain names for each supported record type
+var domainNameFields = map[string][]int{
+	"CNAME": {0},
+	"MX":    {1},
+	"NS":    {0},
+	"PTR":   {0},
+	"SRV":   {3},
+}
+
+// Parse parses the RFC 1035 zone file content for the specified zone into the Record Sets it defines, sorted by name
+// and type.
+//
+// The SOA record and the NS records at the apex of the zone are ignored, since these are managed by Azure.
+func Parse(input string, zoneName string) ([]RecordSet, error) {
+	zone := strings.ToLower(strings.TrimSuffix(zoneName, ".")) + "."
+
+	entries, err := tokenize(input)
+	if err != nil {
+		return nil, err
+	}
+
+	origin := zone
+	var defaultTTL, lastTTL int64 = -1, -1
+	lastOwner := ""
+
+	recordSets := make(map[string]*RecordSet)
+	for _, entry := range entries {
+		tokens := entry.tokens
+
+		if !entry.blankOwner && !tokens[0].quoted && strings.HasPrefix(tokens[0].value, "$") {
+			directive := strings.ToUpper(tokens[0].value)
+			switch directive {
+			case "$ORIGIN":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("line %d: `$ORIGIN` must specify a single domain name", entry.line)
+				}
+				origin = absoluteName(tokens[1].value, origin)
+
+			case "$TTL":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("line %d: `$TTL` must specify a single TTL", entry.line)
+				}
+				ttl, err := parseTTL(tokens[1].value)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %+v", entry.line, err)
+				}
+				defaultTTL = ttl
+
+			default:
+				return nil, fmt.Errorf("line %d: the `%s` directive is not supported", entry.line, directive)
+			}
+			continue
+		}
+
+		i := 0
+		owner := lastOwner
+		if !entry.blankOwner {
+			owner = absoluteName(tokens[0].value, origin)
+			i++
+		}
+		if owner == "" {
+			return nil, fmt.Errorf("line %d: the first record must specify an owner name", entry.line)
+		}
+		lastOwner = owner
+
+		ttl := int64(-1)
+		for ; i < len(tokens); i++ {
+			token := tokens[i].value
+			if isClass(token) {
+				if !strings.EqualFold(token, "IN") {
+					return nil, fmt.Errorf("line %d: only records of the `IN` class are supported but got %q", entry.line, token)
+				}
+				continue
+			}
+
+			if ttl == -1 && token != "" && token[0] >= '0' && token[0] <= '9' {
+				v, err := parseTTL(token)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %+v", entry.line, err)
+				}
+				ttl = v
+				continue
+			}
+
+			break
+		}
+
+		if i >= len(tokens) {
+			return nil, fmt.Errorf("line %d: the record must specify a type", entry.line)
+		}
+		recordType := strings.ToUpper(tokens[i].value)
+
+		switch {
+		case ttl != -1:
+			lastTTL = ttl
+		case defaultTTL != -1:
+			ttl = defaultTTL
+		case lastTTL != -1:
+			ttl = lastTTL
+		default:
+			ttl = DefaultTTL
+		}
+
+		name, err := relativeName(owner, zone)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %+v", entry.line, err)
+		}
+
+		if recordType == "SOA" || (recordType == "NS" && name == "@") {
+			continue
+		}
+
+		fields, err := parseRecordData(recordType, tokens[i+1:], origin)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: parsing %s record %q: %+v", entry.line, recordType, name, err)
+		}
+		record := NewRecord(recordType, fields)
+
+		key := name + "/" + recordType
+		recordSet, ok := recordSets[key]
+		if !ok {
+			recordSet = &RecordSet{
+				Name: name,
+				Type: recordType,
+				TTL:  ttl,
+			}
+			recordSets[key] = recordSet
+		}
+
+		if recordSet.TTL != ttl {
+			return nil, fmt.Errorf("line %d: the %s records named %q must all have the same TTL", entry.line, recordType, name)
+		}
+
+		duplicate := false
+		for _, existing := range recordSet.Records {
+			if existing.Value == record.Value {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			recordSet.Records = append(recordSet.Records, record)
+		}
+
+		if recordType == "CNAME" && len(recordSet.Records) > 1 {
+			return nil, fmt.Errorf("line %d: only a single CNAME record can be named %q", entry.line, name)
+		}
+	}
+
+	output := make([]RecordSet, 0)
+	for _, recordSet := range recordSets {
+		sort.Slice(recordSet.Records, func(i, j int) bool {
+			return recordSet.Records[i].Value < recordSet.Records[j].Value
+		})
+		output = append(output, *recordSet)
+	}
+
+	sort.Slice(output, func(i, j int) bool {
+		if output[i].Name != output[j].Name {
+			return output[i].Name < output[j].Name
+		}
+		return output[i].Type < output[j].Type
+	})
+
+	return output, nil
+}
+
+func parseRecordData(recordType string, tokens []token, origin string) ([]string, error) {
+	expectedFields := map[string]int{
+		"A":     1,
+		"AAAA":  1,
+		"CAA":   3,
+		"CNAME": 1,
+		"MX":    2,
+		"NS":    1,
+		"PTR":   1,
+		"SRV":   4,
+	}
+
+	if recordType == "TXT" {
+		if len(tokens) == 0 {
+			return nil, fmt.Errorf("expected at least one string")
+		}
+	} else {
+		expected, ok := expectedFields[recordType]
+		if !ok {
+			return nil, fmt.Errorf("the record type %q is not supported", recordType)
+		}
+		if len(tokens) != expected {
+			return nil, fmt.Errorf("expected %d fields but got %d", expected, len(tokens))
+		}
+	}
+
+	fields := make([]string, 0)
+	for _, t := range tokens {
+		fields = append(fields, t.value)
+	}
+
+	switch recordType {
+	case "A":
+		if ip := net.ParseIP(fields[0]); ip == nil || ip.To4() == nil || strings.Contains(fields[0], ":") {
+			return nil, fmt.Errorf("%q is not a valid IPv4 Address", fields[0])
+		}
+
+	case "AAAA":
+		if ip := net.ParseIP(fields[0]); ip == nil || !strings.Contains(fields[0], ":") {
+			return nil, fmt.Errorf("%q is not a valid IPv6 Address", fields[0])
+		}
+
+	case "CAA":
+		if err := validateInteger(fields[0], "flags", 255); err != nil {
+			return nil, err
+		}
+		if fields[1] == "" || strings.IndexFunc(fields[1], func(r rune) bool {
+			return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+		}) != -1 {
+			return nil, fmt.Errorf("the tag %q must only contain letters and numbers", fields[1])
+		}
+
+	case "MX":
+		if err := validateInteger(fields[0], "preference", 65535); err != nil {
+			return nil, err
+		}
+
+	case "SRV":
+		for i, name := range []string{"priority", "weight", "port"} {
+			if err := validateInteger(fields[i], name, 65535); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, i := range domainNameFields[recordType] {
+		fields[i] = absoluteName(fields[i], origin)
+	}
+
+	return fields, nil
+}
+
+func validateInteger(input string, name string, maximum int) error {
+	v, err := strconv.Atoi(input)
+	if err != nil || v < 0 || v > maximum {
+		return fmt.Errorf("the %s %q must be a number between 0 and %d", name, input, maximum)
+	}
+	return nil
+}
+
+// parseTTL parses a TTL specified either in seconds or using the BIND units, e.g. `1h30m`
+func parseTTL(input string) (int64, error) {
+	if v, err := strconv.ParseInt(input, 10, 64); err == nil {
+		if v < 0 || v > 2147483647 {
+			return 0, fmt.Errorf("the TTL %q must be between 0 and 2147483647", input)
+		}
+		return v, nil
+	}
+
+	units := map[byte]int64{
+		's': 1,
+		'm': 60,
+		'h': 60 * 60,
+		'd': 24 * 60 * 60,
+		'w': 7 * 24 * 60 * 60,
+	}
+
+	var total, current int64
+	hasDigits := false
+	for i := 0; i < len(input); i++ {
+		c := input[i]
+		if c >= '0' && c <= '9' {
+			current = current*10 + int64(c-'0')
+			hasDigits = true
+			continue
+		}
+
+		multiplier, ok := units[c|0x20]
+		if !ok || !hasDigits {
+			return 0, fmt.Errorf("%q is not a valid TTL", input)
+		}
+		total += current * multiplier
+		current = 0
+		hasDigits = false
+	}
+	if hasDigits {
+		return 0, fmt.Errorf("%q is not a valid TTL", input)
+	}
+	if total > 2147483647 {
+		return 0, fmt.Errorf("the TTL %q must be between 0 and 2147483647", input)
+	}
+
+	return total, nil
+}
+
+func isClass(input string) bool {
+	switch strings.ToUpper(input) {
+	case "IN", "CH", "CS", "HS":
+		return true
+	}
+	return false
+}
+
+// absoluteName returns the fully qualified form of the domain name, with a trailing dot
+func absoluteName(name string, origin string) string {
+	name = strings.ToLower(name)
+	if name == "@" {
+		return origin
+	}
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	if origin == "." {
+		return name + "."
+	}
+	return name + "." + origin
+}
+
+// relativeName returns the name relative to the zone, where `@` is the apex of the zone
+func relativeName(name string, zone string) (string, error) {
+	if name == zone {
+		return "@", nil
+	}
+	if !strings.HasSuffix(name, "."+zone) {
+		return "", fmt.Errorf("the name %q is not within the zone %q", strings.TrimSuffix(name, "."), strings.TrimSuffix(zone, "."))
+	}
+	return strings.TrimSuffix(name, "."+zone), nil
+}
+
+func normalizeDomainName(input string) string {
+	if input == "." {
+		return input
+	}
+	return strings.TrimSuffix(strings.ToLower(input), ".")
+}
+
+func quote(input string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i := 0; i < len(input); i++ {
+		c := input[i]
+		switch {
+		case c == '"' || c == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case c < ' ' || c > '~':
+			fmt.Fprintf(&b, "\\%03d", c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
@@ -0,0 +1,311 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package zonefile
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Expected []RecordSet
+		Error    bool
+	}{
+		{
+			Name:     "Empty",
+			Input:    "",
+			Expected: []RecordSet{},
+		},
+		{
+			Name: "SOA and Apex NS Records are ignored",
+			Input: `
+$TTL 3600
+@ IN SOA ns1.registrar.net. hostmaster.example.com. (
+        2024010101 ; serial
+        7200       ; refresh
+        3600       ; retry
+        1209600    ; expire
+        3600 )     ; minimum
+@ IN NS ns1.registrar.net.
+@ IN NS ns2.registrar.net.
+`,
+			Expected: []RecordSet{},
+		},
+		{
+			Name: "Relative and Absolute Names",
+			Input: `
+$ORIGIN example.com.
+$TTL 1h
+@               IN A     192.0.2.1
+www             IN CNAME @
+mail.example.com. 300 IN A 192.0.2.2
+                IN 300 A 192.0.2.3
+`,
+			Expected: []RecordSet{
+				{
+					Name: "@",
+					Type: "A",
+					TTL:  3600,
+					Records: []Record{
+						{Fields: []string{"192.0.2.1"}, Value: "192.0.2.1"},
+					},
+				},
+				{
+					Name: "mail",
+					Type: "A",
+					TTL:  300,
+					Records: []Record{
+						{Fields: []string{"192.0.2.2"}, Value: "192.0.2.2"},
+						{Fields: []string{"192.0.2.3"}, Value: "192.0.2.3"},
+					},
+				},
+				{
+					Name: "www",
+					Type: "CNAME",
+					TTL:  3600,
+					Records: []Record{
+						{Fields: []string{"example.com"}, Value: "example.com"},
+					},
+				},
+			},
+		},
+		{
+			Name: "Nested Origin",
+			Input: `
+$ORIGIN sub.example.com.
+host 60 A 192.0.2.1
+`,
+			Expected: []RecordSet{
+				{
+					Name: "host.sub",
+					Type: "A",
+					TTL:  60,
+					Records: []Record{
+						{Fields: []string{"192.0.2.1"}, Value: "192.0.2.1"},
+					},
+				},
+			},
+		},
+		{
+			Name: "All Record Types",
+			Input: `
+@      3600 IN AAAA  2001:DB8:0:0::1
+@      3600 IN CAA   0 issue "letsencrypt.org"
+@      3600 IN MX    10 Mail
+@      3600 IN MX    20 mail.backup.net.
+@      3600 IN TXT   "v=spf1 -all" "second \"string\""
+sub    3600 IN NS    ns1.sub
+1      3600 IN PTR   host.example.com.
+_sip._tcp 3600 IN SRV 10 5 5060 sip
+`,
+			Expected: []RecordSet{
+				{
+					Name: "1",
+					Type: "PTR",
+					TTL:  3600,
+					Records: []Record{
+						{Fields: []string{"host.example.com"}, Value: "host.example.com"},
+					},
+				},
+				{
+					Name: "@",
+					Type: "AAAA",
+					TTL:  3600,
+					Records: []Record{
+						{Fields: []string{"2001:db8::1"}, Value: "2001:db8::1"},
+					},
+				},
+				{
+					Name: "@",
+					Type: "CAA",
+					TTL:  3600,
+					Records: []Record{
+						{Fields: []string{"0", "issue", "letsencrypt.org"}, Value: `0 issue "letsencrypt.org"`},
+					},
+				},
+				{
+					Name: "@",
+					Type: "MX",
+					TTL:  3600,
+					Records: []Record{
+						{Fields: []string{"10", "mail.example.com"}, Value: "10 mail.example.com"},
+						{Fields: []string{"20", "mail.backup.net"}, Value: "20 mail.backup.net"},
+					},
+				},
+				{
+					Name: "@",
+					Type: "TXT",
+					TTL:  3600,
+					Records: []Record{
+						{Fields: []string{"v=spf1 -all", `second "string"`}, Value: `"v=spf1 -all" "second \"string\""`},
+					},
+				},
+				{
+					Name: "_sip._tcp",
+					Type: "SRV",
+					TTL:  3600,
+					Records: []Record{
+						{Fields: []string{"10", "5", "5060", "sip.example.com"}, Value: "10 5 5060 sip.example.com"},
+					},
+				},
+				{
+					Name: "sub",
+					Type: "NS",
+					TTL:  3600,
+					Records: []Record{
+						{Fields: []string{"ns1.sub.example.com"}, Value: "ns1.sub.example.com"},
+					},
+				},
+			},
+		},
+		{
+			Name: "Default TTL",
+			Input: `
+www IN A 192.0.2.1
+`,
+			Expected: []RecordSet{
+				{
+					Name: "www",
+					Type: "A",
+					TTL:  DefaultTTL,
+					Records: []Record{
+						{Fields: []string{"192.0.2.1"}, Value: "192.0.2.1"},
+					},
+				},
+			},
+		},
+		{
+			Name:  "Name outside of the Zone",
+			Input: "www.example.net. 300 IN A 192.0.2.1",
+			Error: true,
+		},
+		{
+			Name:  "Unsupported Record Type",
+			Input: "www 300 IN HINFO PC Linux",
+			Error: true,
+		},
+		{
+			Name:  "Unsupported Directive",
+			Input: "$INCLUDE other.zone",
+			Error: true,
+		},
+		{
+			Name:  "Invalid IPv4 Address",
+			Input: "www 300 IN A 2001:db8::1",
+			Error: true,
+		},
+		{
+			Name:  "Differing TTLs",
+			Input: "www 300 IN A 192.0.2.1\nwww 600 IN A 192.0.2.2",
+			Error: true,
+		},
+		{
+			Name:  "Multiple CNAME Records",
+			Input: "www 300 IN CNAME a.example.net.\nwww 300 IN CNAME b.example.net.",
+			Error: true,
+		},
+		{
+			Name:  "Unbalanced Parentheses",
+			Input: "www 300 IN MX ( 10 mail",
+			Error: true,
+		},
+		{
+			Name:  "Unterminated Quoted String",
+			Input: "www 300 IN TXT \"hello",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := Parse(v.Input, "Example.com")
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestParseTTL(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected int64
+		Error    bool
+	}{
+		{Input: "300", Expected: 300},
+		{Input: "1h", Expected: 3600},
+		{Input: "1h30m", Expected: 5400},
+		{Input: "1W2D", Expected: 777600},
+		{Input: "1x", Error: true},
+		{Input: "h", Error: true},
+		{Input: "10m5", Error: true},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := parseTTL(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("expected %d but got %d", v.Expected, actual)
+		}
+	}
+}
+
+func TestNewRecord(t *testing.T) {
+	testData := []struct {
+		Type     string
+		Fields   []string
+		Expected Record
+	}{
+		{
+			Type:     "CNAME",
+			Fields:   []string{"Target.Example.com."},
+			Expected: Record{Fields: []string{"target.example.com"}, Value: "target.example.com"},
+		},
+		{
+			Type:     "AAAA",
+			Fields:   []string{"2001:0db8:0000:0000:0000:0000:0000:0001"},
+			Expected: Record{Fields: []string{"2001:db8::1"}, Value: "2001:db8::1"},
+		},
+		{
+			Type:     "TXT",
+			Fields:   []string{"back\\slash", "tab\there"},
+			Expected: Record{Fields: []string{"back\\slash", "tab\there"}, Value: `"back\\slash" "tab\009here"`},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %s %q", v.Type, v.Fields)
+
+		actual := NewRecord(v.Type, v.Fields)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
@@ -6,6 +6,7 @@ package firewall
 import (
 	"fmt"
 	"log"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -17,7 +18,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -25,7 +28,7 @@ import (
 )
 
 func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceFirewallPolicyRuleCollectionGroupCreateUpdate,
 		Read:   resourceFirewallPolicyRuleCollectionGroupRead,
 		Update: resourceFirewallPolicyRuleCollectionGroupCreateUpdate,
@@ -88,135 +91,7 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 								string(firewallpolicyrulecollectiongroups.FirewallPolicyFilterRuleCollectionActionTypeDeny),
 							}, false),
 						},
-						"rule": {
-							Type:     pluginsdk.TypeList,
-							Required: true,
-							MinItems: 1,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"name": {
-										Type:         pluginsdk.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
-									"description": {
-										Type:         pluginsdk.TypeString,
-										Optional:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
-									"protocols": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Resource{
-											Schema: map[string]*pluginsdk.Schema{
-												"type": {
-													Type:     pluginsdk.TypeString,
-													Required: true,
-													ValidateFunc: validation.StringInSlice([]string{
-														string(firewallpolicyrulecollectiongroups.FirewallPolicyRuleApplicationProtocolTypeHTTP),
-														string(firewallpolicyrulecollectiongroups.FirewallPolicyRuleApplicationProtocolTypeHTTPS),
-														"Mssql",
-													}, false),
-												},
-												"port": {
-													Type:         pluginsdk.TypeInt,
-													Required:     true,
-													ValidateFunc: validation.IntBetween(0, 64000),
-												},
-											},
-										},
-									},
-									"http_headers": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Resource{
-											Schema: map[string]*pluginsdk.Schema{
-												"name": {
-													Type:         pluginsdk.TypeString,
-													Required:     true,
-													ValidateFunc: validation.StringIsNotEmpty,
-												},
-												"value": {
-													Type:         pluginsdk.TypeString,
-													Required:     true,
-													ValidateFunc: validation.StringIsNotEmpty,
-												},
-											},
-										},
-									},
-									"source_addresses": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.Any(
-												validation.IsIPAddress,
-												validation.IsIPv4Range,
-												validation.IsCIDR,
-												validation.StringInSlice([]string{`*`}, false),
-											),
-										},
-									},
-									"source_ip_groups": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"destination_addresses": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.Any(
-												validation.IsIPAddress,
-												validation.IsIPv4Range,
-												validation.IsCIDR,
-												validation.StringInSlice([]string{`*`}, false),
-											),
-										},
-									},
-									"destination_fqdns": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"destination_urls": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"destination_fqdn_tags": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"terminate_tls": {
-										Type:     pluginsdk.TypeBool,
-										Optional: true,
-									},
-									"web_categories": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-								},
-							},
-						},
+						"rule": firewallPolicyRuleCollectionRulesSchema(firewallPolicyApplicationRuleSchema()),
 					},
 				},
 			},
@@ -245,95 +120,7 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 								string(firewallpolicyrulecollectiongroups.FirewallPolicyFilterRuleCollectionActionTypeDeny),
 							}, false),
 						},
-						"rule": {
-							Type:     pluginsdk.TypeList,
-							Required: true,
-							MinItems: 1,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"name": {
-										Type:         pluginsdk.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
-									"description": {
-										Type:         pluginsdk.TypeString,
-										Optional:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
-									"protocols": {
-										Type:     pluginsdk.TypeList,
-										Required: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.StringInSlice([]string{
-												string(firewallpolicyrulecollectiongroups.FirewallPolicyRuleNetworkProtocolAny),
-												string(firewallpolicyrulecollectiongroups.FirewallPolicyRuleNetworkProtocolTCP),
-												string(firewallpolicyrulecollectiongroups.FirewallPolicyRuleNetworkProtocolUDP),
-												string(firewallpolicyrulecollectiongroups.FirewallPolicyRuleNetworkProtocolICMP),
-											}, false),
-										},
-									},
-									"source_addresses": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.Any(
-												validation.IsIPAddress,
-												validation.IsIPv4Range,
-												validation.IsCIDR,
-												validation.StringInSlice([]string{`*`}, false),
-											),
-										},
-									},
-									"source_ip_groups": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"destination_addresses": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											// Can be IP address, CIDR, "*", or service tag
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"destination_ip_groups": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"destination_fqdns": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"destination_ports": {
-										Type:     pluginsdk.TypeList,
-										Required: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.Any(
-												validate.PortOrPortRangeWithin(1, 65535),
-												validation.StringInSlice([]string{`*`}, false),
-											),
-										},
-									},
-								},
-							},
-						},
+						"rule": firewallPolicyRuleCollectionRulesSchema(firewallPolicyNetworkRuleSchema()),
 					},
 				},
 			},
@@ -363,95 +150,333 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 								"Dnat",
 							}, false),
 						},
-						"rule": {
-							Type:     pluginsdk.TypeList,
-							Required: true,
-							MinItems: 1,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"name": {
-										Type:         pluginsdk.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
-									"description": {
-										Type:         pluginsdk.TypeString,
-										Optional:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
-									"protocols": {
-										Type:     pluginsdk.TypeList,
-										Required: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.StringInSlice([]string{
-												string(firewallpolicyrulecollectiongroups.FirewallPolicyRuleNetworkProtocolTCP),
-												string(firewallpolicyrulecollectiongroups.FirewallPolicyRuleNetworkProtocolUDP),
-											}, false),
-										},
-									},
-									"source_addresses": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.Any(
-												validation.IsIPAddress,
-												validation.IsIPv4Range,
-												validation.IsCIDR,
-												validation.StringInSlice([]string{`*`}, false),
-											),
-										},
-									},
-									"source_ip_groups": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"destination_address": {
-										Type:     pluginsdk.TypeString,
-										Optional: true,
-										ValidateFunc: validation.Any(
-											validation.IsIPAddress,
-											validation.IsCIDR,
-										),
-									},
-									"destination_ports": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										// only support 1 destination port in one DNAT rule
-										MaxItems: 1,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validate.PortOrPortRangeWithin(1, 64000),
-										},
-									},
-									"translated_address": {
-										Type:         pluginsdk.TypeString,
-										Optional:     true,
-										ValidateFunc: validation.IsIPAddress,
-									},
-									"translated_port": {
-										Type:         pluginsdk.TypeInt,
-										Required:     true,
-										ValidateFunc: validation.IsPortNumber,
-									},
-									"translated_fqdn": {
-										Type:         pluginsdk.TypeString,
-										Optional:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
-								},
-							},
-						},
+						"rule": firewallPolicyRuleCollectionRulesSchema(firewallPolicyNatRuleSchema()),
 					},
 				},
 			},
 		},
 	}
+
+	if features.FivePointOhBeta() {
+		resource.SchemaVersion = 1
+		resource.StateUpgraders = pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
+			0: migration.FirewallPolicyRuleCollectionGroupV0ToV1{},
+		})
+	}
+
+	return resource
+}
+
+// firewallPolicyRuleCollectionRulesSchema returns the schema for the Rules within a Rule Collection.
+//
+// In 5.0 the Rules are keyed by `name` and ordered within the Rule Collection by their `priority`, and can be omitted
+// so that the Rules within the Rule Collection can be managed using the `azurerm_firewall_policy_rule` resource instead.
+func firewallPolicyRuleCollectionRulesSchema(ruleSchema map[string]*pluginsdk.Schema) *pluginsdk.Schema {
+	ruleSchema["name"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+	}
+
+	if !features.FivePointOhBeta() {
+		return &pluginsdk.Schema{
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: ruleSchema,
+			},
+		}
+	}
+
+	ruleSchema["priority"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+	}
+
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeSet,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: ruleSchema,
+		},
+	}
+}
+
+func firewallPolicyApplicationRuleSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"protocols": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"type": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(firewallpolicyrulecollectiongroups.FirewallPolicyRuleApplicationProtocolTypeHTTP),
+							string(firewallpolicyrulecollectiongroups.FirewallPolicyRuleApplicationProtocolTypeHTTPS),
+							"Mssql",
+						}, false),
+					},
+					"port": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(0, 64000),
+					},
+				},
+			},
+		},
+		"http_headers": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					"value": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+		"source_addresses": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+				ValidateFunc: validation.Any(
+					validation.IsIPAddress,
+					validation.IsIPv4Range,
+					validation.IsCIDR,
+					validation.StringInSlice([]string{`*`}, false),
+				),
+			},
+		},
+		"source_ip_groups": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+		"destination_addresses": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+				ValidateFunc: validation.Any(
+					validation.IsIPAddress,
+					validation.IsIPv4Range,
+					validation.IsCIDR,
+					validation.StringInSlice([]string{`*`}, false),
+				),
+			},
+		},
+		"destination_fqdns": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+		"destination_urls": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+		"destination_fqdn_tags": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+		"terminate_tls": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+		"web_categories": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func firewallPolicyNetworkRuleSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"protocols": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(firewallpolicyrulecollectiongroups.FirewallPolicyRuleNetworkProtocolAny),
+					string(firewallpolicyrulecollectiongroups.FirewallPolicyRuleNetworkProtocolTCP),
+					string(firewallpolicyrulecollectiongroups.FirewallPolicyRuleNetworkProtocolUDP),
+					string(firewallpolicyrulecollectiongroups.FirewallPolicyRuleNetworkProtocolICMP),
+				}, false),
+			},
+		},
+		"source_addresses": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+				ValidateFunc: validation.Any(
+					validation.IsIPAddress,
+					validation.IsIPv4Range,
+					validation.IsCIDR,
+					validation.StringInSlice([]string{`*`}, false),
+				),
+			},
+		},
+		"source_ip_groups": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+		"destination_addresses": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+				// Can be IP address, CIDR, "*", or service tag
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+		"destination_ip_groups": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+		"destination_fqdns": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+		"destination_ports": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+				ValidateFunc: validation.Any(
+					validate.PortOrPortRangeWithin(1, 65535),
+					validation.StringInSlice([]string{`*`}, false),
+				),
+			},
+		},
+	}
+}
+
+func firewallPolicyNatRuleSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"protocols": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(firewallpolicyrulecollectiongroups.FirewallPolicyRuleNetworkProtocolTCP),
+					string(firewallpolicyrulecollectiongroups.FirewallPolicyRuleNetworkProtocolUDP),
+				}, false),
+			},
+		},
+		"source_addresses": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+				ValidateFunc: validation.Any(
+					validation.IsIPAddress,
+					validation.IsIPv4Range,
+					validation.IsCIDR,
+					validation.StringInSlice([]string{`*`}, false),
+				),
+			},
+		},
+		"source_ip_groups": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+		"destination_address": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ValidateFunc: validation.Any(
+				validation.IsIPAddress,
+				validation.IsCIDR,
+			),
+		},
+		"destination_ports": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			// only support 1 destination port in one DNAT rule
+			MaxItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validate.PortOrPortRangeWithin(1, 64000),
+			},
+		},
+		"translated_address": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsIPAddress,
+		},
+		"translated_port": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IsPortNumber,
+		},
+		"translated_fqdn": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
 }
 
 func resourceFirewallPolicyRuleCollectionGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -487,16 +512,64 @@ func resourceFirewallPolicyRuleCollectionGroupCreateUpdate(d *pluginsdk.Resource
 			Priority: utils.Int64(int64(d.Get("priority").(int))),
 		},
 	}
+	// in 5.0 the existing Rules are used to retain the order of the Rules which don't specify a `priority`, and the
+	// Rules within the Rule Collections which are managed using the `azurerm_firewall_policy_rule` resource
+	existingRules := make(map[string]*[]firewallpolicyrulecollectiongroups.FirewallPolicyRule)
+	if features.FivePointOhBeta() && !d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+		if existing.Model != nil && existing.Model.Properties != nil {
+			for _, collection := range pointer.From(existing.Model.Properties.RuleCollections) {
+				name, rules := firewallPolicyRuleCollectionRules(collection)
+				existingRules[name] = rules
+			}
+		}
+	}
+
 	var rulesCollections []firewallpolicyrulecollectiongroups.FirewallPolicyRuleCollection
-	rulesCollections = append(rulesCollections, expandFirewallPolicyRuleCollectionApplication(d.Get("application_rule_collection").([]interface{}))...)
-	rulesCollections = append(rulesCollections, expandFirewallPolicyRuleCollectionNetwork(d.Get("network_rule_collection").([]interface{}))...)
+	applicationRules, err := expandFirewallPolicyRuleCollectionApplication(d.Get("application_rule_collection").([]interface{}), existingRules)
+	if err != nil {
+		return fmt.Errorf("expanding application rule collection: %w", err)
+	}
+	rulesCollections = append(rulesCollections, applicationRules...)
 
-	natRules, err := expandFirewallPolicyRuleCollectionNat(d.Get("nat_rule_collection").([]interface{}))
+	networkRules, err := expandFirewallPolicyRuleCollectionNetwork(d.Get("network_rule_collection").([]interface{}), existingRules)
+	if err != nil {
+		return fmt.Errorf("expanding network rule collection: %w", err)
+	}
+	rulesCollections = append(rulesCollections, networkRules...)
+
+	natRules, err := expandFirewallPolicyRuleCollectionNat(d.Get("nat_rule_collection").([]interface{}), existingRules)
 	if err != nil {
 		return fmt.Errorf("expanding NAT rule collection: %w", err)
 	}
 	rulesCollections = append(rulesCollections, natRules...)
 
+	// the Rules within Rule Collections which don't define any `rule` blocks are managed using the
+	// `azurerm_firewall_policy_rule` resource, so these are retained as-is
+	if features.FivePointOhBeta() {
+		unmanaged := firewallPolicyRuleCollectionNamesWithoutRules(d)
+		for _, collection := range rulesCollections {
+			name, _ := firewallPolicyRuleCollectionRules(collection)
+			if !unmanaged[name] {
+				continue
+			}
+
+			rules := existingRules[name]
+			if rules == nil {
+				rules = &[]firewallpolicyrulecollectiongroups.FirewallPolicyRule{}
+			}
+			switch v := collection.(type) {
+			case *firewallpolicyrulecollectiongroups.FirewallPolicyFilterRuleCollection:
+				v.Rules = rules
+			case *firewallpolicyrulecollectiongroups.FirewallPolicyNatRuleCollection:
+				v.Rules = rules
+			}
+		}
+	}
+
 	param.Properties.RuleCollections = &rulesCollections
 
 	if err = client.CreateOrUpdateThenPoll(ctx, id, param); err != nil {
@@ -536,11 +609,56 @@ func resourceFirewallPolicyRuleCollectionGroupRead(d *pluginsdk.ResourceData, me
 		if props := model.Properties; props != nil {
 			d.Set("priority", props.Priority)
 
-			applicationRuleCollections, networkRuleCollections, natRuleCollections, err := flattenFirewallPolicyRuleCollection(props.RuleCollections)
+			// the priority of each Rule isn't returned by the API, so this is retained from the existing state
+			rulePriorities := make(map[string]map[string]int)
+			networkRuleCollectionNames := make(map[string]bool)
+			for _, key := range []string{"application_rule_collection", "network_rule_collection", "nat_rule_collection"} {
+				for _, item := range d.Get(key).([]interface{}) {
+					collection, ok := item.(map[string]interface{})
+					if !ok {
+						continue
+					}
+					collectionName := collection["name"].(string)
+					if key == "network_rule_collection" {
+						networkRuleCollectionNames[collectionName] = true
+					}
+
+					if rules, ok := collection["rule"].(*pluginsdk.Set); ok {
+						rulePriorities[collectionName] = make(map[string]int)
+						for _, r := range rules.List() {
+							rule := r.(map[string]interface{})
+							rulePriorities[collectionName][rule["name"].(string)] = rule["priority"].(int)
+						}
+					}
+				}
+			}
+
+			applicationRuleCollections, networkRuleCollections, natRuleCollections, err := flattenFirewallPolicyRuleCollection(props.RuleCollections, networkRuleCollectionNames)
 			if err != nil {
 				return fmt.Errorf("flattening Firewall Policy Rule Collections: %+v", err)
 			}
 
+			if features.FivePointOhBeta() {
+				for _, collections := range [][]interface{}{applicationRuleCollections, networkRuleCollections, natRuleCollections} {
+					for _, item := range collections {
+						collection := item.(map[string]interface{})
+						priorities, exists := rulePriorities[collection["name"].(string)]
+
+						// the Rules within a Rule Collection which doesn't define any `rule` blocks are managed using
+						// the `azurerm_firewall_policy_rule` resource, so aren't set here (other than when importing)
+						if exists && len(priorities) == 0 {
+							collection["rule"] = make([]interface{}, 0)
+							continue
+						}
+
+						for _, r := range collection["rule"].([]interface{}) {
+							rule := r.(map[string]interface{})
+							rule["priority"] = priorities[rule["name"].(string)]
+						}
+					}
+				}
+			}
+
 			if err := d.Set("application_rule_collection", applicationRuleCollections); err != nil {
 				return fmt.Errorf("setting `application_rule_collection`: %+v", err)
 			}
@@ -575,19 +693,23 @@ func resourceFirewallPolicyRuleCollectionGroupDelete(d *pluginsdk.ResourceData,
 	return nil
 }
 
-func expandFirewallPolicyRuleCollectionApplication(input []interface{}) []firewallpolicyrulecollectiongroups.FirewallPolicyRuleCollection {
-	return expandFirewallPolicyFilterRuleCollection(input, expandFirewallPolicyRuleApplication)
+func expandFirewallPolicyRuleCollectionApplication(input []interface{}, existingRules map[string]*[]firewallpolicyrulecollectiongroups.FirewallPolicyRule) ([]firewallpolicyrulecollectiongroups.FirewallPolicyRuleCollection, error) {
+	return expandFirewallPolicyFilterRuleCollection(input, existingRules, expandFirewallPolicyRuleApplication)
 }
 
-func expandFirewallPolicyRuleCollectionNetwork(input []interface{}) []firewallpolicyrulecollectiongroups.FirewallPolicyRuleCollection {
-	return expandFirewallPolicyFilterRuleCollection(input, expandFirewallPolicyRuleNetwork)
+func expandFirewallPolicyRuleCollectionNetwork(input []interface{}, existingRules map[string]*[]firewallpolicyrulecollectiongroups.FirewallPolicyRule) ([]firewallpolicyrulecollectiongroups.FirewallPolicyRuleCollection, error) {
+	return expandFirewallPolicyFilterRuleCollection(input, existingRules, expandFirewallPolicyRuleNetwork)
 }
 
-func expandFirewallPolicyRuleCollectionNat(input []interface{}) ([]firewallpolicyrulecollectiongroups.FirewallPolicyRuleCollection, error) {
+func expandFirewallPolicyRuleCollectionNat(input []interface{}, existingRules map[string]*[]firewallpolicyrulecollectiongroups.FirewallPolicyRule) ([]firewallpolicyrulecollectiongroups.FirewallPolicyRuleCollection, error) {
 	result := make([]firewallpolicyrulecollectiongroups.FirewallPolicyRuleCollection, 0)
 	for _, e := range input {
 		rule := e.(map[string]interface{})
-		rules, err := expandFirewallPolicyRuleNat(rule["rule"].([]interface{}))
+		orderedRules, err := orderFirewallPolicyRules(rule["rule"], existingRules[rule["name"].(string)])
+		if err != nil {
+			return nil, fmt.Errorf("rule collection %q: %+v", rule["name"].(string), err)
+		}
+		rules, err := expandFirewallPolicyRuleNat(orderedRules)
 		if err != nil {
 			return nil, err
 		}
@@ -604,21 +726,130 @@ func expandFirewallPolicyRuleCollectionNat(input []interface{}) ([]firewallpolic
 	return result, nil
 }
 
-func expandFirewallPolicyFilterRuleCollection(input []interface{}, f func(input []interface{}) *[]firewallpolicyrulecollectiongroups.FirewallPolicyRule) []firewallpolicyrulecollectiongroups.FirewallPolicyRuleCollection {
+func expandFirewallPolicyFilterRuleCollection(input []interface{}, existingRules map[string]*[]firewallpolicyrulecollectiongroups.FirewallPolicyRule, f func(input []interface{}) *[]firewallpolicyrulecollectiongroups.FirewallPolicyRule) ([]firewallpolicyrulecollectiongroups.FirewallPolicyRuleCollection, error) {
 	result := make([]firewallpolicyrulecollectiongroups.FirewallPolicyRuleCollection, 0)
 	for _, e := range input {
 		rule := e.(map[string]interface{})
+		orderedRules, err := orderFirewallPolicyRules(rule["rule"], existingRules[rule["name"].(string)])
+		if err != nil {
+			return nil, fmt.Errorf("rule collection %q: %+v", rule["name"].(string), err)
+		}
 		output := &firewallpolicyrulecollectiongroups.FirewallPolicyFilterRuleCollection{
 			Action: &firewallpolicyrulecollectiongroups.FirewallPolicyFilterRuleCollectionAction{
 				Type: pointer.To(firewallpolicyrulecollectiongroups.FirewallPolicyFilterRuleCollectionActionType(rule["action"].(string))),
 			},
 			Name:     utils.String(rule["name"].(string)),
 			Priority: utils.Int64(int64(rule["priority"].(int))),
-			Rules:    f(rule["rule"].([]interface{})),
+			Rules:    f(orderedRules),
 		}
 		result = append(result, output)
 	}
-	return result
+	return result, nil
+}
+
+// orderFirewallPolicyRules returns the Rules within a Rule Collection in the order they should be sent to the API.
+//
+// Prior to 5.0 the Rules are a list and so are sent in the order they're defined in the configuration. In 5.0 the
+// Rules are a set keyed by `name` and ordered by their `priority` - since the configuration order of a set isn't
+// available, the Rules which don't specify a `priority` are ordered after those which do, retaining their existing
+// order within the Rule Collection, with any new Rules then ordered by their `name`.
+func orderFirewallPolicyRules(input interface{}, existing *[]firewallpolicyrulecollectiongroups.FirewallPolicyRule) ([]interface{}, error) {
+	rules, ok := input.(*pluginsdk.Set)
+	if !ok {
+		return input.([]interface{}), nil
+	}
+
+	existingPositions := make(map[string]int)
+	for i, rule := range pointer.From(existing) {
+		existingPositions[firewallPolicyRuleName(rule)] = i
+	}
+
+	names := make(map[string]struct{})
+	output := make([]interface{}, 0)
+	for _, item := range rules.List() {
+		rule := item.(map[string]interface{})
+		name := rule["name"].(string)
+		if _, exists := names[name]; exists {
+			return nil, fmt.Errorf("the rule name %q must be unique within the rule collection", name)
+		}
+		names[name] = struct{}{}
+		output = append(output, rule)
+	}
+
+	sort.SliceStable(output, func(i, j int) bool {
+		first := output[i].(map[string]interface{})
+		second := output[j].(map[string]interface{})
+		firstName, secondName := first["name"].(string), second["name"].(string)
+		firstPriority, secondPriority := first["priority"].(int), second["priority"].(int)
+		if firstPriority != secondPriority {
+			if firstPriority == 0 || secondPriority == 0 {
+				return secondPriority == 0
+			}
+			return firstPriority < secondPriority
+		}
+
+		if firstPriority == 0 {
+			firstPosition, firstExists := existingPositions[firstName]
+			secondPosition, secondExists := existingPositions[secondName]
+			if firstExists != secondExists {
+				return firstExists
+			}
+			if firstExists {
+				return firstPosition < secondPosition
+			}
+		}
+
+		return firstName < secondName
+	})
+
+	return output, nil
+}
+
+// firewallPolicyRuleCollectionNamesWithoutRules returns the names of the Rule Collections which don't define any `rule`
+// blocks in the configuration
+func firewallPolicyRuleCollectionNamesWithoutRules(d *pluginsdk.ResourceData) map[string]bool {
+	output := make(map[string]bool)
+
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return output
+	}
+
+	for _, key := range []string{"application_rule_collection", "network_rule_collection", "nat_rule_collection"} {
+		collections := config.GetAttr(key)
+		if collections.IsNull() || !collections.IsKnown() {
+			continue
+		}
+
+		for _, collection := range collections.AsValueSlice() {
+			name := collection.GetAttr("name")
+			if name.IsNull() || !name.IsKnown() {
+				continue
+			}
+
+			rules := collection.GetAttr("rule")
+			if rules.IsNull() || (rules.IsKnown() && rules.LengthInt() == 0) {
+				output[name.AsString()] = true
+			}
+		}
+	}
+
+	return output
+}
+
+// firewallPolicyRuleCollectionRules returns the name and Rules of a Rule Collection
+func firewallPolicyRuleCollectionRules(input firewallpolicyrulecollectiongroups.FirewallPolicyRuleCollection) (string, *[]firewallpolicyrulecollectiongroups.FirewallPolicyRule) {
+	switch v := input.(type) {
+	case firewallpolicyrulecollectiongroups.FirewallPolicyFilterRuleCollection:
+		return pointer.From(v.Name), v.Rules
+	case *firewallpolicyrulecollectiongroups.FirewallPolicyFilterRuleCollection:
+		return pointer.From(v.Name), v.Rules
+	case firewallpolicyrulecollectiongroups.FirewallPolicyNatRuleCollection:
+		return pointer.From(v.Name), v.Rules
+	case *firewallpolicyrulecollectiongroups.FirewallPolicyNatRuleCollection:
+		return pointer.From(v.Name), v.Rules
+	}
+	return "", nil
 }
 
 func expandFirewallPolicyRuleApplication(input []interface{}) *[]firewallpolicyrulecollectiongroups.FirewallPolicyRule {
@@ -724,7 +955,7 @@ func expandFirewallPolicyRuleNat(input []interface{}) (*[]firewallpolicyrulecoll
 	return &result, nil
 }
 
-func flattenFirewallPolicyRuleCollection(input *[]firewallpolicyrulecollectiongroups.FirewallPolicyRuleCollection) ([]interface{}, []interface{}, []interface{}, error) {
+func flattenFirewallPolicyRuleCollection(input *[]firewallpolicyrulecollectiongroups.FirewallPolicyRuleCollection, networkRuleCollectionNames map[string]bool) ([]interface{}, []interface{}, []interface{}, error) {
 	var (
 		applicationRuleCollection = []interface{}{}
 		networkRuleCollection     = []interface{}{}
@@ -759,7 +990,14 @@ func flattenFirewallPolicyRuleCollection(input *[]firewallpolicyrulecollectiongr
 				"action":   action,
 			}
 
+			// the type of an empty Rule Collection can't be determined from the API, so this is retained from the existing state
 			if rule.Rules == nil || len(*rule.Rules) == 0 {
+				result["rule"] = []interface{}{}
+				if networkRuleCollectionNames[name] {
+					networkRuleCollection = append(networkRuleCollection, result)
+				} else {
+					applicationRuleCollection = append(applicationRuleCollection, result)
+				}
 				continue
 			}
 
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -136,6 +137,33 @@ func TestAccFirewallPolicyRuleCollectionGroup_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccFirewallPolicyRuleCollectionGroup_rulePriority(t *testing.T) {
+	if !features.FivePointOhBeta() {
+		t.Skip("skipping as the `priority` of each `rule` is only available in 5.0")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_rule_collection_group", "test")
+	r := FirewallPolicyRuleCollectionGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.rulePriority(data, 100, 200),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		// the priority of each rule isn't returned by the API
+		data.ImportStep("network_rule_collection.0.rule"),
+		{
+			Config: r.rulePriority(data, 200, 100),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("network_rule_collection.0.rule"),
+	})
+}
+
 func (FirewallPolicyRuleCollectionGroupResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := firewallpolicyrulecollectiongroups.ParseRuleCollectionGroupID(state.ID)
 	if err != nil {
@@ -893,6 +921,49 @@ resource "azurerm_firewall_policy_rule_collection_group" "test" {
 `, data.RandomInteger, data.Locations.Primary)
 }
 
+func (FirewallPolicyRuleCollectionGroupResource) rulePriority(data acceptance.TestData, firstPriority, secondPriority int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-fwpolicy-RCG-%[1]d"
+  location = "%[2]s"
+}
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctest-fwpolicy-RCG-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+resource "azurerm_firewall_policy_rule_collection_group" "test" {
+  name               = "acctest-fwpolicy-RCG-%[1]d"
+  firewall_policy_id = azurerm_firewall_policy.test.id
+  priority           = 500
+  network_rule_collection {
+    name     = "network_rule_collection1"
+    priority = 400
+    action   = "Deny"
+    rule {
+      name                  = "network_rule_collection1_rule1"
+      priority              = %[3]d
+      protocols             = ["TCP"]
+      source_addresses      = ["10.0.0.1"]
+      destination_addresses = ["192.168.1.1"]
+      destination_ports     = ["80"]
+    }
+    rule {
+      name                  = "network_rule_collection1_rule2"
+      priority              = %[4]d
+      protocols             = ["UDP"]
+      source_addresses      = ["10.0.0.2"]
+      destination_addresses = ["192.168.1.2"]
+      destination_ports     = ["53"]
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, firstPriority, secondPriority)
+}
+
 func (FirewallPolicyRuleCollectionGroupResource) requiresImport(data acceptance.TestData) string {
 	template := FirewallPolicyRuleCollectionGroupResource{}.basic(data)
 	return fmt.Sprintf(`
@@ -0,0 +1,397 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package firewall
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/firewallpolicyrulecollectiongroups"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourceFirewallPolicyRule() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceFirewallPolicyRuleCreateUpdate,
+		Read:   resourceFirewallPolicyRuleRead,
+		Update: resourceFirewallPolicyRuleCreateUpdate,
+		Delete: resourceFirewallPolicyRuleDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FirewallPolicyRuleID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FirewallPolicyRuleName(),
+			},
+
+			"rule_collection_group_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: firewallpolicyrulecollectiongroups.ValidateRuleCollectionGroupID,
+			},
+
+			"rule_collection_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"application_rule": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"application_rule", "network_rule", "nat_rule"},
+				Elem: &pluginsdk.Resource{
+					Schema: firewallPolicyApplicationRuleSchema(),
+				},
+			},
+
+			"network_rule": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"application_rule", "network_rule", "nat_rule"},
+				Elem: &pluginsdk.Resource{
+					Schema: firewallPolicyNetworkRuleSchema(),
+				},
+			},
+
+			"nat_rule": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"application_rule", "network_rule", "nat_rule"},
+				Elem: &pluginsdk.Resource{
+					Schema: firewallPolicyNatRuleSchema(),
+				},
+			},
+		},
+	}
+}
+
+func resourceFirewallPolicyRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.FirewallPolicyRuleCollectionGroups
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	groupId, err := firewallpolicyrulecollectiongroups.ParseRuleCollectionGroupID(d.Get("rule_collection_group_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewFirewallPolicyRuleID(groupId.SubscriptionId, groupId.ResourceGroupName, groupId.FirewallPolicyName, groupId.RuleCollectionGroupName, d.Get("rule_collection_name").(string), d.Get("name").(string))
+
+	locks.ByName(id.FirewallPolicyName, AzureFirewallPolicyResourceName)
+	defer locks.UnlockByName(id.FirewallPolicyName, AzureFirewallPolicyResourceName)
+
+	group, err := client.Get(ctx, *groupId)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", groupId, err)
+	}
+	if group.Model == nil || group.Model.Properties == nil || group.Model.Properties.RuleCollections == nil {
+		return fmt.Errorf("retrieving %s: `properties.ruleCollections` was nil", groupId)
+	}
+	collections := *group.Model.Properties.RuleCollections
+
+	collectionIndex := findFirewallPolicyRuleCollection(collections, id.RuleCollectionName)
+	if collectionIndex == -1 {
+		return fmt.Errorf("the Rule Collection %q was not found within %s", id.RuleCollectionName, groupId)
+	}
+	collection := collections[collectionIndex]
+	_, existingRules := firewallPolicyRuleCollectionRules(collection)
+
+	rule, err := expandFirewallPolicyRule(d, id.RuleName)
+	if err != nil {
+		return fmt.Errorf("expanding %s: %+v", id, err)
+	}
+
+	_, isNatRuleCollection := collection.(firewallpolicyrulecollectiongroups.FirewallPolicyNatRuleCollection)
+	if isNatRuleCollection != (firewallPolicyRuleType(rule) == "NatRule") {
+		return fmt.Errorf("a `nat_rule` must be specified for the NAT Rule Collection %q, and an `application_rule` or `network_rule` for any other Rule Collection", id.RuleCollectionName)
+	}
+
+	rules := make([]firewallpolicyrulecollectiongroups.FirewallPolicyRule, 0)
+	exists := false
+	for _, existing := range pointer.From(existingRules) {
+		if firewallPolicyRuleName(existing) != id.RuleName {
+			if firewallPolicyRuleType(existing) != firewallPolicyRuleType(rule) {
+				return fmt.Errorf("the Rule Collection %q within %s contains rules of a different type to %s", id.RuleCollectionName, groupId, id)
+			}
+			rules = append(rules, existing)
+			continue
+		}
+
+		if d.IsNewResource() {
+			return tf.ImportAsExistsError("azurerm_firewall_policy_rule", id.ID())
+		}
+
+		exists = true
+		rules = append(rules, rule)
+	}
+
+	if !exists {
+		if !d.IsNewResource() {
+			return fmt.Errorf("locating %s", id)
+		}
+		rules = append(rules, rule)
+	}
+
+	collections[collectionIndex] = setFirewallPolicyRuleCollectionRules(collection, rules)
+	group.Model.Properties.RuleCollections = &collections
+
+	if err := client.CreateOrUpdateThenPoll(ctx, *groupId, *group.Model); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceFirewallPolicyRuleRead(d, meta)
+}
+
+func resourceFirewallPolicyRuleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.FirewallPolicyRuleCollectionGroups
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FirewallPolicyRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	groupId := firewallpolicyrulecollectiongroups.NewRuleCollectionGroupID(id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName)
+
+	group, err := client.Get(ctx, groupId)
+	if err != nil {
+		if response.WasNotFound(group.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", groupId)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", groupId, err)
+	}
+
+	var rule firewallpolicyrulecollectiongroups.FirewallPolicyRule
+	if model := group.Model; model != nil && model.Properties != nil {
+		collections := pointer.From(model.Properties.RuleCollections)
+		if index := findFirewallPolicyRuleCollection(collections, id.RuleCollectionName); index != -1 {
+			_, rules := firewallPolicyRuleCollectionRules(collections[index])
+			for _, r := range pointer.From(rules) {
+				if firewallPolicyRuleName(r) == id.RuleName {
+					rule = r
+					break
+				}
+			}
+		}
+	}
+
+	if rule == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state!", id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", id.RuleName)
+	d.Set("rule_collection_group_id", groupId.ID())
+	d.Set("rule_collection_name", id.RuleCollectionName)
+
+	applicationRule := make([]interface{}, 0)
+	networkRule := make([]interface{}, 0)
+	natRule := make([]interface{}, 0)
+	rules := &[]firewallpolicyrulecollectiongroups.FirewallPolicyRule{rule}
+	switch rule.(type) {
+	case firewallpolicyrulecollectiongroups.ApplicationRule:
+		applicationRule, err = flattenFirewallPolicyRuleApplication(rules)
+	case firewallpolicyrulecollectiongroups.NetworkRule:
+		networkRule, err = flattenFirewallPolicyRuleNetwork(rules)
+	case firewallpolicyrulecollectiongroups.NatRule:
+		natRule, err = flattenFirewallPolicyRuleNat(rules)
+	default:
+		return fmt.Errorf("unknown rule type %+v", rule)
+	}
+	if err != nil {
+		return fmt.Errorf("flattening %s: %+v", id, err)
+	}
+
+	// the name of the Rule is exposed at the top-level
+	for _, flattened := range [][]interface{}{applicationRule, networkRule, natRule} {
+		for _, v := range flattened {
+			delete(v.(map[string]interface{}), "name")
+		}
+	}
+
+	if err := d.Set("application_rule", applicationRule); err != nil {
+		return fmt.Errorf("setting `application_rule`: %+v", err)
+	}
+	if err := d.Set("network_rule", networkRule); err != nil {
+		return fmt.Errorf("setting `network_rule`: %+v", err)
+	}
+	if err := d.Set("nat_rule", natRule); err != nil {
+		return fmt.Errorf("setting `nat_rule`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceFirewallPolicyRuleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.FirewallPolicyRuleCollectionGroups
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FirewallPolicyRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	groupId := firewallpolicyrulecollectiongroups.NewRuleCollectionGroupID(id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName)
+
+	locks.ByName(id.FirewallPolicyName, AzureFirewallPolicyResourceName)
+	defer locks.UnlockByName(id.FirewallPolicyName, AzureFirewallPolicyResourceName)
+
+	group, err := client.Get(ctx, groupId)
+	if err != nil {
+		if response.WasNotFound(group.HttpResponse) {
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", groupId, err)
+	}
+	if group.Model == nil || group.Model.Properties == nil || group.Model.Properties.RuleCollections == nil {
+		return fmt.Errorf("retrieving %s: `properties.ruleCollections` was nil", groupId)
+	}
+	collections := *group.Model.Properties.RuleCollections
+
+	collectionIndex := findFirewallPolicyRuleCollection(collections, id.RuleCollectionName)
+	if collectionIndex == -1 {
+		return nil
+	}
+
+	_, existingRules := firewallPolicyRuleCollectionRules(collections[collectionIndex])
+	rules := make([]firewallpolicyrulecollectiongroups.FirewallPolicyRule, 0)
+	for _, existing := range pointer.From(existingRules) {
+		if firewallPolicyRuleName(existing) != id.RuleName {
+			rules = append(rules, existing)
+		}
+	}
+
+	if len(rules) == len(pointer.From(existingRules)) {
+		return nil
+	}
+
+	collections[collectionIndex] = setFirewallPolicyRuleCollectionRules(collections[collectionIndex], rules)
+	group.Model.Properties.RuleCollections = &collections
+
+	if err := client.CreateOrUpdateThenPoll(ctx, groupId, *group.Model); err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func expandFirewallPolicyRule(d *pluginsdk.ResourceData, name string) (firewallpolicyrulecollectiongroups.FirewallPolicyRule, error) {
+	withName := func(input []interface{}) []interface{} {
+		rule := map[string]interface{}{
+			"name": name,
+		}
+		if len(input) > 0 && input[0] != nil {
+			for k, v := range input[0].(map[string]interface{}) {
+				rule[k] = v
+			}
+		}
+		return []interface{}{rule}
+	}
+
+	var rules *[]firewallpolicyrulecollectiongroups.FirewallPolicyRule
+	if v := d.Get("application_rule").([]interface{}); len(v) > 0 {
+		rules = expandFirewallPolicyRuleApplication(withName(v))
+	} else if v := d.Get("network_rule").([]interface{}); len(v) > 0 {
+		rules = expandFirewallPolicyRuleNetwork(withName(v))
+	} else {
+		var err error
+		rules, err = expandFirewallPolicyRuleNat(withName(d.Get("nat_rule").([]interface{})))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return (*rules)[0], nil
+}
+
+func findFirewallPolicyRuleCollection(input []firewallpolicyrulecollectiongroups.FirewallPolicyRuleCollection, name string) int {
+	for i, collection := range input {
+		if collectionName, _ := firewallPolicyRuleCollectionRules(collection); collectionName == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// setFirewallPolicyRuleCollectionRules returns a copy of the Rule Collection containing the specified Rules
+func setFirewallPolicyRuleCollectionRules(input firewallpolicyrulecollectiongroups.FirewallPolicyRuleCollection, rules []firewallpolicyrulecollectiongroups.FirewallPolicyRule) firewallpolicyrulecollectiongroups.FirewallPolicyRuleCollection {
+	switch v := input.(type) {
+	case firewallpolicyrulecollectiongroups.FirewallPolicyFilterRuleCollection:
+		v.Rules = &rules
+		return v
+	case firewallpolicyrulecollectiongroups.FirewallPolicyNatRuleCollection:
+		v.Rules = &rules
+		return v
+	}
+	return input
+}
+
+func firewallPolicyRuleName(input firewallpolicyrulecollectiongroups.FirewallPolicyRule) string {
+	switch v := input.(type) {
+	case firewallpolicyrulecollectiongroups.ApplicationRule:
+		return pointer.From(v.Name)
+	case *firewallpolicyrulecollectiongroups.ApplicationRule:
+		return pointer.From(v.Name)
+	case firewallpolicyrulecollectiongroups.NetworkRule:
+		return pointer.From(v.Name)
+	case *firewallpolicyrulecollectiongroups.NetworkRule:
+		return pointer.From(v.Name)
+	case firewallpolicyrulecollectiongroups.NatRule:
+		return pointer.From(v.Name)
+	case *firewallpolicyrulecollectiongroups.NatRule:
+		return pointer.From(v.Name)
+	}
+	return ""
+}
+
+// firewallPolicyRuleType returns the type of the Rule, since all of the Rules within a Rule Collection must be of the same type
+func firewallPolicyRuleType(input firewallpolicyrulecollectiongroups.FirewallPolicyRule) string {
+	switch input.(type) {
+	case firewallpolicyrulecollectiongroups.ApplicationRule, *firewallpolicyrulecollectiongroups.ApplicationRule:
+		return "ApplicationRule"
+	case firewallpolicyrulecollectiongroups.NetworkRule, *firewallpolicyrulecollectiongroups.NetworkRule:
+		return "NetworkRule"
+	case firewallpolicyrulecollectiongroups.NatRule, *firewallpolicyrulecollectiongroups.NatRule:
+		return "NatRule"
+	}
+	return ""
+}
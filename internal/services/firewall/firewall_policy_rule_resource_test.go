@@ -0,0 +1,328 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package firewall_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/firewallpolicyrulecollectiongroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type FirewallPolicyRuleResource struct{}
+
+func TestAccFirewallPolicyRule_application(t *testing.T) {
+	if !features.FivePointOhBeta() {
+		t.Skip("skipping as Rule Collections without any `rule` blocks are only supported in 5.0")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_rule", "test")
+	r := FirewallPolicyRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.application(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFirewallPolicyRule_network(t *testing.T) {
+	if !features.FivePointOhBeta() {
+		t.Skip("skipping as Rule Collections without any `rule` blocks are only supported in 5.0")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_rule", "test")
+	r := FirewallPolicyRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.network(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_firewall_policy_rule.other").ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.networkUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_firewall_policy_rule.other").ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFirewallPolicyRule_nat(t *testing.T) {
+	if !features.FivePointOhBeta() {
+		t.Skip("skipping as Rule Collections without any `rule` blocks are only supported in 5.0")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_rule", "test")
+	r := FirewallPolicyRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.nat(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFirewallPolicyRule_requiresImport(t *testing.T) {
+	if !features.FivePointOhBeta() {
+		t.Skip("skipping as Rule Collections without any `rule` blocks are only supported in 5.0")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_rule", "test")
+	r := FirewallPolicyRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.application(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (FirewallPolicyRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.FirewallPolicyRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	groupId := firewallpolicyrulecollectiongroups.NewRuleCollectionGroupID(id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName)
+	resp, err := clients.Network.FirewallPolicyRuleCollectionGroups.Get(ctx, groupId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %v", groupId, err)
+	}
+
+	if resp.Model == nil || resp.Model.Properties == nil {
+		return utils.Bool(false), nil
+	}
+
+	for _, collection := range pointer.From(resp.Model.Properties.RuleCollections) {
+		var collectionName string
+		var rules []firewallpolicyrulecollectiongroups.FirewallPolicyRule
+		switch v := collection.(type) {
+		case firewallpolicyrulecollectiongroups.FirewallPolicyFilterRuleCollection:
+			collectionName, rules = pointer.From(v.Name), pointer.From(v.Rules)
+		case firewallpolicyrulecollectiongroups.FirewallPolicyNatRuleCollection:
+			collectionName, rules = pointer.From(v.Name), pointer.From(v.Rules)
+		}
+		if collectionName != id.RuleCollectionName {
+			continue
+		}
+
+		for _, rule := range rules {
+			var ruleName string
+			switch v := rule.(type) {
+			case firewallpolicyrulecollectiongroups.ApplicationRule:
+				ruleName = pointer.From(v.Name)
+			case firewallpolicyrulecollectiongroups.NetworkRule:
+				ruleName = pointer.From(v.Name)
+			case firewallpolicyrulecollectiongroups.NatRule:
+				ruleName = pointer.From(v.Name)
+			}
+			if ruleName == id.RuleName {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (FirewallPolicyRuleResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-fwpolicy-rule-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctest-fwpolicy-rule-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_firewall_policy_rule_collection_group" "test" {
+  name               = "acctest-fwpolicy-RCG-%[1]d"
+  firewall_policy_id = azurerm_firewall_policy.test.id
+  priority           = 500
+
+  application_rule_collection {
+    name     = "app_rule_collection1"
+    priority = 500
+    action   = "Deny"
+  }
+
+  network_rule_collection {
+    name     = "network_rule_collection1"
+    priority = 400
+    action   = "Deny"
+  }
+
+  nat_rule_collection {
+    name     = "nat_rule_collection1"
+    priority = 300
+    action   = "Dnat"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r FirewallPolicyRuleResource) application(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_rule" "test" {
+  name                     = "app_rule_collection1_rule1"
+  rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  rule_collection_name     = "app_rule_collection1"
+
+  application_rule {
+    description = "acceptance test application rule"
+    protocols {
+      type = "Https"
+      port = 443
+    }
+    source_addresses  = ["10.0.0.1"]
+    destination_fqdns = ["terraform.io"]
+  }
+}
+`, r.template(data))
+}
+
+func (r FirewallPolicyRuleResource) network(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_rule" "test" {
+  name                     = "network_rule_collection1_rule1"
+  rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  rule_collection_name     = "network_rule_collection1"
+
+  network_rule {
+    protocols             = ["TCP", "UDP"]
+    source_addresses      = ["10.0.0.1"]
+    destination_addresses = ["192.168.1.1"]
+    destination_ports     = ["80", "1000-2000"]
+  }
+}
+
+resource "azurerm_firewall_policy_rule" "other" {
+  name                     = "network_rule_collection1_rule2"
+  rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  rule_collection_name     = "network_rule_collection1"
+
+  network_rule {
+    protocols             = ["UDP"]
+    source_addresses      = ["10.0.0.2"]
+    destination_addresses = ["192.168.1.2"]
+    destination_ports     = ["53"]
+  }
+}
+`, r.template(data))
+}
+
+func (r FirewallPolicyRuleResource) networkUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_rule" "test" {
+  name                     = "network_rule_collection1_rule1"
+  rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  rule_collection_name     = "network_rule_collection1"
+
+  network_rule {
+    description           = "acceptance test network rule"
+    protocols             = ["TCP"]
+    source_addresses      = ["10.0.0.0/24"]
+    destination_addresses = ["192.168.1.0/24"]
+    destination_ports     = ["443"]
+  }
+}
+
+resource "azurerm_firewall_policy_rule" "other" {
+  name                     = "network_rule_collection1_rule2"
+  rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  rule_collection_name     = "network_rule_collection1"
+
+  network_rule {
+    protocols             = ["UDP"]
+    source_addresses      = ["10.0.0.2"]
+    destination_addresses = ["192.168.1.2"]
+    destination_ports     = ["53"]
+  }
+}
+`, r.template(data))
+}
+
+func (r FirewallPolicyRuleResource) nat(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_rule" "test" {
+  name                     = "nat_rule_collection1_rule1"
+  rule_collection_group_id = azurerm_firewall_policy_rule_collection_group.test.id
+  rule_collection_name     = "nat_rule_collection1"
+
+  nat_rule {
+    protocols           = ["TCP", "UDP"]
+    source_addresses    = ["10.0.0.1", "10.0.0.2"]
+    destination_address = "192.168.1.1"
+    destination_ports   = ["80"]
+    translated_address  = "192.168.0.1"
+    translated_port     = "8080"
+  }
+}
+`, r.template(data))
+}
+
+func (r FirewallPolicyRuleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_rule" "import" {
+  name                     = azurerm_firewall_policy_rule.test.name
+  rule_collection_group_id = azurerm_firewall_policy_rule.test.rule_collection_group_id
+  rule_collection_name     = azurerm_firewall_policy_rule.test.rule_collection_name
+
+  application_rule {
+    description = "acceptance test application rule"
+    protocols {
+      type = "Https"
+      port = 443
+    }
+    source_addresses  = ["10.0.0.1"]
+    destination_fqdns = ["terraform.io"]
+  }
+}
+`, r.application(data))
+}
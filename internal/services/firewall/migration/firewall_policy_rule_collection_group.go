@@ -0,0 +1,368 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package migration
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ pluginsdk.StateUpgrade = FirewallPolicyRuleCollectionGroupV0ToV1{}
+
+type FirewallPolicyRuleCollectionGroupV0ToV1 struct{}
+
+func (FirewallPolicyRuleCollectionGroupV0ToV1) Schema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"firewall_policy_id": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"priority": {
+			Type:     pluginsdk.TypeInt,
+			Required: true,
+		},
+
+		"application_rule_collection": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+					"priority": {
+						Type:     pluginsdk.TypeInt,
+						Required: true,
+					},
+					"action": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+					"rule": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:     pluginsdk.TypeString,
+									Required: true,
+								},
+								"description": {
+									Type:     pluginsdk.TypeString,
+									Optional: true,
+								},
+								"protocols": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"type": {
+												Type:     pluginsdk.TypeString,
+												Required: true,
+											},
+											"port": {
+												Type:     pluginsdk.TypeInt,
+												Required: true,
+											},
+										},
+									},
+								},
+								"http_headers": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"name": {
+												Type:     pluginsdk.TypeString,
+												Required: true,
+											},
+											"value": {
+												Type:     pluginsdk.TypeString,
+												Required: true,
+											},
+										},
+									},
+								},
+								"source_addresses": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeString,
+									},
+								},
+								"source_ip_groups": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeString,
+									},
+								},
+								"destination_addresses": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeString,
+									},
+								},
+								"destination_fqdns": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeString,
+									},
+								},
+								"destination_urls": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeString,
+									},
+								},
+								"destination_fqdn_tags": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeString,
+									},
+								},
+								"terminate_tls": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+								},
+								"web_categories": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeString,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"network_rule_collection": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+					"priority": {
+						Type:     pluginsdk.TypeInt,
+						Required: true,
+					},
+					"action": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+					"rule": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:     pluginsdk.TypeString,
+									Required: true,
+								},
+								"description": {
+									Type:     pluginsdk.TypeString,
+									Optional: true,
+								},
+								"protocols": {
+									Type:     pluginsdk.TypeList,
+									Required: true,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeString,
+									},
+								},
+								"source_addresses": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeString,
+									},
+								},
+								"source_ip_groups": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeString,
+									},
+								},
+								"destination_addresses": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeString,
+									},
+								},
+								"destination_ip_groups": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeString,
+									},
+								},
+								"destination_fqdns": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeString,
+									},
+								},
+								"destination_ports": {
+									Type:     pluginsdk.TypeList,
+									Required: true,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeString,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"nat_rule_collection": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+					"priority": {
+						Type:     pluginsdk.TypeInt,
+						Required: true,
+					},
+					"action": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+					"rule": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:     pluginsdk.TypeString,
+									Required: true,
+								},
+								"description": {
+									Type:     pluginsdk.TypeString,
+									Optional: true,
+								},
+								"protocols": {
+									Type:     pluginsdk.TypeList,
+									Required: true,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeString,
+									},
+								},
+								"source_addresses": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeString,
+									},
+								},
+								"source_ip_groups": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeString,
+									},
+								},
+								"destination_address": {
+									Type:     pluginsdk.TypeString,
+									Optional: true,
+								},
+								"destination_ports": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									// only support 1 destination port in one DNAT rule
+									MaxItems: 1,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeString,
+									},
+								},
+								"translated_address": {
+									Type:     pluginsdk.TypeString,
+									Optional: true,
+								},
+								"translated_port": {
+									Type:     pluginsdk.TypeInt,
+									Required: true,
+								},
+								"translated_fqdn": {
+									Type:     pluginsdk.TypeString,
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (FirewallPolicyRuleCollectionGroupV0ToV1) UpgradeFunc() pluginsdk.StateUpgraderFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		// the `rule` blocks are now keyed by `name` and ordered by an optional `priority` - which is left unset so that
+		// the existing Rules retain their current order within each Rule Collection
+		for _, key := range []string{"application_rule_collection", "network_rule_collection", "nat_rule_collection"} {
+			collections, ok := rawState[key].([]interface{})
+			if !ok {
+				continue
+			}
+
+			for _, item := range collections {
+				collection, ok := item.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				rules, ok := collection["rule"].([]interface{})
+				if !ok {
+					continue
+				}
+
+				for _, r := range rules {
+					if rule, ok := r.(map[string]interface{}); ok {
+						rule["priority"] = 0
+					}
+				}
+			}
+		}
+
+		log.Printf("[DEBUG] Updated the `rule` blocks of the Firewall Policy Rule Collection Group to include `priority`")
+
+		return rawState, nil
+	}
+}
@@ -4,6 +4,7 @@
 package firewall
 
 import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
@@ -38,7 +39,7 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
-	return map[string]*pluginsdk.Resource{
+	resources := map[string]*pluginsdk.Resource{
 		"azurerm_firewall_application_rule_collection":  resourceFirewallApplicationRuleCollection(),
 		"azurerm_firewall_policy":                       resourceFirewallPolicy(),
 		"azurerm_firewall_policy_rule_collection_group": resourceFirewallPolicyRuleCollectionGroup(),
@@ -46,4 +47,11 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_firewall_network_rule_collection":      resourceFirewallNetworkRuleCollection(),
 		"azurerm_firewall":                              resourceFirewall(),
 	}
+
+	// Rule Collections without any `rule` blocks are only supported in 5.0, which this resource depends on
+	if features.FivePointOhBeta() {
+		resources["azurerm_firewall_policy_rule"] = resourceFirewallPolicyRule()
+	}
+
+	return resources
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/graphservices/2023-04-13/graphservicesprods"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -34,6 +35,10 @@ func (r ServicesAccountResource) IDValidationFunc() pluginsdk.SchemaValidateFunc
 	return graphservicesprods.ValidateAccountID
 }
 
+func (r ServicesAccountResource) IdentityType() resourceids.ResourceId {
+	return &graphservicesprods.AccountId{}
+}
+
 func (r ServicesAccountResource) ResourceType() string {
 	return "azurerm_graph_services_account"
 }
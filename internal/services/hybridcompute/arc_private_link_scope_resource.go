@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-11-10/privatelinkscopes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -220,3 +221,7 @@ func (a ArcPrivateLinkScopeResource) Delete() sdk.ResourceFunc {
 func (a ArcPrivateLinkScopeResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return privatelinkscopes.ValidateProviderPrivateLinkScopeID
 }
+
+func (a ArcPrivateLinkScopeResource) IdentityType() resourceids.ResourceId {
+	return &privatelinkscopes.ProviderPrivateLinkScopeId{}
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/keyvault/2023-02-01/vaults"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+func keyVaultListResource() sdk.ListResource {
+	return sdk.ListResource{
+		ResourceType:        "azurerm_key_vault",
+		Resource:            resourceKeyVault,
+		ResourceGroupScoped: true,
+		List: func(ctx context.Context, client *clients.Client, resourceGroupName *string) ([]resourceids.ResourceId, error) {
+			var items []vaults.Vault
+			if resourceGroupName != nil {
+				resourceGroupId := commonids.NewResourceGroupID(client.Account.SubscriptionId, *resourceGroupName)
+				resp, err := client.KeyVault.VaultsClient.ListByResourceGroupComplete(ctx, resourceGroupId, vaults.DefaultListByResourceGroupOperationOptions())
+				if err != nil {
+					return nil, fmt.Errorf("listing Key Vaults within %s: %+v", resourceGroupId, err)
+				}
+				items = resp.Items
+			} else {
+				subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
+				resp, err := client.KeyVault.VaultsClient.ListBySubscriptionComplete(ctx, subscriptionId, vaults.DefaultListBySubscriptionOperationOptions())
+				if err != nil {
+					return nil, fmt.Errorf("listing Key Vaults within %s: %+v", subscriptionId, err)
+				}
+				items = resp.Items
+			}
+
+			output := make([]resourceids.ResourceId, 0)
+			for _, item := range items {
+				id, err := commonids.ParseKeyVaultIDInsensitively(pointer.From(item.Id))
+				if err != nil {
+					return nil, err
+				}
+				output = append(output, id)
+			}
+
+			return output, nil
+		},
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/preventdestroy"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/set"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -43,10 +44,9 @@ func resourceKeyVault() *pluginsdk.Resource {
 		Update: resourceKeyVaultUpdate,
 		Delete: resourceKeyVaultDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := commonids.ParseKeyVaultID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&commonids.KeyVaultId{}),
+
+		Identity: pluginsdk.GenerateIdentitySchema(&commonids.KeyVaultId{}),
 
 		SchemaVersion: 2,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -304,7 +304,7 @@ func resourceKeyVaultCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	if !response.WasNotFound(softDeletedKeyVault.HttpResponse) && !response.WasStatusCode(softDeletedKeyVault.HttpResponse, http.StatusForbidden) {
 		if !meta.(*clients.Client).Features.KeyVault.RecoverSoftDeletedKeyVaults {
 			// this exists but the users opted out so they must import this it out-of-band
-			return fmt.Errorf(optedOutOfRecoveringSoftDeletedKeyVaultErrorFmt(id.VaultName, location))
+			return fmt.Errorf("%s", optedOutOfRecoveringSoftDeletedKeyVaultErrorFmt(id.VaultName, location))
 		}
 
 		recoverSoftDeletedKeyVault = true
@@ -716,6 +716,10 @@ func resourceKeyVaultRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	d.Set("resource_group_name", id.ResourceGroupName)
 	d.Set("vault_uri", vaultUri)
 
+	if err := pluginsdk.SetResourceIdentityData(d, id); err != nil {
+		return err
+	}
+
 	publicNetworkAccessEnabled := true
 
 	if model := resp.Model; model != nil {
@@ -809,6 +813,15 @@ func resourceKeyVaultDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
+	if err := preventdestroy.Check(ctx, meta.(*clients.Client), id, func(ctx context.Context) ([]string, error) {
+		if read.Model == nil || read.Model.Properties.VaultUri == nil {
+			return nil, fmt.Errorf("`properties.VaultUri` was nil")
+		}
+		return keyVaultDataItems(ctx, meta.(*clients.Client).KeyVault.ManagementClient, *read.Model.Properties.VaultUri)
+	}); err != nil {
+		return err
+	}
+
 	location := ""
 	purgeProtectionEnabled := false
 	softDeleteEnabled := false
@@ -1058,6 +1071,52 @@ type keyVaultDeletionStatus struct {
 	purgeDate  string
 }
 
+// keyVaultDataItems returns the Certificates, Keys and Secrets which exist within the Key Vault
+func keyVaultDataItems(ctx context.Context, client *dataplane.BaseClient, vaultUri string) ([]string, error) {
+	items := make([]string, 0)
+
+	certificates, err := client.GetCertificatesComplete(ctx, vaultUri, utils.Int32(25), utils.Bool(false))
+	if err != nil {
+		return nil, fmt.Errorf("listing Certificates: %+v", err)
+	}
+	for certificates.NotDone() {
+		items = append(items, fmt.Sprintf("Certificate %q", pointer.From(certificates.Value().ID)))
+		if err := certificates.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing Certificates: %+v", err)
+		}
+	}
+
+	keys, err := client.GetKeysComplete(ctx, vaultUri, utils.Int32(25))
+	if err != nil {
+		return nil, fmt.Errorf("listing Keys: %+v", err)
+	}
+	for keys.NotDone() {
+		// Keys backing a Certificate are removed alongside the Certificate
+		if !pointer.From(keys.Value().Managed) {
+			items = append(items, fmt.Sprintf("Key %q", pointer.From(keys.Value().Kid)))
+		}
+		if err := keys.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing Keys: %+v", err)
+		}
+	}
+
+	secrets, err := client.GetSecretsComplete(ctx, vaultUri, utils.Int32(25))
+	if err != nil {
+		return nil, fmt.Errorf("listing Secrets: %+v", err)
+	}
+	for secrets.NotDone() {
+		// Secrets backing a Certificate are removed alongside the Certificate
+		if !pointer.From(secrets.Value().Managed) {
+			items = append(items, fmt.Sprintf("Secret %q", pointer.From(secrets.Value().ID)))
+		}
+		if err := secrets.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing Secrets: %+v", err)
+		}
+	}
+
+	return items, nil
+}
+
 func getSoftDeletedStateForKeyVault(ctx context.Context, client *vaults.VaultsClient, deletedVaultId vaults.DeletedVaultId) (*keyVaultDeletionStatus, error) {
 	resp, err := client.GetDeleted(ctx, deletedVaultId)
 	if err != nil {
@@ -13,6 +13,8 @@ type Registration struct{}
 var (
 	_ sdk.TypedServiceRegistrationWithAGitHubLabel   = Registration{}
 	_ sdk.UntypedServiceRegistrationWithAGitHubLabel = Registration{}
+
+	_ sdk.ServiceRegistrationWithListResources = Registration{}
 )
 
 func (r Registration) AssociatedGitHubLabel() string {
@@ -71,3 +73,10 @@ func (r Registration) Resources() []sdk.Resource {
 		KeyVaultCertificateContactsResource{},
 	}
 }
+
+// ListResources returns a list of List Resources supported by this Service
+func (r Registration) ListResources() []sdk.ListResource {
+	return []sdk.ListResource{
+		keyVaultListResource(),
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/labservices/2022-08-01/lab"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/labservices/2022-08-01/user"
 	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
@@ -46,6 +47,10 @@ func (r LabServiceUserResource) IDValidationFunc() pluginsdk.SchemaValidateFunc
 	return user.ValidateUserID
 }
 
+func (r LabServiceUserResource) IdentityType() resourceids.ResourceId {
+	return &user.UserId{}
+}
+
 func (r LabServiceUserResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
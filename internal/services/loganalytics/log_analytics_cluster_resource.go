@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/clusters"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -91,6 +92,10 @@ func (r LogAnalyticsClusterResource) IDValidationFunc() pluginsdk.SchemaValidate
 	return clusters.ValidateClusterID
 }
 
+func (r LogAnalyticsClusterResource) IdentityType() resourceids.ResourceId {
+	return &clusters.ClusterId{}
+}
+
 func (r LogAnalyticsClusterResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 6 * time.Hour,
@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2019-09-01/querypackqueries"
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -50,6 +51,10 @@ func (r LogAnalyticsQueryPackQueryResource) IDValidationFunc() pluginsdk.SchemaV
 	return querypackqueries.ValidateQueryID
 }
 
+func (r LogAnalyticsQueryPackQueryResource) IdentityType() resourceids.ResourceId {
+	return &querypackqueries.QueryId{}
+}
+
 func (r LogAnalyticsQueryPackQueryResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
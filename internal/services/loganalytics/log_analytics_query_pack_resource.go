@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2019-09-01/querypacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -29,11 +30,14 @@ type LogAnalyticsQueryPackModel struct {
 type LogAnalyticsQueryPackResource struct{}
 
 var _ sdk.ResourceWithUpdate = LogAnalyticsQueryPackResource{}
+var _ sdk.ResourceWithSubscriptionOverride = LogAnalyticsQueryPackResource{}
 
 func (r LogAnalyticsQueryPackResource) ResourceType() string {
 	return "azurerm_log_analytics_query_pack"
 }
 
+func (r LogAnalyticsQueryPackResource) SupportsSubscriptionOverride() {}
+
 func (r LogAnalyticsQueryPackResource) ModelObject() interface{} {
 	return &LogAnalyticsQueryPackModel{}
 }
@@ -42,6 +46,10 @@ func (r LogAnalyticsQueryPackResource) IDValidationFunc() pluginsdk.SchemaValida
 	return querypacks.ValidateQueryPackID
 }
 
+func (r LogAnalyticsQueryPackResource) IdentityType() resourceids.ResourceId {
+	return &querypacks.QueryPackId{}
+}
+
 func (r LogAnalyticsQueryPackResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -158,18 +166,25 @@ func (r LogAnalyticsQueryPackResource) Read() sdk.ResourceFunc {
 				return err
 			}
 
-			resp, err := client.Get(ctx, *id)
+			model := &querypacks.LogAnalyticsQueryPack{}
+			found, err := metadata.ReadFromResourceGraph(ctx, id, model)
 			if err != nil {
-				if response.WasNotFound(resp.HttpResponse) {
-					return metadata.MarkAsGone(id)
-				}
-
-				return fmt.Errorf("retrieving %s: %+v", *id, err)
+				return err
 			}
+			if !found {
+				resp, err := client.Get(ctx, *id)
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						return metadata.MarkAsGone(id)
+					}
 
-			model := resp.Model
-			if model == nil {
-				return fmt.Errorf("retrieving %s: model was nil", id)
+					return fmt.Errorf("retrieving %s: %+v", *id, err)
+				}
+
+				model = resp.Model
+				if model == nil {
+					return fmt.Errorf("retrieving %s: model was nil", id)
+				}
 			}
 
 			state := LogAnalyticsQueryPackModel{
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationsmanagement/2015-11-01-preview/solution"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -70,6 +71,10 @@ func (s LogAnalyticsSolutionResource) IDValidationFunc() pluginsdk.SchemaValidat
 	return solution.ValidateSolutionID
 }
 
+func (s LogAnalyticsSolutionResource) IdentityType() resourceids.ResourceId {
+	return &solution.SolutionId{}
+}
+
 func (s LogAnalyticsSolutionResource) Arguments() map[string]*schema.Schema {
 	return map[string]*pluginsdk.Schema{
 		"solution_name": {
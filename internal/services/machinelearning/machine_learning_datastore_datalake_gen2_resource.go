@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/datastore"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/workspaces"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -61,6 +62,10 @@ func (r MachineLearningDataStoreDataLakeGen2) IDValidationFunc() pluginsdk.Schem
 	return datastore.ValidateDataStoreID
 }
 
+func (r MachineLearningDataStoreDataLakeGen2) IdentityType() resourceids.ResourceId {
+	return &datastore.DataStoreId{}
+}
+
 var _ sdk.ResourceWithUpdate = MachineLearningDataStoreDataLakeGen2{}
 
 func (r MachineLearningDataStoreDataLakeGen2) Arguments() map[string]*pluginsdk.Schema {
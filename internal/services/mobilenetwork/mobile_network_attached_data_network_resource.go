@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/attacheddatanetwork"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/packetcoredataplane"
@@ -67,6 +68,10 @@ func (r AttachedDataNetworkResource) IDValidationFunc() pluginsdk.SchemaValidate
 	return attacheddatanetwork.ValidateAttachedDataNetworkID
 }
 
+func (r AttachedDataNetworkResource) IdentityType() resourceids.ResourceId {
+	return &attacheddatanetwork.AttachedDataNetworkId{}
+}
+
 func (r AttachedDataNetworkResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"mobile_network_data_network_name": {
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/packetcorecontrolplane"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/packetcoredataplane"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
@@ -48,6 +49,10 @@ func (r PacketCoreDataPlaneResource) IDValidationFunc() pluginsdk.SchemaValidate
 	return packetcoredataplane.ValidatePacketCoreDataPlaneID
 }
 
+func (r PacketCoreDataPlaneResource) IdentityType() resourceids.ResourceId {
+	return &packetcoredataplane.PacketCoreDataPlaneId{}
+}
+
 func (r PacketCoreDataPlaneResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/mobilenetwork"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -45,6 +46,10 @@ func (r MobileNetworkResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return mobilenetwork.ValidateMobileNetworkID
 }
 
+func (r MobileNetworkResource) IdentityType() resourceids.ResourceId {
+	return &mobilenetwork.MobileNetworkId{}
+}
+
 func (r MobileNetworkResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
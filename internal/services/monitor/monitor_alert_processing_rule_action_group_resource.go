@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/alertsmanagement/2021-08-08/alertprocessingrules"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
@@ -44,6 +45,10 @@ func (r AlertProcessingRuleActionGroupResource) IDValidationFunc() pluginsdk.Sch
 	return alertprocessingrules.ValidateActionRuleID
 }
 
+func (r AlertProcessingRuleActionGroupResource) IdentityType() resourceids.ResourceId {
+	return &alertprocessingrules.ActionRuleId{}
+}
+
 func (r AlertProcessingRuleActionGroupResource) Arguments() map[string]*pluginsdk.Schema {
 	arguments := schemaAlertProcessingRule()
 	arguments["add_action_group_ids"] = &pluginsdk.Schema{
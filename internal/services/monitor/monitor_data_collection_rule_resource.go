@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/eventhubs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2022-06-01/datacollectionendpoints"
@@ -924,6 +925,10 @@ func (r DataCollectionRuleResource) IDValidationFunc() pluginsdk.SchemaValidateF
 	return datacollectionrules.ValidateDataCollectionRuleID
 }
 
+func (r DataCollectionRuleResource) IdentityType() resourceids.ResourceId {
+	return &datacollectionrules.DataCollectionRuleId{}
+}
+
 func (r DataCollectionRuleResource) ModelObject() interface{} {
 	return &DataCollectionRule{}
 }
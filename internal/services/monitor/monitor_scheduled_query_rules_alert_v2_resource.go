@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-03-15-preview/scheduledqueryrules"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -89,6 +90,10 @@ func (r ScheduledQueryRulesAlertV2Resource) IDValidationFunc() pluginsdk.SchemaV
 	return scheduledqueryrules.ValidateScheduledQueryRuleID
 }
 
+func (r ScheduledQueryRulesAlertV2Resource) IdentityType() resourceids.ResourceId {
+	return &scheduledqueryrules.ScheduledQueryRuleId{}
+}
+
 func (r ScheduledQueryRulesAlertV2Resource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
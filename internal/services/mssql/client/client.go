@@ -10,7 +10,9 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/backupshorttermretentionpolicies"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/blobauditing"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/databases"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/databaseschemas"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/databasesecurityalertpolicies"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/databasetables"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/databasevulnerabilityassessmentrulebaselines"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/elasticpools"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/encryptionprotectors"
@@ -43,7 +45,9 @@ import (
 type Client struct {
 	BackupShortTermRetentionPoliciesClient             *backupshorttermretentionpolicies.BackupShortTermRetentionPoliciesClient
 	BlobAuditingPoliciesClient                         *blobauditing.BlobAuditingClient
+	DatabaseSchemasClient                              *databaseschemas.DatabaseSchemasClient
 	DatabaseSecurityAlertPoliciesClient                *databasesecurityalertpolicies.DatabaseSecurityAlertPoliciesClient
+	DatabaseTablesClient                               *databasetables.DatabaseTablesClient
 	DatabaseVulnerabilityAssessmentRuleBaselinesClient *databasevulnerabilityassessmentrulebaselines.DatabaseVulnerabilityAssessmentRuleBaselinesClient
 	DatabasesClient                                    *databases.DatabasesClient
 	ElasticPoolsClient                                 *elasticpools.ElasticPoolsClient
@@ -88,12 +92,24 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(databaseExtendedBlobAuditingPoliciesClient.Client, o.Authorizers.ResourceManager)
 
+	databaseSchemasClient, err := databaseschemas.NewDatabaseSchemasClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Database Schemas Client: %+v", err)
+	}
+	o.Configure(databaseSchemasClient.Client, o.Authorizers.ResourceManager)
+
 	databaseSecurityAlertPoliciesClient, err := databasesecurityalertpolicies.NewDatabaseSecurityAlertPoliciesClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Databases Security Alert Policies Client: %+v", err)
 	}
 	o.Configure(databaseSecurityAlertPoliciesClient.Client, o.Authorizers.ResourceManager)
 
+	databaseTablesClient, err := databasetables.NewDatabaseTablesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Database Tables Client: %+v", err)
+	}
+	o.Configure(databaseTablesClient.Client, o.Authorizers.ResourceManager)
+
 	databaseVulnerabilityAssessmentRuleBaselinesClient, err := databasevulnerabilityassessmentrulebaselines.NewDatabaseVulnerabilityAssessmentRuleBaselinesClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Database Vulnerability Assessment Rule Baselines Client: %+v", err)
@@ -289,7 +305,9 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		// 2023-08-01-preview Clients
 		BackupShortTermRetentionPoliciesClient: backupShortTermRetentionPoliciesClient,
 		DatabasesClient:                        databasesClient,
+		DatabaseSchemasClient:                  databaseSchemasClient,
 		DatabaseSecurityAlertPoliciesClient:    databaseSecurityAlertPoliciesClient,
+		DatabaseTablesClient:                   databaseTablesClient,
 		ElasticPoolsClient:                     elasticPoolsClient,
 		GeoBackupPoliciesClient:                geoBackupPoliciesClient,
 		LongTermRetentionPoliciesClient:        longTermRetentionPoliciesClient,
@@ -20,7 +20,9 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/maintenance/2023-04-01/publicmaintenanceconfigurations"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/backupshorttermretentionpolicies"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/databases"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/databaseschemas"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/databasesecurityalertpolicies"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/databasetables"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/elasticpools"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/geobackuppolicies"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/longtermretentionpolicies"
@@ -36,6 +38,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/helper"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/preventdestroy"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -1295,6 +1298,12 @@ func resourceMsSqlDatabaseDelete(d *pluginsdk.ResourceData, meta interface{}) er
 		return err
 	}
 
+	if err := preventdestroy.Check(ctx, meta.(*clients.Client), id, func(ctx context.Context) ([]string, error) {
+		return msSqlDatabaseDataItems(ctx, meta.(*clients.Client).MSSQL.DatabaseSchemasClient, meta.(*clients.Client).MSSQL.DatabaseTablesClient, *id)
+	}); err != nil {
+		return err
+	}
+
 	err = client.DeleteThenPoll(ctx, *id)
 	if err != nil {
 		return fmt.Errorf("deleting %s: %+v", id, err)
@@ -1303,6 +1312,35 @@ func resourceMsSqlDatabaseDelete(d *pluginsdk.ResourceData, meta interface{}) er
 	return nil
 }
 
+// msSqlDatabaseDataItems returns the user Tables which exist within the SQL Database
+func msSqlDatabaseDataItems(ctx context.Context, schemasClient *databaseschemas.DatabaseSchemasClient, tablesClient *databasetables.DatabaseTablesClient, id commonids.SqlDatabaseId) ([]string, error) {
+	schemas, err := schemasClient.ListByDatabaseComplete(ctx, id, databaseschemas.DefaultListByDatabaseOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("listing Schemas: %+v", err)
+	}
+
+	items := make([]string, 0)
+	for _, schema := range schemas.Items {
+		schemaName := pointer.From(schema.Name)
+		// the system schemas always exist and only contain system objects
+		if strings.EqualFold(schemaName, "sys") || strings.EqualFold(schemaName, "INFORMATION_SCHEMA") {
+			continue
+		}
+
+		schemaId := databasetables.NewSchemaID(id.SubscriptionId, id.ResourceGroupName, id.ServerName, id.DatabaseName, schemaName)
+		tables, err := tablesClient.ListBySchemaComplete(ctx, schemaId, databasetables.DefaultListBySchemaOperationOptions())
+		if err != nil {
+			return nil, fmt.Errorf("listing Tables within Schema %q: %+v", schemaName, err)
+		}
+
+		for _, table := range tables.Items {
+			items = append(items, fmt.Sprintf("Table %q", fmt.Sprintf("%s.%s", schemaName, pointer.From(table.Name))))
+		}
+	}
+
+	return items, nil
+}
+
 func flattenMsSqlServerSecurityAlertPolicy(d *pluginsdk.ResourceData, policy databasesecurityalertpolicies.DatabaseSecurityAlertPolicy) []interface{} {
 	// The SQL database security alert API always returns the default value even if never set.
 	// If the values are on their default one, threat it as not set.
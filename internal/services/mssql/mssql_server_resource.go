@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/databases"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/restorabledroppeddatabases"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/serverazureadadministrators"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/serverazureadonlyauthentications"
@@ -30,6 +32,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/custompollers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/preventdestroy"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -577,6 +580,12 @@ func resourceMsSqlServerDelete(d *pluginsdk.ResourceData, meta interface{}) erro
 		return err
 	}
 
+	if err := preventdestroy.Check(ctx, meta.(*clients.Client), id, func(ctx context.Context) ([]string, error) {
+		return msSqlServerDataItems(ctx, meta.(*clients.Client).MSSQL.DatabasesClient, *id)
+	}); err != nil {
+		return err
+	}
+
 	err = client.DeleteThenPoll(ctx, pointer.From(id))
 	if err != nil {
 		return fmt.Errorf("deleting SQL Server %s: %+v", id, err)
@@ -585,6 +594,26 @@ func resourceMsSqlServerDelete(d *pluginsdk.ResourceData, meta interface{}) erro
 	return nil
 }
 
+// msSqlServerDataItems returns the user Databases which exist within the SQL Server
+func msSqlServerDataItems(ctx context.Context, client *databases.DatabasesClient, id commonids.SqlServerId) ([]string, error) {
+	resp, err := client.ListByServerComplete(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("listing Databases: %+v", err)
+	}
+
+	items := make([]string, 0)
+	for _, v := range resp.Items {
+		name := pointer.From(v.Name)
+		// the `master` database always exists and is removed alongside the SQL Server
+		if strings.EqualFold(name, "master") {
+			continue
+		}
+		items = append(items, fmt.Sprintf("Database %q", name))
+	}
+
+	return items, nil
+}
+
 func expandMsSqlServerAADOnlyAuthentictions(input []interface{}) bool {
 	if len(input) == 0 || input[0] == nil {
 		return false
@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-09-01/loadbalancers"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sqlvirtualmachine/2023-10-01/availabilitygrouplisteners"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sqlvirtualmachine/2023-10-01/sqlvirtualmachines"
@@ -76,6 +77,10 @@ func (r MsSqlVirtualMachineAvailabilityGroupListenerResource) IDValidationFunc()
 	return availabilitygrouplisteners.ValidateAvailabilityGroupListenerID
 }
 
+func (r MsSqlVirtualMachineAvailabilityGroupListenerResource) IdentityType() resourceids.ResourceId {
+	return &availabilitygrouplisteners.AvailabilityGroupListenerId{}
+}
+
 func (r MsSqlVirtualMachineAvailabilityGroupListenerResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
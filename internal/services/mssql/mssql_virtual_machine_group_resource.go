@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sqlvirtualmachine/2023-10-01/availabilitygrouplisteners"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sqlvirtualmachine/2023-10-01/sqlvirtualmachinegroups"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -61,6 +62,10 @@ func (r MsSqlVirtualMachineGroupResource) IDValidationFunc() pluginsdk.SchemaVal
 	return availabilitygrouplisteners.ValidateSqlVirtualMachineGroupID
 }
 
+func (r MsSqlVirtualMachineGroupResource) IdentityType() resourceids.ResourceId {
+	return &availabilitygrouplisteners.SqlVirtualMachineGroupId{}
+}
+
 func (r MsSqlVirtualMachineGroupResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -13,6 +13,7 @@ type Registration struct{}
 var (
 	_ sdk.TypedServiceRegistration                   = Registration{}
 	_ sdk.UntypedServiceRegistrationWithAGitHubLabel = Registration{}
+	_ sdk.ServiceRegistrationWithResourceMoves       = Registration{}
 )
 
 func (r Registration) AssociatedGitHubLabel() string {
@@ -76,3 +77,12 @@ func (r Registration) Resources() []sdk.Resource {
 		ServerDNSAliasResource{},
 	}
 }
+
+// ResourceMoves returns a list of the Resource Moves into the Resources supported by this Service
+func (r Registration) ResourceMoves() []sdk.ResourceMove {
+	return []sdk.ResourceMove{
+		sqlDatabaseResourceMove(),
+		sqlElasticPoolResourceMove(),
+		sqlServerResourceMove(),
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mssql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/databases"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+// sqlServerResourceMove returns a Resource Move from the (superseded) `azurerm_sql_server` resource
+func sqlServerResourceMove() sdk.ResourceMove {
+	return sdk.ResourceMove{
+		SourceResourceType: "azurerm_sql_server",
+		TargetResourceType: "azurerm_mssql_server",
+		MoveFunc: func(ctx context.Context, sourceState sdk.ResourceMoveSourceState) (*sdk.ResourceMoveTargetState, error) {
+			id, err := commonids.ParseSqlServerIDInsensitively(sourceState.String("id"))
+			if err != nil {
+				return nil, err
+			}
+
+			output := sdk.ResourceMoveTargetState{
+				ResourceId: id,
+				Attributes: map[string]interface{}{},
+			}
+
+			// the Administrator Login Password isn't returned from the API, so must be retained from the existing state
+			if v := sourceState.String("administrator_login_password"); v != "" {
+				output.Attributes["administrator_login_password"] = v
+			}
+
+			return &output, nil
+		},
+	}
+}
+
+// sqlDatabaseResourceMove returns a Resource Move from the (superseded) `azurerm_sql_database` resource
+func sqlDatabaseResourceMove() sdk.ResourceMove {
+	return sdk.ResourceMove{
+		SourceResourceType: "azurerm_sql_database",
+		TargetResourceType: "azurerm_mssql_database",
+		MoveFunc: func(ctx context.Context, sourceState sdk.ResourceMoveSourceState) (*sdk.ResourceMoveTargetState, error) {
+			id, err := commonids.ParseSqlDatabaseIDInsensitively(sourceState.String("id"))
+			if err != nil {
+				return nil, err
+			}
+
+			// as with importing, the `create_mode` is only retained for Secondary Databases (which is
+			// otherwise `Default`) since the `create_mode` isn't returned from the API
+			output := sdk.ResourceMoveTargetState{
+				ResourceId: id,
+				Attributes: map[string]interface{}{
+					"create_mode": string(databases.CreateModeDefault),
+				},
+			}
+			if strings.HasSuffix(sourceState.String("create_mode"), "Secondary") && sourceState.String("source_database_id") != "" {
+				output.Attributes["create_mode"] = string(databases.CreateModeSecondary)
+				output.Attributes["creation_source_database_id"] = sourceState.String("source_database_id")
+			}
+
+			return &output, nil
+		},
+	}
+}
+
+// sqlElasticPoolResourceMove returns a Resource Move from the (superseded) `azurerm_sql_elasticpool` resource
+func sqlElasticPoolResourceMove() sdk.ResourceMove {
+	return sdk.ResourceMove{
+		SourceResourceType: "azurerm_sql_elasticpool",
+		TargetResourceType: "azurerm_mssql_elasticpool",
+		MoveFunc: func(ctx context.Context, sourceState sdk.ResourceMoveSourceState) (*sdk.ResourceMoveTargetState, error) {
+			id, err := commonids.ParseSqlElasticPoolIDInsensitively(sourceState.String("id"))
+			if err != nil {
+				return nil, err
+			}
+
+			return &sdk.ResourceMoveTargetState{
+				ResourceId: id,
+			}, nil
+		},
+	}
+}
@@ -6,6 +6,7 @@ package mssqlmanagedinstance
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"regexp"
 	"strings"
 	"time"
@@ -19,6 +20,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/maintenance/2023-04-01/publicmaintenanceconfigurations"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/managedinstances"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/operations"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssqlmanagedinstance/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -303,14 +305,18 @@ func (r MsSqlManagedInstanceResource) Create() sdk.ResourceFunc {
 
 			id := commonids.NewSqlManagedInstanceID(subscriptionId, model.ResourceGroupName, model.Name)
 
-			metadata.Logger.Infof("Import check for %s", id)
-			existing, err := client.Get(ctx, id, managedinstances.GetOperationOptions{})
-			if err != nil && !response.WasNotFound(existing.HttpResponse) {
-				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
-			}
+			// a SQL Managed Instance can take several hours to provision, if a previous run was interrupted
+			// whilst this was being created we resume polling the existing operation rather than erroring
+			if !operations.InFlight(ctx, id) {
+				metadata.Logger.Infof("Import check for %s", id)
+				existing, err := client.Get(ctx, id, managedinstances.GetOperationOptions{})
+				if err != nil && !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
 
-			if !response.WasNotFound(existing.HttpResponse) {
-				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+				if !response.WasNotFound(existing.HttpResponse) {
+					return metadata.ResourceRequiresImport(r.ResourceType(), id)
+				}
 			}
 
 			sku, err := r.expandSkuName(model.SkuName)
@@ -353,7 +359,10 @@ func (r MsSqlManagedInstanceResource) Create() sdk.ResourceFunc {
 
 			metadata.Logger.Infof("Creating %s", id)
 
-			err = client.CreateOrUpdateThenPoll(ctx, id, parameters)
+			err = operations.ResumeOrStart(ctx, client.Client, id, func() (*http.Response, error) {
+				resp, err := client.CreateOrUpdate(ctx, id, parameters)
+				return resp.HttpResponse, err
+			})
 			if err != nil {
 				return fmt.Errorf("creating %s: %+v", id, err)
 			}
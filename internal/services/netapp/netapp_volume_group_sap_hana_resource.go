@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2023-05-01/capacitypools"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2023-05-01/volumegroups"
@@ -44,6 +45,10 @@ func (r NetAppVolumeGroupSapHanaResource) IDValidationFunc() pluginsdk.SchemaVal
 	return volumegroups.ValidateVolumeGroupID
 }
 
+func (r NetAppVolumeGroupSapHanaResource) IdentityType() resourceids.ResourceId {
+	return &volumegroups.VolumeGroupId{}
+}
+
 func (r NetAppVolumeGroupSapHanaResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
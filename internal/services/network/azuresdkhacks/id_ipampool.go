@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/recaser"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+func init() {
+	recaser.RegisterResourceId(&IpamPoolId{})
+}
+
+var _ resourceids.ResourceId = &IpamPoolId{}
+
+// IpamPoolId is a struct representing the Resource ID for an IPAM Pool
+type IpamPoolId struct {
+	SubscriptionId     string
+	ResourceGroupName  string
+	NetworkManagerName string
+	IpamPoolName       string
+}
+
+// NewIpamPoolID returns a new IpamPoolId struct
+func NewIpamPoolID(subscriptionId string, resourceGroupName string, networkManagerName string, ipamPoolName string) IpamPoolId {
+	return IpamPoolId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroupName:  resourceGroupName,
+		NetworkManagerName: networkManagerName,
+		IpamPoolName:       ipamPoolName,
+	}
+}
+
+// ParseIpamPoolID parses 'input' into a IpamPoolId
+func ParseIpamPoolID(input string) (*IpamPoolId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&IpamPoolId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := IpamPoolId{}
+	if err := id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// ParseIpamPoolIDInsensitively parses 'input' case-insensitively into a IpamPoolId
+// note: this method should only be used for API response data and not user input
+func ParseIpamPoolIDInsensitively(input string) (*IpamPoolId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&IpamPoolId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := IpamPoolId{}
+	if err := id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *IpamPoolId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroupName, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.NetworkManagerName, ok = input.Parsed["networkManagerName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "networkManagerName", input)
+	}
+
+	if id.IpamPoolName, ok = input.Parsed["ipamPoolName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "ipamPoolName", input)
+	}
+
+	return nil
+}
+
+// ValidateIpamPoolID checks that 'input' can be parsed as an IPAM Pool ID
+func ValidateIpamPoolID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseIpamPoolID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted IPAM Pool ID
+func (id IpamPoolId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkManagers/%s/ipamPools/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.NetworkManagerName, id.IpamPoolName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this IPAM Pool ID
+func (id IpamPoolId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network", "Microsoft.Network"),
+		resourceids.StaticSegment("staticNetworkManagers", "networkManagers", "networkManagers"),
+		resourceids.UserSpecifiedSegment("networkManagerName", "networkManagerValue"),
+		resourceids.StaticSegment("staticIpamPools", "ipamPools", "ipamPools"),
+		resourceids.UserSpecifiedSegment("ipamPoolName", "ipamPoolValue"),
+	}
+}
+
+// String returns a human-readable description of this IPAM Pool ID
+func (id IpamPoolId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Network Manager Name: %q", id.NetworkManagerName),
+		fmt.Sprintf("Ipam Pool Name: %q", id.IpamPoolName),
+	}
+	return fmt.Sprintf("IPAM Pool (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,267 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/subnets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/virtualnetworks"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	sdkEnv "github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+type IpamPoolPrefixAllocation struct {
+	AllocatedAddressPrefixes *[]string                     `json:"allocatedAddressPrefixes,omitempty"`
+	NumberOfIPAddresses      *string                       `json:"numberOfIpAddresses,omitempty"`
+	Pool                     *IpamPoolPrefixAllocationPool `json:"pool,omitempty"`
+}
+
+type IpamPoolPrefixAllocationPool struct {
+	Id *string `json:"id,omitempty"`
+}
+
+// IpamPoolPrefixAllocationsClient manages the IPAM Pool Prefix Allocations of Virtual Networks and Subnets, which
+// aren't available in the `2023-11-01` models - as such the rest of the Virtual Network/Subnet is sent as-is, with
+// any properties which are only available in the newer API Version retained from the existing resource.
+type IpamPoolPrefixAllocationsClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewIpamPoolPrefixAllocationsClientWithBaseURI(sdkApi sdkEnv.Api) (*IpamPoolPrefixAllocationsClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(sdkApi, "ipampoolprefixallocations", ipamApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating IpamPoolPrefixAllocationsClient: %+v", err)
+	}
+
+	return &IpamPoolPrefixAllocationsClient{
+		Client: client,
+	}, nil
+}
+
+// CreateOrUpdateVirtualNetworkThenPoll creates or updates the Virtual Network with the specified IPAM Pool Prefix
+// Allocations, from which the Address Space of the Virtual Network is allocated
+func (c IpamPoolPrefixAllocationsClient) CreateOrUpdateVirtualNetworkThenPoll(ctx context.Context, id commonids.VirtualNetworkId, input virtualnetworks.VirtualNetwork, allocations []IpamPoolPrefixAllocation) error {
+	payload, err := withIpamPoolPrefixAllocations(input, allocations, "properties", "addressSpace")
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[string]interface{})
+	resp, err := c.get(ctx, &id, &existing)
+	if err != nil && !response.WasNotFound(resp) {
+		return fmt.Errorf("retrieving existing: %+v", err)
+	}
+	retainVirtualNetworkProperties(payload, existing)
+
+	return c.createOrUpdateThenPoll(ctx, &id, payload)
+}
+
+// CreateOrUpdateSubnetThenPoll creates or updates the Subnet with the specified IPAM Pool Prefix Allocations, from
+// which the Address Prefixes of the Subnet are allocated
+func (c IpamPoolPrefixAllocationsClient) CreateOrUpdateSubnetThenPoll(ctx context.Context, id commonids.SubnetId, input subnets.Subnet, allocations []IpamPoolPrefixAllocation) error {
+	payload, err := withIpamPoolPrefixAllocations(input, allocations, "properties")
+	if err != nil {
+		return err
+	}
+
+	// the Address Prefix is a single value within the Subnet which is also allocated from the IPAM Pool
+	if len(allocations) > 0 {
+		delete(payload["properties"].(map[string]interface{}), "addressPrefix")
+	}
+
+	return c.createOrUpdateThenPoll(ctx, &id, payload)
+}
+
+// GetVirtualNetwork returns the IPAM Pool Prefix Allocations of the Virtual Network
+func (c IpamPoolPrefixAllocationsClient) GetVirtualNetwork(ctx context.Context, id commonids.VirtualNetworkId) (*http.Response, []IpamPoolPrefixAllocation, error) {
+	var model struct {
+		Properties *struct {
+			AddressSpace *struct {
+				IpamPoolPrefixAllocations *[]IpamPoolPrefixAllocation `json:"ipamPoolPrefixAllocations,omitempty"`
+			} `json:"addressSpace,omitempty"`
+		} `json:"properties,omitempty"`
+	}
+	resp, err := c.get(ctx, &id, &model)
+	if err != nil {
+		return resp, nil, err
+	}
+
+	output := make([]IpamPoolPrefixAllocation, 0)
+	if props := model.Properties; props != nil && props.AddressSpace != nil && props.AddressSpace.IpamPoolPrefixAllocations != nil {
+		output = *props.AddressSpace.IpamPoolPrefixAllocations
+	}
+	return resp, output, nil
+}
+
+// GetSubnet returns the IPAM Pool Prefix Allocations of the Subnet
+func (c IpamPoolPrefixAllocationsClient) GetSubnet(ctx context.Context, id commonids.SubnetId) (*http.Response, []IpamPoolPrefixAllocation, error) {
+	var model struct {
+		Properties *struct {
+			IpamPoolPrefixAllocations *[]IpamPoolPrefixAllocation `json:"ipamPoolPrefixAllocations,omitempty"`
+		} `json:"properties,omitempty"`
+	}
+	resp, err := c.get(ctx, &id, &model)
+	if err != nil {
+		return resp, nil, err
+	}
+
+	output := make([]IpamPoolPrefixAllocation, 0)
+	if props := model.Properties; props != nil && props.IpamPoolPrefixAllocations != nil {
+		output = *props.IpamPoolPrefixAllocations
+	}
+	return resp, output, nil
+}
+
+func (c IpamPoolPrefixAllocationsClient) createOrUpdateThenPoll(ctx context.Context, id resourceids.ResourceId, input map[string]interface{}) error {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusCreated,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPut,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return err
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return fmt.Errorf("performing CreateOrUpdate: %+v", err)
+	}
+
+	poller, err := resourcemanager.PollerFromResponse(resp, c.Client)
+	if err != nil {
+		return err
+	}
+
+	if err := poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after CreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+func (c IpamPoolPrefixAllocationsClient) get(ctx context.Context, id resourceids.ResourceId, model interface{}) (*http.Response, error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := req.Execute(ctx)
+	if resp == nil {
+		return nil, err
+	}
+	if err != nil {
+		return resp.Response, err
+	}
+
+	return resp.Response, resp.Unmarshal(model)
+}
+
+// retainVirtualNetworkProperties copies the properties of the existing Virtual Network which aren't available in the
+// `2023-11-01` model into the payload, so that they're not removed when the Virtual Network is updated - notably the
+// IPAM Pool Prefix Allocations of Subnets, which are managed using the `azurerm_subnet` resource
+func retainVirtualNetworkProperties(payload map[string]interface{}, existing map[string]interface{}) {
+	existingProps, ok := existing["properties"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	props := payload["properties"].(map[string]interface{})
+
+	if v, ok := existingProps["privateEndpointVNetPolicies"]; ok {
+		if _, exists := props["privateEndpointVNetPolicies"]; !exists {
+			props["privateEndpointVNetPolicies"] = v
+		}
+	}
+
+	subnetAllocations := make(map[string][]interface{})
+	existingSubnets, _ := existingProps["subnets"].([]interface{})
+	for _, item := range existingSubnets {
+		subnet, _ := item.(map[string]interface{})
+		name, _ := subnet["name"].(string)
+		subnetProps, _ := subnet["properties"].(map[string]interface{})
+		if v, ok := subnetProps["ipamPoolPrefixAllocations"].([]interface{}); ok && len(v) > 0 {
+			subnetAllocations[strings.ToLower(name)] = v
+		}
+	}
+
+	subnets, _ := props["subnets"].([]interface{})
+	for _, item := range subnets {
+		subnet, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := subnet["name"].(string)
+		v, ok := subnetAllocations[strings.ToLower(name)]
+		if !ok {
+			continue
+		}
+
+		subnetProps, ok := subnet["properties"].(map[string]interface{})
+		if !ok {
+			subnetProps = make(map[string]interface{})
+			subnet["properties"] = subnetProps
+		}
+		subnetProps["ipamPoolPrefixAllocations"] = v
+		delete(subnetProps, "addressPrefix")
+		delete(subnetProps, "addressPrefixes")
+	}
+}
+
+// withIpamPoolPrefixAllocations returns the input as a map with the IPAM Pool Prefix Allocations set within the
+// object at path - removing the Address Prefixes which are allocated from the IPAM Pool(s) where they're specified
+func withIpamPoolPrefixAllocations(input interface{}, allocations []IpamPoolPrefixAllocation, path ...string) (map[string]interface{}, error) {
+	raw, err := json.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling: %+v", err)
+	}
+
+	output := make(map[string]interface{})
+	if err := json.Unmarshal(raw, &output); err != nil {
+		return nil, fmt.Errorf("unmarshaling: %+v", err)
+	}
+
+	object := output
+	for _, key := range path {
+		child, ok := object[key].(map[string]interface{})
+		if !ok {
+			child = make(map[string]interface{})
+			object[key] = child
+		}
+		object = child
+	}
+
+	object["ipamPoolPrefixAllocations"] = allocations
+	if len(allocations) > 0 {
+		delete(object, "addressPrefixes")
+	}
+
+	return output, nil
+}
@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	sdkEnv "github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// NOTE: IPAM Pools were introduced in API Version `2024-05-01` of Network which isn't yet available in
+// `hashicorp/go-azure-sdk` - these clients can be removed once the Network SDK is upgraded.
+const ipamApiVersion = "2024-05-01"
+
+type IpamPool struct {
+	Id         *string            `json:"id,omitempty"`
+	Location   string             `json:"location"`
+	Name       *string            `json:"name,omitempty"`
+	Properties IpamPoolProperties `json:"properties"`
+	Tags       *map[string]string `json:"tags,omitempty"`
+	Type       *string            `json:"type,omitempty"`
+}
+
+type IpamPoolProperties struct {
+	AddressPrefixes   []string  `json:"addressPrefixes"`
+	Description       *string   `json:"description,omitempty"`
+	DisplayName       *string   `json:"displayName,omitempty"`
+	IPAddressType     *[]string `json:"ipAddressType,omitempty"`
+	ParentPoolName    *string   `json:"parentPoolName,omitempty"`
+	ProvisioningState *string   `json:"provisioningState,omitempty"`
+}
+
+type IpamPoolsClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewIpamPoolsClientWithBaseURI(sdkApi sdkEnv.Api) (*IpamPoolsClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(sdkApi, "ipampools", ipamApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating IpamPoolsClient: %+v", err)
+	}
+
+	return &IpamPoolsClient{
+		Client: client,
+	}, nil
+}
+
+type IpamPoolCreateOperationResponse struct {
+	Poller       pollers.Poller
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *IpamPool
+}
+
+// Create ...
+func (c IpamPoolsClient) Create(ctx context.Context, id IpamPoolId, input IpamPool) (result IpamPoolCreateOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusCreated,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPut,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	result.Poller, err = resourcemanager.PollerFromResponse(resp, c.Client)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// CreateThenPoll performs Create then polls until it's completed
+func (c IpamPoolsClient) CreateThenPoll(ctx context.Context, id IpamPoolId, input IpamPool) error {
+	result, err := c.Create(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing Create: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after Create: %+v", err)
+	}
+
+	return nil
+}
+
+type IpamPoolGetOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *IpamPool
+}
+
+// Get ...
+func (c IpamPoolsClient) Get(ctx context.Context, id IpamPoolId) (result IpamPoolGetOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model IpamPool
+	result.Model = &model
+
+	if err = resp.Unmarshal(result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+type IpamPoolDeleteOperationResponse struct {
+	Poller       pollers.Poller
+	HttpResponse *http.Response
+	OData        *odata.OData
+}
+
+// Delete ...
+func (c IpamPoolsClient) Delete(ctx context.Context, id IpamPoolId) (result IpamPoolDeleteOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+			http.StatusNoContent,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodDelete,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	result.Poller, err = resourcemanager.PollerFromResponse(resp, c.Client)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// DeleteThenPoll performs Delete then polls until it's completed
+func (c IpamPoolsClient) DeleteThenPoll(ctx context.Context, id IpamPoolId) error {
+	result, err := c.Delete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing Delete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after Delete: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azuresdkhacks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	sdkEnv "github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+type StaticCidr struct {
+	Id         *string              `json:"id,omitempty"`
+	Name       *string              `json:"name,omitempty"`
+	Properties StaticCidrProperties `json:"properties"`
+	Type       *string              `json:"type,omitempty"`
+}
+
+type StaticCidrProperties struct {
+	AddressPrefixes               *[]string `json:"addressPrefixes,omitempty"`
+	Description                   *string   `json:"description,omitempty"`
+	NumberOfIPAddressesToAllocate *string   `json:"numberOfIPAddressesToAllocate,omitempty"`
+	ProvisioningState             *string   `json:"provisioningState,omitempty"`
+	TotalNumberOfIPAddresses      *string   `json:"totalNumberOfIPAddresses,omitempty"`
+}
+
+type StaticCidrsClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewStaticCidrsClientWithBaseURI(sdkApi sdkEnv.Api) (*StaticCidrsClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(sdkApi, "staticcidrs", ipamApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating StaticCidrsClient: %+v", err)
+	}
+
+	return &StaticCidrsClient{
+		Client: client,
+	}, nil
+}
+
+type StaticCidrCreateOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *StaticCidr
+}
+
+// Create ...
+func (c StaticCidrsClient) Create(ctx context.Context, id StaticCidrId, input StaticCidr) (result StaticCidrCreateOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusCreated,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPut,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model StaticCidr
+	result.Model = &model
+
+	if err = resp.Unmarshal(result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+type StaticCidrGetOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *StaticCidr
+}
+
+// Get ...
+func (c StaticCidrsClient) Get(ctx context.Context, id StaticCidrId) (result StaticCidrGetOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model StaticCidr
+	result.Model = &model
+
+	if err = resp.Unmarshal(result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+type StaticCidrDeleteOperationResponse struct {
+	Poller       pollers.Poller
+	HttpResponse *http.Response
+	OData        *odata.OData
+}
+
+// Delete ...
+func (c StaticCidrsClient) Delete(ctx context.Context, id StaticCidrId) (result StaticCidrDeleteOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+			http.StatusNoContent,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodDelete,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	result.Poller, err = resourcemanager.PollerFromResponse(resp, c.Client)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// DeleteThenPoll performs Delete then polls until it's completed
+func (c StaticCidrsClient) DeleteThenPoll(ctx context.Context, id StaticCidrId) error {
+	result, err := c.Delete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing Delete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after Delete: %+v", err)
+	}
+
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	network_2023_11_01 "github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/azuresdkhacks"
 )
 
 type Client struct {
@@ -19,6 +20,14 @@ type Client struct {
 	// VMSS Data Source requires the Network Interfaces and VMSSPublicIpAddresses client from `2023-09-01` for the `ListVirtualMachineScaleSetVMNetworkInterfacesComplete` method
 	NetworkInterfacesClient     *networkinterfaces.NetworkInterfacesClient
 	VMSSPublicIPAddressesClient *vmsspublicipaddresses.VMSSPublicIPAddressesClient
+
+	// IPAM Pools require API Version `2024-05-01`, which isn't available in the Network SDK yet
+	IpamPoolsClient                 *azuresdkhacks.IpamPoolsClient
+	IpamPoolPrefixAllocationsClient *azuresdkhacks.IpamPoolPrefixAllocationsClient
+	StaticCidrsClient               *azuresdkhacks.StaticCidrsClient
+
+	// Private Endpoint Connections are managed within the Resource Provider of the Target Resource
+	PrivateEndpointConnectionsClient *PrivateEndpointConnectionsClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -34,6 +43,29 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(VMSSPublicIPAddressesClient.Client, o.Authorizers.ResourceManager)
 
+	IpamPoolsClient, err := azuresdkhacks.NewIpamPoolsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building IPAM Pools Client: %+v", err)
+	}
+	o.Configure(IpamPoolsClient.Client, o.Authorizers.ResourceManager)
+
+	IpamPoolPrefixAllocationsClient, err := azuresdkhacks.NewIpamPoolPrefixAllocationsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building IPAM Pool Prefix Allocations Client: %+v", err)
+	}
+	o.Configure(IpamPoolPrefixAllocationsClient.Client, o.Authorizers.ResourceManager)
+
+	StaticCidrsClient, err := azuresdkhacks.NewStaticCidrsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Static CIDRs Client: %+v", err)
+	}
+	o.Configure(StaticCidrsClient.Client, o.Authorizers.ResourceManager)
+
+	PrivateEndpointConnectionsClient, err := NewPrivateEndpointConnectionsClient(o)
+	if err != nil {
+		return nil, fmt.Errorf("building Private Endpoint Connections Client: %+v", err)
+	}
+
 	client, err := network_2023_11_01.NewClientWithBaseURI(o.Environment.ResourceManager, func(c *resourcemanager.Client) {
 		o.Configure(c, o.Authorizers.ResourceManager)
 	})
@@ -42,8 +74,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 
 	return &Client{
-		NetworkInterfacesClient:     NetworkInterfacesClient,
-		VMSSPublicIPAddressesClient: VMSSPublicIPAddressesClient,
-		Client:                      client,
+		NetworkInterfacesClient:          NetworkInterfacesClient,
+		VMSSPublicIPAddressesClient:      VMSSPublicIPAddressesClient,
+		IpamPoolsClient:                  IpamPoolsClient,
+		IpamPoolPrefixAllocationsClient:  IpamPoolPrefixAllocationsClient,
+		StaticCidrsClient:                StaticCidrsClient,
+		PrivateEndpointConnectionsClient: PrivateEndpointConnectionsClient,
+		Client:                           client,
 	}, nil
 }
@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+// privateEndpointConnectionApiVersions are the API versions used to manage the Private Endpoint Connections within
+// each Resource Provider (keyed by the lower-cased namespace), since there's no common API version across Resource
+// Providers - new Resource Providers should be added here once the API version has been tested.
+var privateEndpointConnectionApiVersions = map[string]string{
+	"microsoft.appconfiguration":  "2023-03-01",
+	"microsoft.cognitiveservices": "2023-05-01",
+	"microsoft.containerregistry": "2023-07-01",
+	"microsoft.documentdb":        "2023-04-15",
+	"microsoft.eventhub":          "2021-11-01",
+	"microsoft.keyvault":          "2023-07-01",
+	"microsoft.network":           "2023-11-01",
+	"microsoft.search":            "2023-11-01",
+	"microsoft.servicebus":        "2021-11-01",
+	"microsoft.signalrservice":    "2023-02-01",
+	"microsoft.sql":               "2021-11-01",
+	"microsoft.storage":           "2023-01-01",
+	"microsoft.web":               "2023-01-01",
+}
+
+// PrivateEndpointConnectionProviderNamespaces returns the (lower-cased) Resource Provider namespaces for which the
+// Private Endpoint Connections can be managed
+func PrivateEndpointConnectionProviderNamespaces() []string {
+	output := make([]string, 0, len(privateEndpointConnectionApiVersions))
+	for namespace := range privateEndpointConnectionApiVersions {
+		output = append(output, namespace)
+	}
+	sort.Strings(output)
+	return output
+}
+
+// PrivateEndpointConnection is a Private Endpoint Connection within any Resource Provider, where the properties are
+// retained as-is since these differ slightly between Resource Providers
+type PrivateEndpointConnection struct {
+	Id         *string                `json:"id,omitempty"`
+	Name       *string                `json:"name,omitempty"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+type PrivateEndpointConnectionGetResponse struct {
+	HttpResponse *http.Response
+	Model        *PrivateEndpointConnection
+}
+
+// PrivateEndpointConnectionsClient manages the Private Endpoint Connections to Resources within other Resource
+// Providers (for example a Storage Account), using the API version pinned for each Resource Provider
+type PrivateEndpointConnectionsClient struct {
+	clients map[string]*resourcemanager.Client
+}
+
+func NewPrivateEndpointConnectionsClient(o *common.ClientOptions) (*PrivateEndpointConnectionsClient, error) {
+	clients := make(map[string]*resourcemanager.Client)
+	for namespace, apiVersion := range privateEndpointConnectionApiVersions {
+		c, err := resourcemanager.NewResourceManagerClient(o.Environment.ResourceManager, "privateendpointconnections", apiVersion)
+		if err != nil {
+			return nil, fmt.Errorf("building Private Endpoint Connections client for %q: %+v", namespace, err)
+		}
+		o.Configure(c, o.Authorizers.ResourceManager)
+		clients[namespace] = c
+	}
+
+	return &PrivateEndpointConnectionsClient{
+		clients: clients,
+	}, nil
+}
+
+func (c PrivateEndpointConnectionsClient) clientForNamespace(namespace string) (*resourcemanager.Client, error) {
+	v, ok := c.clients[strings.ToLower(namespace)]
+	if !ok {
+		return nil, fmt.Errorf("managing Private Endpoint Connections within the Resource Provider %q is not supported - supported Resource Providers are: %s", namespace, strings.Join(PrivateEndpointConnectionProviderNamespaces(), ", "))
+	}
+	return v, nil
+}
+
+// Get retrieves the Private Endpoint Connection, which is a child of a Resource within the specified Resource Provider
+func (c PrivateEndpointConnectionsClient) Get(ctx context.Context, namespace string, id resourceids.Id) (result PrivateEndpointConnectionGetResponse, err error) {
+	resourceManagerClient, err := c.clientForNamespace(namespace)
+	if err != nil {
+		return
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       id.ID(),
+	}
+
+	req, err := resourceManagerClient.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model PrivateEndpointConnection
+	if err = resp.Unmarshal(&model); err != nil {
+		return
+	}
+	result.Model = &model
+
+	return
+}
+
+// CreateOrUpdateThenPoll updates the Private Endpoint Connection and then polls until the update has completed
+func (c PrivateEndpointConnectionsClient) CreateOrUpdateThenPoll(ctx context.Context, namespace string, id resourceids.Id, input PrivateEndpointConnection) error {
+	resourceManagerClient, err := c.clientForNamespace(namespace)
+	if err != nil {
+		return err
+	}
+
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+			http.StatusCreated,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPut,
+		Path:       id.ID(),
+	}
+
+	req, err := resourceManagerClient.NewRequest(ctx, opts)
+	if err != nil {
+		return fmt.Errorf("building request: %+v", err)
+	}
+
+	if err := req.Marshal(input); err != nil {
+		return fmt.Errorf("marshaling request: %+v", err)
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return fmt.Errorf("performing CreateOrUpdate: %+v", err)
+	}
+
+	poller, err := resourcemanager.PollerFromResponse(resp, resourceManagerClient)
+	if err != nil {
+		return fmt.Errorf("building poller: %+v", err)
+	}
+	if err := poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after CreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
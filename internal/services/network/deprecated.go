@@ -21,7 +21,7 @@ func evaluateSchemaValidateFunc(i interface{}, k string, validateFunc pluginsdk.
 	}
 
 	if len(errors) > 0 {
-		return false, fmt.Errorf(strings.Join(errorStrings, "\n"))
+		return false, fmt.Errorf("%s", strings.Join(errorStrings, "\n"))
 	}
 
 	return true, nil
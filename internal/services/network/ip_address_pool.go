@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// ipAddressPoolSchema returns the schema for allocating the address space of a Virtual Network or Subnet from one
+// IPv4 and/or one IPv6 Network Manager IPAM Pool, as an alternative to specifying the address prefixes directly
+func ipAddressPoolSchema(conflictsWith string) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:         pluginsdk.TypeList,
+		Optional:     true,
+		MinItems:     1,
+		MaxItems:     2,
+		ExactlyOneOf: []string{conflictsWith, "ip_address_pool"},
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: azuresdkhacks.ValidateIpamPoolID,
+				},
+
+				"number_of_ip_addresses": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[1-9][0-9]*$`), "must be a positive number of IP Addresses"),
+				},
+
+				"allocated_ip_address_prefixes": {
+					Type:     pluginsdk.TypeList,
+					Computed: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandIpAddressPools(input []interface{}) []azuresdkhacks.IpamPoolPrefixAllocation {
+	output := make([]azuresdkhacks.IpamPoolPrefixAllocation, 0)
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+
+		output = append(output, azuresdkhacks.IpamPoolPrefixAllocation{
+			NumberOfIPAddresses: pointer.To(v["number_of_ip_addresses"].(string)),
+			Pool: &azuresdkhacks.IpamPoolPrefixAllocationPool{
+				Id: pointer.To(v["id"].(string)),
+			},
+		})
+	}
+
+	return output
+}
+
+func flattenIpAddressPools(input []azuresdkhacks.IpamPoolPrefixAllocation) []interface{} {
+	output := make([]interface{}, 0)
+	for _, item := range input {
+		poolId := ""
+		if item.Pool != nil && item.Pool.Id != nil {
+			poolId = *item.Pool.Id
+			if parsed, err := azuresdkhacks.ParseIpamPoolIDInsensitively(poolId); err == nil {
+				poolId = parsed.ID()
+			}
+		}
+
+		output = append(output, map[string]interface{}{
+			"id":                            poolId,
+			"number_of_ip_addresses":        pointer.From(item.NumberOfIPAddresses),
+			"allocated_ip_address_prefixes": pointer.From(item.AllocatedAddressPrefixes),
+		})
+	}
+
+	return output
+}
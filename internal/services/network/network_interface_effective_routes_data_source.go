@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/networkinterfaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type NetworkInterfaceEffectiveRoutesModel struct {
+	NetworkInterfaceId string                                `tfschema:"network_interface_id"`
+	Routes             []NetworkInterfaceEffectiveRouteModel `tfschema:"route"`
+}
+
+type NetworkInterfaceEffectiveRouteModel struct {
+	Name                       string   `tfschema:"name"`
+	Source                     string   `tfschema:"source"`
+	State                      string   `tfschema:"state"`
+	AddressPrefixes            []string `tfschema:"address_prefixes"`
+	NextHopType                string   `tfschema:"next_hop_type"`
+	NextHopIPAddresses         []string `tfschema:"next_hop_ip_addresses"`
+	DisableBgpRoutePropagation bool     `tfschema:"disable_bgp_route_propagation"`
+}
+
+type NetworkInterfaceEffectiveRoutesDataSource struct{}
+
+var _ sdk.DataSource = NetworkInterfaceEffectiveRoutesDataSource{}
+
+func (r NetworkInterfaceEffectiveRoutesDataSource) ResourceType() string {
+	return "azurerm_network_interface_effective_routes"
+}
+
+func (r NetworkInterfaceEffectiveRoutesDataSource) ModelObject() interface{} {
+	return &NetworkInterfaceEffectiveRoutesModel{}
+}
+
+func (r NetworkInterfaceEffectiveRoutesDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"network_interface_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateNetworkInterfaceID,
+		},
+	}
+}
+
+func (r NetworkInterfaceEffectiveRoutesDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"route": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"source": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"state": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"address_prefixes": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"next_hop_type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"next_hop_ip_addresses": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"disable_bgp_route_propagation": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r NetworkInterfaceEffectiveRoutesDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkInterfaces
+
+			var model NetworkInterfaceEffectiveRoutesModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := commonids.ParseNetworkInterfaceID(model.NetworkInterfaceId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.GetEffectiveRouteTable(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving the Effective Routes for %s: %+v", id, err)
+			}
+
+			var result struct {
+				Value *[]networkinterfaces.EffectiveRoute `json:"value"`
+			}
+			if err := networkWatcherDiagnosticResult(ctx, resp.HttpResponse, &resp.Poller, &result); err != nil {
+				return fmt.Errorf("waiting for the Effective Routes for %s: %+v", id, err)
+			}
+
+			model.Routes = flattenNetworkInterfaceEffectiveRoutes(result.Value)
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func flattenNetworkInterfaceEffectiveRoutes(input *[]networkinterfaces.EffectiveRoute) []NetworkInterfaceEffectiveRouteModel {
+	output := make([]NetworkInterfaceEffectiveRouteModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, NetworkInterfaceEffectiveRouteModel{
+			Name:                       pointer.From(v.Name),
+			Source:                     string(pointer.From(v.Source)),
+			State:                      string(pointer.From(v.State)),
+			AddressPrefixes:            pointer.From(v.AddressPrefix),
+			NextHopType:                string(pointer.From(v.NextHopType)),
+			NextHopIPAddresses:         pointer.From(v.NextHopIPAddress),
+			DisableBgpRoutePropagation: pointer.From(v.DisableBgpRoutePropagation),
+		})
+	}
+
+	return output
+}
@@ -0,0 +1,242 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/networkinterfaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type NetworkInterfaceEffectiveSecurityRulesModel struct {
+	NetworkInterfaceId    string                                               `tfschema:"network_interface_id"`
+	NetworkSecurityGroups []NetworkInterfaceEffectiveNetworkSecurityGroupModel `tfschema:"network_security_group"`
+}
+
+type NetworkInterfaceEffectiveNetworkSecurityGroupModel struct {
+	Id                 string                                       `tfschema:"id"`
+	NetworkInterfaceId string                                       `tfschema:"network_interface_id"`
+	SubnetId           string                                       `tfschema:"subnet_id"`
+	SecurityRules      []NetworkInterfaceEffectiveSecurityRuleModel `tfschema:"security_rule"`
+}
+
+type NetworkInterfaceEffectiveSecurityRuleModel struct {
+	Name                               string   `tfschema:"name"`
+	Priority                           int64    `tfschema:"priority"`
+	Direction                          string   `tfschema:"direction"`
+	Access                             string   `tfschema:"access"`
+	Protocol                           string   `tfschema:"protocol"`
+	SourceAddressPrefixes              []string `tfschema:"source_address_prefixes"`
+	ExpandedSourceAddressPrefixes      []string `tfschema:"expanded_source_address_prefixes"`
+	SourcePortRanges                   []string `tfschema:"source_port_ranges"`
+	DestinationAddressPrefixes         []string `tfschema:"destination_address_prefixes"`
+	ExpandedDestinationAddressPrefixes []string `tfschema:"expanded_destination_address_prefixes"`
+	DestinationPortRanges              []string `tfschema:"destination_port_ranges"`
+}
+
+type NetworkInterfaceEffectiveSecurityRulesDataSource struct{}
+
+var _ sdk.DataSource = NetworkInterfaceEffectiveSecurityRulesDataSource{}
+
+func (r NetworkInterfaceEffectiveSecurityRulesDataSource) ResourceType() string {
+	return "azurerm_network_interface_effective_security_rules"
+}
+
+func (r NetworkInterfaceEffectiveSecurityRulesDataSource) ModelObject() interface{} {
+	return &NetworkInterfaceEffectiveSecurityRulesModel{}
+}
+
+func (r NetworkInterfaceEffectiveSecurityRulesDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"network_interface_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateNetworkInterfaceID,
+		},
+	}
+}
+
+func (r NetworkInterfaceEffectiveSecurityRulesDataSource) Attributes() map[string]*pluginsdk.Schema {
+	stringList := func() *pluginsdk.Schema {
+		return &pluginsdk.Schema{
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		}
+	}
+
+	return map[string]*pluginsdk.Schema{
+		"network_security_group": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"network_interface_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"subnet_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"security_rule": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"priority": {
+									Type:     pluginsdk.TypeInt,
+									Computed: true,
+								},
+
+								"direction": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"access": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"protocol": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"source_address_prefixes": stringList(),
+
+								"expanded_source_address_prefixes": stringList(),
+
+								"source_port_ranges": stringList(),
+
+								"destination_address_prefixes": stringList(),
+
+								"expanded_destination_address_prefixes": stringList(),
+
+								"destination_port_ranges": stringList(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r NetworkInterfaceEffectiveSecurityRulesDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkInterfaces
+
+			var model NetworkInterfaceEffectiveSecurityRulesModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := commonids.ParseNetworkInterfaceID(model.NetworkInterfaceId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.ListEffectiveNetworkSecurityGroups(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving the Effective Security Rules for %s: %+v", id, err)
+			}
+
+			var result struct {
+				Value *[]networkinterfaces.EffectiveNetworkSecurityGroup `json:"value"`
+			}
+			if err := networkWatcherDiagnosticResult(ctx, resp.HttpResponse, &resp.Poller, &result); err != nil {
+				return fmt.Errorf("waiting for the Effective Security Rules for %s: %+v", id, err)
+			}
+
+			model.NetworkSecurityGroups = flattenNetworkInterfaceEffectiveNetworkSecurityGroups(result.Value)
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func flattenNetworkInterfaceEffectiveNetworkSecurityGroups(input *[]networkinterfaces.EffectiveNetworkSecurityGroup) []NetworkInterfaceEffectiveNetworkSecurityGroupModel {
+	output := make([]NetworkInterfaceEffectiveNetworkSecurityGroupModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		group := NetworkInterfaceEffectiveNetworkSecurityGroupModel{
+			SecurityRules: make([]NetworkInterfaceEffectiveSecurityRuleModel, 0),
+		}
+		if v.NetworkSecurityGroup != nil {
+			group.Id = pointer.From(v.NetworkSecurityGroup.Id)
+		}
+		if association := v.Association; association != nil {
+			if association.NetworkInterface != nil {
+				group.NetworkInterfaceId = pointer.From(association.NetworkInterface.Id)
+			}
+			if association.Subnet != nil {
+				group.SubnetId = pointer.From(association.Subnet.Id)
+			}
+		}
+
+		if v.EffectiveSecurityRules != nil {
+			for _, rule := range *v.EffectiveSecurityRules {
+				group.SecurityRules = append(group.SecurityRules, NetworkInterfaceEffectiveSecurityRuleModel{
+					Name:                               pointer.From(rule.Name),
+					Priority:                           pointer.From(rule.Priority),
+					Direction:                          string(pointer.From(rule.Direction)),
+					Access:                             string(pointer.From(rule.Access)),
+					Protocol:                           string(pointer.From(rule.Protocol)),
+					SourceAddressPrefixes:              flattenEffectiveSecurityRuleValues(rule.SourceAddressPrefix, rule.SourceAddressPrefixes),
+					ExpandedSourceAddressPrefixes:      pointer.From(rule.ExpandedSourceAddressPrefix),
+					SourcePortRanges:                   flattenEffectiveSecurityRuleValues(rule.SourcePortRange, rule.SourcePortRanges),
+					DestinationAddressPrefixes:         flattenEffectiveSecurityRuleValues(rule.DestinationAddressPrefix, rule.DestinationAddressPrefixes),
+					ExpandedDestinationAddressPrefixes: pointer.From(rule.ExpandedDestinationAddressPrefix),
+					DestinationPortRanges:              flattenEffectiveSecurityRuleValues(rule.DestinationPortRange, rule.DestinationPortRanges),
+				})
+			}
+		}
+
+		output = append(output, group)
+	}
+
+	return output
+}
+
+// flattenEffectiveSecurityRuleValues combines the singular and plural fields of an Effective Security Rule, since
+// which is returned depends on how the Security Rule was defined
+func flattenEffectiveSecurityRuleValues(value *string, values *[]string) []string {
+	output := make([]string, 0)
+	if value != nil && *value != "" {
+		output = append(output, *value)
+	}
+	if values != nil {
+		output = append(output, *values...)
+	}
+	return output
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-09-01/networkgroups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/adminrulecollections"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -40,6 +41,10 @@ func (r ManagerAdminRuleCollectionResource) IDValidationFunc() pluginsdk.SchemaV
 	return adminrulecollections.ValidateRuleCollectionID
 }
 
+func (r ManagerAdminRuleCollectionResource) IdentityType() resourceids.ResourceId {
+	return &adminrulecollections.RuleCollectionId{}
+}
+
 func (r ManagerAdminRuleCollectionResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
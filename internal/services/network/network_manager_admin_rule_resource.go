@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/adminrules"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -51,6 +52,10 @@ func (r ManagerAdminRuleResource) IDValidationFunc() pluginsdk.SchemaValidateFun
 	return adminrules.ValidateRuleID
 }
 
+func (r ManagerAdminRuleResource) IdentityType() resourceids.ResourceId {
+	return &adminrules.RuleId{}
+}
+
 func (r ManagerAdminRuleResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/connectivityconfigurations"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -56,6 +57,10 @@ func (r ManagerConnectivityConfigurationResource) IDValidationFunc() pluginsdk.S
 	return connectivityconfigurations.ValidateConnectivityConfigurationID
 }
 
+func (r ManagerConnectivityConfigurationResource) IdentityType() resourceids.ResourceId {
+	return &connectivityconfigurations.ConnectivityConfigurationId{}
+}
+
 func (r ManagerConnectivityConfigurationResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
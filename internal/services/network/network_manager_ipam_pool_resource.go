@@ -0,0 +1,279 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/networkmanagers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ManagerIpamPoolModel struct {
+	Name             string                 `tfschema:"name"`
+	NetworkManagerId string                 `tfschema:"network_manager_id"`
+	Location         string                 `tfschema:"location"`
+	AddressPrefixes  []string               `tfschema:"address_prefixes"`
+	Description      string                 `tfschema:"description"`
+	DisplayName      string                 `tfschema:"display_name"`
+	ParentPoolName   string                 `tfschema:"parent_pool_name"`
+	Tags             map[string]interface{} `tfschema:"tags"`
+}
+
+type ManagerIpamPoolResource struct{}
+
+var _ sdk.ResourceWithUpdate = ManagerIpamPoolResource{}
+
+func (r ManagerIpamPoolResource) ResourceType() string {
+	return "azurerm_network_manager_ipam_pool"
+}
+
+func (r ManagerIpamPoolResource) ModelObject() interface{} {
+	return &ManagerIpamPoolModel{}
+}
+
+func (r ManagerIpamPoolResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return azuresdkhacks.ValidateIpamPoolID
+}
+
+func (r ManagerIpamPoolResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.NetworkManagerIpamPoolName,
+		},
+
+		"network_manager_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: networkmanagers.ValidateNetworkManagerID,
+		},
+
+		"location": commonschema.Location(),
+
+		"address_prefixes": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.IsCIDR,
+			},
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"parent_pool_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.NetworkManagerIpamPoolName,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ManagerIpamPoolResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ManagerIpamPoolResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ManagerIpamPoolModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Network.IpamPoolsClient
+			networkManagerId, err := networkmanagers.ParseNetworkManagerID(model.NetworkManagerId)
+			if err != nil {
+				return err
+			}
+
+			id := azuresdkhacks.NewIpamPoolID(networkManagerId.SubscriptionId, networkManagerId.ResourceGroupName, networkManagerId.NetworkManagerName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			pool := azuresdkhacks.IpamPool{
+				Location: location.Normalize(model.Location),
+				Properties: azuresdkhacks.IpamPoolProperties{
+					AddressPrefixes: model.AddressPrefixes,
+				},
+				Tags: utils.ExpandPtrMapStringString(model.Tags),
+			}
+
+			if model.Description != "" {
+				pool.Properties.Description = pointer.To(model.Description)
+			}
+
+			if model.DisplayName != "" {
+				pool.Properties.DisplayName = pointer.To(model.DisplayName)
+			}
+
+			if model.ParentPoolName != "" {
+				pool.Properties.ParentPoolName = pointer.To(model.ParentPoolName)
+			}
+
+			if err := client.CreateThenPoll(ctx, id, pool); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ManagerIpamPoolResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.IpamPoolsClient
+
+			id, err := azuresdkhacks.ParseIpamPoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ManagerIpamPoolModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *id)
+			}
+
+			pool := existing.Model
+			if metadata.ResourceData.HasChange("address_prefixes") {
+				pool.Properties.AddressPrefixes = model.AddressPrefixes
+			}
+
+			if metadata.ResourceData.HasChange("description") {
+				pool.Properties.Description = nil
+				if model.Description != "" {
+					pool.Properties.Description = pointer.To(model.Description)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("display_name") {
+				pool.Properties.DisplayName = nil
+				if model.DisplayName != "" {
+					pool.Properties.DisplayName = pointer.To(model.DisplayName)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				pool.Tags = utils.ExpandPtrMapStringString(model.Tags)
+			}
+
+			// the read-only properties are rejected by the API
+			pool.Properties.IPAddressType = nil
+			pool.Properties.ProvisioningState = nil
+
+			if err := client.CreateThenPoll(ctx, *id, *pool); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ManagerIpamPoolResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.IpamPoolsClient
+
+			id, err := azuresdkhacks.ParseIpamPoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *id)
+			}
+
+			properties := existing.Model.Properties
+			state := ManagerIpamPoolModel{
+				Name:             id.IpamPoolName,
+				NetworkManagerId: networkmanagers.NewNetworkManagerID(id.SubscriptionId, id.ResourceGroupName, id.NetworkManagerName).ID(),
+				Location:         location.Normalize(existing.Model.Location),
+				AddressPrefixes:  properties.AddressPrefixes,
+				Description:      pointer.From(properties.Description),
+				DisplayName:      pointer.From(properties.DisplayName),
+				ParentPoolName:   pointer.From(properties.ParentPoolName),
+				Tags:             utils.FlattenPtrMapStringString(existing.Model.Tags),
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ManagerIpamPoolResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.IpamPoolsClient
+
+			id, err := azuresdkhacks.ParseIpamPoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
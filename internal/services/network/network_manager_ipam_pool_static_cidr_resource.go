@@ -0,0 +1,253 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ManagerIpamPoolStaticCidrModel struct {
+	Name                          string   `tfschema:"name"`
+	IpamPoolId                    string   `tfschema:"ipam_pool_id"`
+	AddressPrefixes               []string `tfschema:"address_prefixes"`
+	NumberOfIPAddressesToAllocate string   `tfschema:"number_of_ip_addresses_to_allocate"`
+	Description                   string   `tfschema:"description"`
+	TotalNumberOfIPAddresses      string   `tfschema:"total_number_of_ip_addresses"`
+}
+
+type ManagerIpamPoolStaticCidrResource struct{}
+
+var _ sdk.ResourceWithUpdate = ManagerIpamPoolStaticCidrResource{}
+
+func (r ManagerIpamPoolStaticCidrResource) ResourceType() string {
+	return "azurerm_network_manager_ipam_pool_static_cidr"
+}
+
+func (r ManagerIpamPoolStaticCidrResource) ModelObject() interface{} {
+	return &ManagerIpamPoolStaticCidrModel{}
+}
+
+func (r ManagerIpamPoolStaticCidrResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return azuresdkhacks.ValidateStaticCidrID
+}
+
+func (r ManagerIpamPoolStaticCidrResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.NetworkManagerIpamPoolName,
+		},
+
+		"ipam_pool_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: azuresdkhacks.ValidateIpamPoolID,
+		},
+
+		"address_prefixes": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.IsCIDR,
+			},
+			ExactlyOneOf: []string{"address_prefixes", "number_of_ip_addresses_to_allocate"},
+		},
+
+		"number_of_ip_addresses_to_allocate": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[1-9][0-9]*$`), "must be a positive number of IP Addresses"),
+			ExactlyOneOf: []string{"address_prefixes", "number_of_ip_addresses_to_allocate"},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r ManagerIpamPoolStaticCidrResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"total_number_of_ip_addresses": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ManagerIpamPoolStaticCidrResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ManagerIpamPoolStaticCidrModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Network.StaticCidrsClient
+			poolId, err := azuresdkhacks.ParseIpamPoolID(model.IpamPoolId)
+			if err != nil {
+				return err
+			}
+
+			id := azuresdkhacks.NewStaticCidrID(poolId.SubscriptionId, poolId.ResourceGroupName, poolId.NetworkManagerName, poolId.IpamPoolName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			cidr := azuresdkhacks.StaticCidr{
+				Properties: azuresdkhacks.StaticCidrProperties{},
+			}
+
+			if len(model.AddressPrefixes) > 0 {
+				cidr.Properties.AddressPrefixes = pointer.To(model.AddressPrefixes)
+			}
+
+			if model.NumberOfIPAddressesToAllocate != "" {
+				cidr.Properties.NumberOfIPAddressesToAllocate = pointer.To(model.NumberOfIPAddressesToAllocate)
+			}
+
+			if model.Description != "" {
+				cidr.Properties.Description = pointer.To(model.Description)
+			}
+
+			if _, err := client.Create(ctx, id, cidr); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ManagerIpamPoolStaticCidrResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.StaticCidrsClient
+
+			id, err := azuresdkhacks.ParseStaticCidrID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ManagerIpamPoolStaticCidrModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *id)
+			}
+
+			// the allocated Address Prefixes are sent back as-is, since sending both the Address Prefixes and the
+			// Number of IP Addresses to Allocate is rejected by the API
+			cidr := azuresdkhacks.StaticCidr{
+				Properties: azuresdkhacks.StaticCidrProperties{
+					AddressPrefixes: existing.Model.Properties.AddressPrefixes,
+				},
+			}
+
+			if model.Description != "" {
+				cidr.Properties.Description = pointer.To(model.Description)
+			}
+
+			if _, err := client.Create(ctx, *id, cidr); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ManagerIpamPoolStaticCidrResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.StaticCidrsClient
+
+			id, err := azuresdkhacks.ParseStaticCidrID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", *id)
+			}
+
+			properties := existing.Model.Properties
+			state := ManagerIpamPoolStaticCidrModel{
+				Name:                          id.StaticCidrName,
+				IpamPoolId:                    azuresdkhacks.NewIpamPoolID(id.SubscriptionId, id.ResourceGroupName, id.NetworkManagerName, id.IpamPoolName).ID(),
+				AddressPrefixes:               pointer.From(properties.AddressPrefixes),
+				NumberOfIPAddressesToAllocate: pointer.From(properties.NumberOfIPAddressesToAllocate),
+				Description:                   pointer.From(properties.Description),
+				TotalNumberOfIPAddresses:      pointer.From(properties.TotalNumberOfIPAddresses),
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ManagerIpamPoolStaticCidrResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.StaticCidrsClient
+
+			id, err := azuresdkhacks.ParseStaticCidrID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
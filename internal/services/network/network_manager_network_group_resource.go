@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/networkgroups"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -39,6 +40,10 @@ func (r ManagerNetworkGroupResource) IDValidationFunc() pluginsdk.SchemaValidate
 	return networkgroups.ValidateNetworkGroupID
 }
 
+func (r ManagerNetworkGroupResource) IdentityType() resourceids.ResourceId {
+	return &networkgroups.NetworkGroupId{}
+}
+
 func (r ManagerNetworkGroupResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -39,6 +39,19 @@ func TestAccNetworkManager(t *testing.T) {
 			"requiresImport": testAccNetworkManagerNetworkGroup_requiresImport,
 			"dataSource":     testAccNetworkManagerNetworkGroupDataSource_complete,
 		},
+		"IpamPool": {
+			"basic":          testAccNetworkManagerIpamPool_basic,
+			"complete":       testAccNetworkManagerIpamPool_complete,
+			"update":         testAccNetworkManagerIpamPool_update,
+			"child":          testAccNetworkManagerIpamPool_child,
+			"requiresImport": testAccNetworkManagerIpamPool_requiresImport,
+		},
+		"IpamPoolStaticCidr": {
+			"basic":               testAccNetworkManagerIpamPoolStaticCidr_basic,
+			"numberOfIPAddresses": testAccNetworkManagerIpamPoolStaticCidr_numberOfIPAddresses,
+			"update":              testAccNetworkManagerIpamPoolStaticCidr_update,
+			"requiresImport":      testAccNetworkManagerIpamPoolStaticCidr_requiresImport,
+		},
 		"SubscriptionConnection": {
 			"basic":          testAccNetworkSubscriptionNetworkManagerConnection_basic,
 			"complete":       testAccNetworkSubscriptionNetworkManagerConnection_complete,
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/scopeconnections"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -40,6 +41,10 @@ func (r ManagerScopeConnectionResource) IDValidationFunc() pluginsdk.SchemaValid
 	return scopeconnections.ValidateScopeConnectionID
 }
 
+func (r ManagerScopeConnectionResource) IdentityType() resourceids.ResourceId {
+	return &scopeconnections.ScopeConnectionId{}
+}
+
 func (r ManagerScopeConnectionResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
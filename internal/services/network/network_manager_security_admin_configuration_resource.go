@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/securityadminconfigurations"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -39,6 +40,10 @@ func (r ManagerSecurityAdminConfigurationResource) IDValidationFunc() pluginsdk.
 	return securityadminconfigurations.ValidateSecurityAdminConfigurationID
 }
 
+func (r ManagerSecurityAdminConfigurationResource) IdentityType() resourceids.ResourceId {
+	return &securityadminconfigurations.SecurityAdminConfigurationId{}
+}
+
 func (r ManagerSecurityAdminConfigurationResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
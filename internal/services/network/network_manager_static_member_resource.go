@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/staticmembers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -39,6 +40,10 @@ func (r ManagerStaticMemberResource) IDValidationFunc() pluginsdk.SchemaValidate
 	return staticmembers.ValidateStaticMemberID
 }
 
+func (r ManagerStaticMemberResource) IdentityType() resourceids.ResourceId {
+	return &staticmembers.StaticMemberId{}
+}
+
 func (r ManagerStaticMemberResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-09-01/networkmanagers"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/networkmanagerconnections"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -41,6 +42,10 @@ func (r ManagerSubscriptionConnectionResource) IDValidationFunc() pluginsdk.Sche
 	return networkmanagerconnections.ValidateNetworkManagerConnectionID
 }
 
+func (r ManagerSubscriptionConnectionResource) IdentityType() resourceids.ResourceId {
+	return &networkmanagerconnections.NetworkManagerConnectionId{}
+}
+
 func (r ManagerSubscriptionConnectionResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
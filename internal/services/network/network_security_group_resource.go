@@ -4,6 +4,7 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/networksecuritygroups"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -178,11 +180,32 @@ func resourceNetworkSecurityGroup() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 		},
+
+		ValidateRawResourceConfigFuncs: []pluginsdk.ValidateRawResourceConfigFunc{
+			resourceNetworkSecurityGroupValidateSecurityRules,
+		},
 	}
 
 	return resource
 }
 
+// resourceNetworkSecurityGroupValidateSecurityRules checks the inline Security Rules for conflicts at plan time, since
+// Azure only rejects some of these at apply time
+func resourceNetworkSecurityGroupValidateSecurityRules(_ context.Context, req pluginsdk.ValidateResourceConfigFuncRequest, resp *pluginsdk.ValidateResourceConfigFuncResponse) {
+	// the Security Rules can only be analysed once they're known, and aren't managed by this resource when omitted
+	raw := req.RawConfig.GetAttr("security_rule")
+	if raw.IsNull() || !raw.IsWhollyKnown() {
+		return
+	}
+
+	rules := make([]securityRuleConflictCandidate, 0)
+	for _, rule := range raw.AsValueSlice() {
+		rules = append(rules, securityRuleConflictCandidateFromRawConfig(rule))
+	}
+
+	resp.Diagnostics = append(resp.Diagnostics, securityRuleConflictsDiagnostics(findSecurityRuleConflicts(rules), cty.GetAttrPath("security_rule"))...)
+}
+
 func resourceNetworkSecurityGroupCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.Client.NetworkSecurityGroups
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
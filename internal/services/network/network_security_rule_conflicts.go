@@ -0,0 +1,451 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/networksecuritygroups"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type securityRuleConflictType string
+
+const (
+	// securityRuleConflictPriority is where two Security Rules share a priority and direction, which Azure rejects
+	securityRuleConflictPriority securityRuleConflictType = "Priority"
+
+	// securityRuleConflictShadowed is where all of the traffic matched by a Security Rule is matched first by another
+	securityRuleConflictShadowed securityRuleConflictType = "Shadowed"
+
+	// securityRuleConflictOverlap is where some of the traffic matched by a Security Rule is matched first by another
+	// with the opposite access
+	securityRuleConflictOverlap securityRuleConflictType = "Overlap"
+)
+
+// securityRuleConflictCandidate is the subset of a Security Rule which determines the traffic that it matches
+type securityRuleConflictCandidate struct {
+	Name      string
+	Priority  int
+	Direction string
+	Access    string
+	Protocol  string
+
+	// the Address Prefixes and Application Security Group IDs
+	SourceAddresses      []string
+	DestinationAddresses []string
+
+	SourcePortRanges      []string
+	DestinationPortRanges []string
+}
+
+type securityRuleConflict struct {
+	Type securityRuleConflictType
+
+	// Rule is the Security Rule which is conflicted, which is evaluated after Other
+	Rule  securityRuleConflictCandidate
+	Other securityRuleConflictCandidate
+}
+
+func (c securityRuleConflict) String() string {
+	switch c.Type {
+	case securityRuleConflictPriority:
+		return fmt.Sprintf("the Security Rules %q and %q both have the priority %d for %s traffic but priorities must be unique per direction", c.Other.Name, c.Rule.Name, c.Rule.Priority, c.Rule.Direction)
+
+	case securityRuleConflictShadowed:
+		return fmt.Sprintf("the Security Rule %q (priority %d) will never be matched since all of the traffic it matches is matched first by %q (priority %d), which %s it", c.Rule.Name, c.Rule.Priority, c.Other.Name, c.Other.Priority, securityRuleAccessVerb(c.Other.Access))
+	}
+
+	return fmt.Sprintf("some of the traffic matched by the Security Rule %q (priority %d) is matched first by %q (priority %d), which %s it", c.Rule.Name, c.Rule.Priority, c.Other.Name, c.Other.Priority, securityRuleAccessVerb(c.Other.Access))
+}
+
+// involves returns whether the Security Rule with the specified name is either side of the conflict
+func (c securityRuleConflict) involves(name string) bool {
+	return strings.EqualFold(c.Rule.Name, name) || strings.EqualFold(c.Other.Name, name)
+}
+
+func securityRuleAccessVerb(access string) string {
+	if strings.EqualFold(access, string(networksecuritygroups.SecurityRuleAccessDeny)) {
+		return "denies"
+	}
+	return "allows"
+}
+
+// securityRuleConflictCandidateFromConfig builds a securityRuleConflictCandidate from the schema of either an inline
+// `security_rule` block or the `azurerm_network_security_rule` resource, which share their field names
+func securityRuleConflictCandidateFromConfig(get func(key string) interface{}) securityRuleConflictCandidate {
+	values := func(single, plural string) []string {
+		output := make([]string, 0)
+		if single != "" {
+			if v, ok := get(single).(string); ok && v != "" {
+				output = append(output, v)
+			}
+		}
+		if v, ok := get(plural).(*pluginsdk.Set); ok && v != nil {
+			for _, item := range v.List() {
+				output = append(output, item.(string))
+			}
+		}
+		return output
+	}
+
+	return securityRuleConflictCandidate{
+		Name:                  get("name").(string),
+		Priority:              get("priority").(int),
+		Direction:             get("direction").(string),
+		Access:                get("access").(string),
+		Protocol:              get("protocol").(string),
+		SourceAddresses:       append(values("source_address_prefix", "source_address_prefixes"), values("", "source_application_security_group_ids")...),
+		DestinationAddresses:  append(values("destination_address_prefix", "destination_address_prefixes"), values("", "destination_application_security_group_ids")...),
+		SourcePortRanges:      values("source_port_range", "source_port_ranges"),
+		DestinationPortRanges: values("destination_port_range", "destination_port_ranges"),
+	}
+}
+
+// securityRuleConflictCandidateFromRawConfig builds a securityRuleConflictCandidate from the raw configuration of an
+// inline `security_rule` block, which must be wholly known
+func securityRuleConflictCandidateFromRawConfig(input cty.Value) securityRuleConflictCandidate {
+	return securityRuleConflictCandidateFromConfig(func(key string) interface{} {
+		v := input.GetAttr(key)
+		switch {
+		case v.Type() == cty.String:
+			if v.IsNull() {
+				return ""
+			}
+			return v.AsString()
+
+		case v.Type() == cty.Number:
+			if v.IsNull() {
+				return 0
+			}
+			i, _ := v.AsBigFloat().Int64()
+			return int(i)
+
+		case v.Type().IsSetType() && !v.IsNull():
+			items := make([]interface{}, 0)
+			for _, item := range v.AsValueSlice() {
+				if !item.IsNull() {
+					items = append(items, item.AsString())
+				}
+			}
+			return pluginsdk.NewSet(pluginsdk.HashString, items)
+		}
+
+		return nil
+	})
+}
+
+func securityRuleConflictCandidateFromModel(input networksecuritygroups.SecurityRule) securityRuleConflictCandidate {
+	output := securityRuleConflictCandidate{
+		Name: pointer.From(input.Name),
+	}
+
+	props := input.Properties
+	if props == nil {
+		return output
+	}
+
+	values := func(single *string, plural *[]string) []string {
+		out := make([]string, 0)
+		if v := pointer.From(single); v != "" {
+			out = append(out, v)
+		}
+		return append(out, pointer.From(plural)...)
+	}
+	applicationSecurityGroupIds := func(input *[]networksecuritygroups.ApplicationSecurityGroup) []string {
+		out := make([]string, 0)
+		for _, v := range pointer.From(input) {
+			out = append(out, pointer.From(v.Id))
+		}
+		return out
+	}
+
+	output.Priority = int(props.Priority)
+	output.Direction = string(props.Direction)
+	output.Access = string(props.Access)
+	output.Protocol = string(props.Protocol)
+	output.SourceAddresses = append(values(props.SourceAddressPrefix, props.SourceAddressPrefixes), applicationSecurityGroupIds(props.SourceApplicationSecurityGroups)...)
+	output.DestinationAddresses = append(values(props.DestinationAddressPrefix, props.DestinationAddressPrefixes), applicationSecurityGroupIds(props.DestinationApplicationSecurityGroups)...)
+	output.SourcePortRanges = values(props.SourcePortRange, props.SourcePortRanges)
+	output.DestinationPortRanges = values(props.DestinationPortRange, props.DestinationPortRanges)
+
+	return output
+}
+
+// findSecurityRuleConflicts evaluates the Security Rules in the order Azure does to find those which have conflicting
+// priorities, or which are shadowed or overlapped by another Security Rule with a higher priority
+func findSecurityRuleConflicts(input []securityRuleConflictCandidate) []securityRuleConflict {
+	rules := make([]securityRuleConflictCandidate, len(input))
+	copy(rules, input)
+	sort.SliceStable(rules, func(i, j int) bool {
+		return rules[i].Priority < rules[j].Priority
+	})
+
+	conflicts := make([]securityRuleConflict, 0)
+	for i, rule := range rules {
+		for _, other := range rules[:i] {
+			if other.Priority == rule.Priority && strings.EqualFold(other.Direction, rule.Direction) {
+				conflicts = append(conflicts, securityRuleConflict{
+					Type:  securityRuleConflictPriority,
+					Rule:  rule,
+					Other: other,
+				})
+			}
+		}
+	}
+
+	for i, rule := range rules {
+		for _, other := range rules[:i] {
+			if other.Priority == rule.Priority || !strings.EqualFold(other.Direction, rule.Direction) {
+				continue
+			}
+
+			if !other.analysable() || !rule.analysable() {
+				continue
+			}
+
+			if other.covers(rule) {
+				conflicts = append(conflicts, securityRuleConflict{
+					Type:  securityRuleConflictShadowed,
+					Rule:  rule,
+					Other: other,
+				})
+				// since this Security Rule is never matched there's nothing further to report for it
+				break
+			}
+
+			if !strings.EqualFold(other.Access, rule.Access) && other.overlaps(rule) {
+				conflicts = append(conflicts, securityRuleConflict{
+					Type:  securityRuleConflictOverlap,
+					Rule:  rule,
+					Other: other,
+				})
+			}
+		}
+	}
+
+	return conflicts
+}
+
+// securityRuleConflictsDiagnostics returns an error for the Security Rules which have conflicting priorities, since
+// Azure rejects these - and a warning for Security Rules which are shadowed or overlapped, since whilst these are valid
+// they're likely unintentional
+func securityRuleConflictsDiagnostics(conflicts []securityRuleConflict, path cty.Path) diag.Diagnostics {
+	diags := make(diag.Diagnostics, 0)
+	for _, conflict := range conflicts {
+		severity := diag.Warning
+		summary := "Security Rule is partially overridden by a higher priority Security Rule"
+		switch conflict.Type {
+		case securityRuleConflictPriority:
+			severity = diag.Error
+			summary = "Security Rules have conflicting priorities"
+		case securityRuleConflictShadowed:
+			summary = "Security Rule is shadowed by a higher priority Security Rule"
+		}
+
+		diags = append(diags, diag.Diagnostic{
+			Severity:      severity,
+			Summary:       summary,
+			Detail:        conflict.String(),
+			AttributePath: path,
+		})
+	}
+
+	return diags
+}
+
+// securityRuleConflictsError returns an error for the Security Rules which have conflicting priorities
+func securityRuleConflictsError(conflicts []securityRuleConflict) error {
+	var err *multierror.Error
+	for _, conflict := range conflicts {
+		if conflict.Type == securityRuleConflictPriority {
+			err = multierror.Append(err, fmt.Errorf("%s", conflict))
+		}
+	}
+
+	return err.ErrorOrNil()
+}
+
+// analysable returns whether all of the values which determine the traffic matched by the Security Rule are known and
+// can be parsed
+func (r securityRuleConflictCandidate) analysable() bool {
+	if r.Protocol == "" || len(r.SourceAddresses) == 0 || len(r.DestinationAddresses) == 0 {
+		return false
+	}
+
+	for _, v := range [][]string{r.SourcePortRanges, r.DestinationPortRanges} {
+		if _, ok := parseSecurityRulePortRanges(v); !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// covers returns whether all of the traffic matched by other is also matched by this Security Rule
+func (r securityRuleConflictCandidate) covers(other securityRuleConflictCandidate) bool {
+	if r.Protocol != "*" && !strings.EqualFold(r.Protocol, other.Protocol) {
+		return false
+	}
+
+	return securityRuleAddressesCover(r.SourceAddresses, other.SourceAddresses) &&
+		securityRuleAddressesCover(r.DestinationAddresses, other.DestinationAddresses) &&
+		securityRulePortRangesCover(r.SourcePortRanges, other.SourcePortRanges) &&
+		securityRulePortRangesCover(r.DestinationPortRanges, other.DestinationPortRanges)
+}
+
+// overlaps returns whether any of the traffic matched by other is also matched by this Security Rule
+func (r securityRuleConflictCandidate) overlaps(other securityRuleConflictCandidate) bool {
+	if r.Protocol != "*" && other.Protocol != "*" && !strings.EqualFold(r.Protocol, other.Protocol) {
+		return false
+	}
+
+	return securityRuleAddressesOverlap(r.SourceAddresses, other.SourceAddresses) &&
+		securityRuleAddressesOverlap(r.DestinationAddresses, other.DestinationAddresses) &&
+		securityRulePortRangesOverlap(r.SourcePortRanges, other.SourcePortRanges) &&
+		securityRulePortRangesOverlap(r.DestinationPortRanges, other.DestinationPortRanges)
+}
+
+// securityRuleAddressesCover returns whether each of the inner addresses is contained within one of the outer
+// addresses. Service Tags and Application Security Groups can only be compared by name, since the addresses
+// they contain aren't known
+func securityRuleAddressesCover(outer, inner []string) bool {
+	for _, i := range inner {
+		covered := false
+		for _, o := range outer {
+			if o == "*" || strings.EqualFold(o, i) {
+				covered = true
+				break
+			}
+
+			outerNetwork, ok := validate.ParseAddressPrefix(o)
+			if !ok {
+				continue
+			}
+			innerNetwork, ok := validate.ParseAddressPrefix(i)
+			if !ok {
+				continue
+			}
+
+			outerOnes, outerBits := outerNetwork.Mask.Size()
+			innerOnes, innerBits := innerNetwork.Mask.Size()
+			if outerBits == innerBits && outerOnes <= innerOnes && outerNetwork.Contains(innerNetwork.IP) {
+				covered = true
+				break
+			}
+		}
+
+		if !covered {
+			return false
+		}
+	}
+
+	return true
+}
+
+// securityRuleAddressesOverlap returns whether any of the addresses in a and b overlap
+func securityRuleAddressesOverlap(a, b []string) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if x == "*" || y == "*" || strings.EqualFold(x, y) {
+				return true
+			}
+
+			xNetwork, ok := validate.ParseAddressPrefix(x)
+			if !ok {
+				continue
+			}
+			yNetwork, ok := validate.ParseAddressPrefix(y)
+			if !ok {
+				continue
+			}
+
+			if xNetwork.Contains(yNetwork.IP) || yNetwork.Contains(xNetwork.IP) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+type securityRulePortRange struct {
+	start int
+	end   int
+}
+
+func parseSecurityRulePortRanges(input []string) ([]securityRulePortRange, bool) {
+	if len(input) == 0 {
+		return nil, false
+	}
+
+	output := make([]securityRulePortRange, 0, len(input))
+	for _, v := range input {
+		start, end, err := validate.ParsePortRange(v)
+		if err != nil {
+			return nil, false
+		}
+		output = append(output, securityRulePortRange{start: start, end: end})
+	}
+
+	return output, true
+}
+
+// securityRulePortRangesCover returns whether each of the inner port ranges is contained within the outer port
+// ranges, which are merged first since a range can be covered by several adjoining ranges
+func securityRulePortRangesCover(outer, inner []string) bool {
+	outerRanges, _ := parseSecurityRulePortRanges(outer)
+	innerRanges, _ := parseSecurityRulePortRanges(inner)
+
+	sort.Slice(outerRanges, func(i, j int) bool {
+		return outerRanges[i].start < outerRanges[j].start
+	})
+	merged := make([]securityRulePortRange, 0)
+	for _, v := range outerRanges {
+		if last := len(merged) - 1; last >= 0 && v.start <= merged[last].end+1 {
+			merged[last].end = max(merged[last].end, v.end)
+			continue
+		}
+		merged = append(merged, v)
+	}
+
+	for _, i := range innerRanges {
+		covered := false
+		for _, o := range merged {
+			if o.start <= i.start && i.end <= o.end {
+				covered = true
+				break
+			}
+		}
+
+		if !covered {
+			return false
+		}
+	}
+
+	return true
+}
+
+// securityRulePortRangesOverlap returns whether any of the port ranges in a and b overlap
+func securityRulePortRangesOverlap(a, b []string) bool {
+	aRanges, _ := parseSecurityRulePortRanges(a)
+	bRanges, _ := parseSecurityRulePortRanges(b)
+
+	for _, x := range aRanges {
+		for _, y := range bRanges {
+			if x.start <= y.end && y.start <= x.end {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestFindSecurityRuleConflicts(t *testing.T) {
+	rule := func(name string, priority int, direction, access, protocol, source, sourcePorts, destination, destinationPorts string) securityRuleConflictCandidate {
+		return securityRuleConflictCandidate{
+			Name:                  name,
+			Priority:              priority,
+			Direction:             direction,
+			Access:                access,
+			Protocol:              protocol,
+			SourceAddresses:       []string{source},
+			SourcePortRanges:      []string{sourcePorts},
+			DestinationAddresses:  []string{destination},
+			DestinationPortRanges: []string{destinationPorts},
+		}
+	}
+
+	testData := []struct {
+		Name     string
+		Input    []securityRuleConflictCandidate
+		Expected []securityRuleConflict
+	}{
+		{
+			Name: "No Conflicts",
+			Input: []securityRuleConflictCandidate{
+				rule("https", 100, "Inbound", "Allow", "Tcp", "*", "*", "10.0.1.0/24", "443"),
+				rule("ssh", 110, "Inbound", "Allow", "Tcp", "10.0.0.0/24", "*", "10.0.1.0/24", "22"),
+				rule("deny", 4096, "Outbound", "Deny", "*", "*", "*", "*", "*"),
+			},
+			Expected: []securityRuleConflict{},
+		},
+		{
+			Name: "Duplicate Priority",
+			Input: []securityRuleConflictCandidate{
+				rule("https", 100, "Inbound", "Allow", "Tcp", "*", "*", "10.0.1.0/24", "443"),
+				rule("ssh", 100, "Inbound", "Allow", "Tcp", "10.0.0.0/24", "*", "10.0.1.0/24", "22"),
+			},
+			Expected: []securityRuleConflict{
+				{Type: securityRuleConflictPriority, Rule: securityRuleConflictCandidate{Name: "ssh"}, Other: securityRuleConflictCandidate{Name: "https"}},
+			},
+		},
+		{
+			Name: "Duplicate Priority In Different Directions",
+			Input: []securityRuleConflictCandidate{
+				rule("https", 100, "Inbound", "Allow", "Tcp", "*", "*", "10.0.1.0/24", "443"),
+				rule("ssh", 100, "Outbound", "Allow", "Tcp", "10.0.0.0/24", "*", "10.0.1.0/24", "22"),
+			},
+			Expected: []securityRuleConflict{},
+		},
+		{
+			Name: "Shadowed By Broader Deny",
+			Input: []securityRuleConflictCandidate{
+				rule("allow-ssh", 200, "Inbound", "Allow", "Tcp", "10.0.0.4", "*", "10.0.1.0/24", "22"),
+				rule("deny-management", 100, "Inbound", "Deny", "*", "10.0.0.0/16", "*", "*", "20-23"),
+			},
+			Expected: []securityRuleConflict{
+				{Type: securityRuleConflictShadowed, Rule: securityRuleConflictCandidate{Name: "allow-ssh"}, Other: securityRuleConflictCandidate{Name: "deny-management"}},
+			},
+		},
+		{
+			Name: "Shadowed By Adjoining Port Ranges",
+			Input: []securityRuleConflictCandidate{
+				{
+					Name:                  "web",
+					Priority:              100,
+					Direction:             "Inbound",
+					Access:                "Allow",
+					Protocol:              "Tcp",
+					SourceAddresses:       []string{"*"},
+					SourcePortRanges:      []string{"*"},
+					DestinationAddresses:  []string{"10.0.1.0/24", "10.0.2.0/24"},
+					DestinationPortRanges: []string{"80-443", "444-8080"},
+				},
+				rule("https-range", 110, "Inbound", "Allow", "Tcp", "*", "*", "10.0.2.4", "440-450"),
+			},
+			Expected: []securityRuleConflict{
+				{Type: securityRuleConflictShadowed, Rule: securityRuleConflictCandidate{Name: "https-range"}, Other: securityRuleConflictCandidate{Name: "web"}},
+			},
+		},
+		{
+			Name: "Service Tags Are Compared By Name",
+			Input: []securityRuleConflictCandidate{
+				rule("internet", 100, "Outbound", "Deny", "*", "*", "*", "Internet", "*"),
+				rule("google-dns", 110, "Outbound", "Allow", "Udp", "*", "*", "8.8.8.8", "53"),
+				rule("internet-https", 120, "Outbound", "Allow", "Tcp", "*", "*", "internet", "443"),
+			},
+			Expected: []securityRuleConflict{
+				{Type: securityRuleConflictShadowed, Rule: securityRuleConflictCandidate{Name: "internet-https"}, Other: securityRuleConflictCandidate{Name: "internet"}},
+			},
+		},
+		{
+			Name: "Partial Overlap With Opposite Access",
+			Input: []securityRuleConflictCandidate{
+				rule("deny-subnet", 100, "Inbound", "Deny", "Tcp", "10.0.0.0/25", "*", "*", "*"),
+				rule("allow-vnet", 110, "Inbound", "Allow", "Tcp", "10.0.0.0/16", "*", "*", "443"),
+				rule("deny-vnet-udp", 120, "Inbound", "Deny", "Udp", "10.0.0.0/16", "*", "*", "*"),
+			},
+			Expected: []securityRuleConflict{
+				{Type: securityRuleConflictOverlap, Rule: securityRuleConflictCandidate{Name: "allow-vnet"}, Other: securityRuleConflictCandidate{Name: "deny-subnet"}},
+			},
+		},
+		{
+			Name: "Unparseable Ports Are Skipped",
+			Input: []securityRuleConflictCandidate{
+				rule("all", 100, "Inbound", "Allow", "*", "*", "*", "*", "*"),
+				rule("invalid", 110, "Inbound", "Allow", "Tcp", "*", "*", "*", "http"),
+			},
+			Expected: []securityRuleConflict{},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := findSecurityRuleConflicts(v.Input)
+		if len(actual) != len(v.Expected) {
+			t.Fatalf("expected %d conflicts but got %d: %+v", len(v.Expected), len(actual), actual)
+		}
+
+		for i, expected := range v.Expected {
+			if actual[i].Type != expected.Type || actual[i].Rule.Name != expected.Rule.Name || actual[i].Other.Name != expected.Other.Name {
+				t.Fatalf("expected conflict %d to be %s between %q and %q but got %s between %q and %q", i, expected.Type, expected.Rule.Name, expected.Other.Name, actual[i].Type, actual[i].Rule.Name, actual[i].Other.Name)
+			}
+		}
+	}
+}
+
+func TestNetworkSecurityGroupValidateSecurityRules(t *testing.T) {
+	configType := resourceNetworkSecurityGroup().CoreConfigSchema().ImpliedType()
+	ruleType := configType.AttributeType("security_rule").ElementType()
+
+	rule := func(name string, priority int, access, destinationPorts string) cty.Value {
+		values := make(map[string]cty.Value)
+		for k, v := range ruleType.AttributeTypes() {
+			values[k] = cty.NullVal(v)
+		}
+		values["name"] = cty.StringVal(name)
+		values["priority"] = cty.NumberIntVal(int64(priority))
+		values["direction"] = cty.StringVal("Inbound")
+		values["access"] = cty.StringVal(access)
+		values["protocol"] = cty.StringVal("Tcp")
+		values["source_port_range"] = cty.StringVal("*")
+		values["destination_port_range"] = cty.StringVal(destinationPorts)
+		values["source_address_prefix"] = cty.StringVal("*")
+		values["destination_address_prefix"] = cty.StringVal("*")
+		return cty.ObjectVal(values)
+	}
+	config := func(securityRules cty.Value) cty.Value {
+		values := make(map[string]cty.Value)
+		for k, v := range configType.AttributeTypes() {
+			values[k] = cty.NullVal(v)
+		}
+		values["security_rule"] = securityRules
+		return cty.ObjectVal(values)
+	}
+
+	testData := []struct {
+		Name     string
+		Input    cty.Value
+		Expected []diag.Severity
+	}{
+		{
+			Name:     "Omitted",
+			Input:    config(cty.NullVal(configType.AttributeType("security_rule"))),
+			Expected: []diag.Severity{},
+		},
+		{
+			Name:     "Unknown",
+			Input:    config(cty.UnknownVal(configType.AttributeType("security_rule"))),
+			Expected: []diag.Severity{},
+		},
+		{
+			Name: "No Conflicts",
+			Input: config(cty.SetVal([]cty.Value{
+				rule("https", 100, "Allow", "443"),
+				rule("ssh", 110, "Allow", "22"),
+			})),
+			Expected: []diag.Severity{},
+		},
+		{
+			Name: "Duplicate Priority Is An Error",
+			Input: config(cty.SetVal([]cty.Value{
+				rule("https", 100, "Allow", "443"),
+				rule("ssh", 100, "Allow", "22"),
+			})),
+			Expected: []diag.Severity{diag.Error},
+		},
+		{
+			Name: "Shadowed Is A Warning",
+			Input: config(cty.SetVal([]cty.Value{
+				rule("deny-all", 100, "Deny", "*"),
+				rule("https", 110, "Allow", "443"),
+			})),
+			Expected: []diag.Severity{diag.Warning},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		resp := &pluginsdk.ValidateResourceConfigFuncResponse{}
+		resourceNetworkSecurityGroupValidateSecurityRules(context.Background(), pluginsdk.ValidateResourceConfigFuncRequest{RawConfig: v.Input}, resp)
+		if len(resp.Diagnostics) != len(v.Expected) {
+			t.Fatalf("expected %d diagnostics but got %d: %+v", len(v.Expected), len(resp.Diagnostics), resp.Diagnostics)
+		}
+
+		for i, expected := range v.Expected {
+			if resp.Diagnostics[i].Severity != expected {
+				t.Fatalf("expected diagnostic %d to have the severity %d but got %d: %+v", i, expected, resp.Diagnostics[i].Severity, resp.Diagnostics[i])
+			}
+		}
+	}
+}
@@ -4,6 +4,7 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/networksecuritygroups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/securityrules"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -173,6 +175,39 @@ func resourceNetworkSecurityRule() *pluginsdk.Resource {
 	}
 }
 
+// checkNetworkSecurityRulePriority checks the Security Rule for a conflicting priority with the other Security Rules in
+// the Network Security Group, whether they're defined inline or as other `azurerm_network_security_rule` resources -
+// since these are only known once the Network Security Group exists this is checked at apply time
+func checkNetworkSecurityRulePriority(ctx context.Context, client *networksecuritygroups.NetworkSecurityGroupsClient, id securityrules.SecurityRuleId, rule securityRuleConflictCandidate) error {
+	networkSecurityGroupId := networksecuritygroups.NewNetworkSecurityGroupID(id.SubscriptionId, id.ResourceGroupName, id.NetworkSecurityGroupName)
+	existing, err := client.Get(ctx, networkSecurityGroupId, networksecuritygroups.DefaultGetOperationOptions())
+	if err != nil {
+		return fmt.Errorf("retrieving %s to check for conflicting Security Rules: %+v", networkSecurityGroupId, err)
+	}
+
+	rules := []securityRuleConflictCandidate{
+		rule,
+	}
+	if model := existing.Model; model != nil && model.Properties != nil && model.Properties.SecurityRules != nil {
+		for _, other := range *model.Properties.SecurityRules {
+			// this Security Rule is superseded by the values being applied
+			if strings.EqualFold(pointer.From(other.Name), id.SecurityRuleName) {
+				continue
+			}
+			rules = append(rules, securityRuleConflictCandidateFromModel(other))
+		}
+	}
+
+	conflicts := make([]securityRuleConflict, 0)
+	for _, conflict := range findSecurityRuleConflicts(rules) {
+		if conflict.involves(id.SecurityRuleName) {
+			conflicts = append(conflicts, conflict)
+		}
+	}
+
+	return securityRuleConflictsError(conflicts)
+}
+
 func resourceNetworkSecurityRuleCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.SecurityRules
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
@@ -192,6 +227,10 @@ func resourceNetworkSecurityRuleCreate(d *pluginsdk.ResourceData, meta interface
 		return tf.ImportAsExistsError("azurerm_network_security_rule", id.ID())
 	}
 
+	if err := checkNetworkSecurityRulePriority(ctx, meta.(*clients.Client).Network.Client.NetworkSecurityGroups, id, securityRuleConflictCandidateFromConfig(d.Get)); err != nil {
+		return err
+	}
+
 	rule := securityrules.SecurityRule{
 		Name: &id.SecurityRuleName,
 		Properties: &securityrules.SecurityRulePropertiesFormat{
@@ -306,6 +345,12 @@ func resourceNetworkSecurityRuleUpdate(d *pluginsdk.ResourceData, meta interface
 
 	payload := existing.Model
 
+	if d.HasChanges("priority", "direction") {
+		if err := checkNetworkSecurityRulePriority(ctx, meta.(*clients.Client).Network.Client.NetworkSecurityGroups, *id, securityRuleConflictCandidateFromConfig(d.Get)); err != nil {
+			return err
+		}
+	}
+
 	if d.HasChange("description") {
 		payload.Properties.Description = pointer.To(d.Get("description").(string))
 	}
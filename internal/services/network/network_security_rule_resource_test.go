@@ -6,6 +6,7 @@ package network_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/securityrules"
@@ -111,6 +112,24 @@ func TestAccNetworkSecurityRule_applicationSecurityGroups(t *testing.T) {
 	})
 }
 
+func TestAccNetworkSecurityRule_duplicatePriority(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_security_rule", "test")
+	r := NetworkSecurityRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.duplicatePriority(data),
+			ExpectError: regexp.MustCompile("both have the priority 100 for Outbound traffic"),
+		},
+	})
+}
+
 func (t NetworkSecurityRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := securityrules.ParseSecurityRuleID(state.ID)
 	if err != nil {
@@ -171,6 +190,26 @@ resource "azurerm_network_security_rule" "test" {
 `, data.RandomInteger, data.Locations.Primary)
 }
 
+func (r NetworkSecurityRuleResource) duplicatePriority(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_security_rule" "duplicate" {
+  name                        = "test456"
+  network_security_group_name = azurerm_network_security_group.test.name
+  resource_group_name         = azurerm_resource_group.test.name
+  priority                    = 100
+  direction                   = "Outbound"
+  access                      = "Deny"
+  protocol                    = "Udp"
+  source_port_range           = "*"
+  destination_port_range      = "53"
+  source_address_prefix       = "*"
+  destination_address_prefix  = "*"
+}
+`, r.basic(data))
+}
+
 func (r NetworkSecurityRuleResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+)
+
+// networkWatcherDiagnosticResult waits for an on-demand diagnostic operation (such as IP Flow Verify or the Effective
+// Routes of a Network Interface) to complete and unmarshals the result into model, since the generated methods for
+// these long-running operations don't expose the result
+func networkWatcherDiagnosticResult(ctx context.Context, httpResponse *http.Response, poller *pollers.Poller, model interface{}) error {
+	if httpResponse != nil && httpResponse.StatusCode == http.StatusOK {
+		// the result was returned without a long-running operation
+		resp := client.Response{Response: httpResponse}
+		return resp.Unmarshal(model)
+	}
+
+	if err := poller.PollUntilDone(ctx); err != nil {
+		return err
+	}
+
+	latestResponse := poller.LatestResponse()
+	if latestResponse == nil {
+		return fmt.Errorf("the final response was nil")
+	}
+
+	return latestResponse.Unmarshal(model)
+}
@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type NetworkWatcherDiagnosticsDataSource struct{}
+
+func testAccDataSourceNetworkWatcherIPFlowVerify_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_watcher_ip_flow_verify", "test")
+	d := NetworkWatcherDiagnosticsDataSource{}
+
+	data.DataSourceTestInSequence(t, []acceptance.TestStep{
+		{
+			Config: d.ipFlowVerify(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("access").HasValue("Allow"),
+				check.That(data.ResourceName).Key("rule_name").Exists(),
+				check.That("data.azurerm_network_watcher_ip_flow_verify.denied").Key("access").HasValue("Deny"),
+			),
+		},
+	})
+}
+
+func testAccDataSourceNetworkWatcherNextHop_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_watcher_next_hop", "test")
+	d := NetworkWatcherDiagnosticsDataSource{}
+
+	data.DataSourceTestInSequence(t, []acceptance.TestStep{
+		{
+			Config: d.nextHop(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("next_hop_type").HasValue("Internet"),
+			),
+		},
+	})
+}
+
+func testAccDataSourceNetworkInterfaceEffectiveRoutes_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_interface_effective_routes", "test")
+	d := NetworkWatcherDiagnosticsDataSource{}
+
+	data.DataSourceTestInSequence(t, []acceptance.TestStep{
+		{
+			Config: d.effectiveRoutes(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("route.0.source").HasValue("Default"),
+			),
+		},
+	})
+}
+
+func testAccDataSourceNetworkInterfaceEffectiveSecurityRules_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_interface_effective_security_rules", "test")
+	d := NetworkWatcherDiagnosticsDataSource{}
+
+	data.DataSourceTestInSequence(t, []acceptance.TestStep{
+		{
+			Config: d.effectiveSecurityRules(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("network_security_group.#").HasValue("1"),
+				check.That(data.ResourceName).Key("network_security_group.0.subnet_id").Exists(),
+				check.That(data.ResourceName).Key("network_security_group.0.security_rule.0.name").Exists(),
+			),
+		},
+	})
+}
+
+func (d NetworkWatcherDiagnosticsDataSource) ipFlowVerify(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_watcher_ip_flow_verify" "test" {
+  network_watcher_id = azurerm_network_watcher.test.id
+  target_resource_id = azurerm_virtual_machine.src.id
+  direction          = "Outbound"
+  protocol           = "TCP"
+  local_ip_address   = azurerm_network_interface.src.private_ip_address
+  local_port         = "*"
+  remote_ip_address  = "13.107.21.200"
+  remote_port        = "443"
+
+  depends_on = [azurerm_virtual_machine_extension.src, azurerm_subnet_network_security_group_association.test]
+}
+
+data "azurerm_network_watcher_ip_flow_verify" "denied" {
+  network_watcher_id = azurerm_network_watcher.test.id
+  target_resource_id = azurerm_virtual_machine.src.id
+  direction          = "Outbound"
+  protocol           = "TCP"
+  local_ip_address   = azurerm_network_interface.src.private_ip_address
+  local_port         = "*"
+  remote_ip_address  = "13.107.21.200"
+  remote_port        = "25"
+
+  depends_on = [azurerm_virtual_machine_extension.src, azurerm_subnet_network_security_group_association.test]
+}
+`, d.template(data))
+}
+
+func (d NetworkWatcherDiagnosticsDataSource) nextHop(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_watcher_next_hop" "test" {
+  network_watcher_id          = azurerm_network_watcher.test.id
+  target_resource_id          = azurerm_virtual_machine.src.id
+  target_network_interface_id = azurerm_network_interface.src.id
+  source_ip_address           = azurerm_network_interface.src.private_ip_address
+  destination_ip_address      = "13.107.21.200"
+
+  depends_on = [azurerm_virtual_machine_extension.src]
+}
+`, d.template(data))
+}
+
+func (d NetworkWatcherDiagnosticsDataSource) effectiveRoutes(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_interface_effective_routes" "test" {
+  network_interface_id = azurerm_network_interface.src.id
+
+  depends_on = [azurerm_virtual_machine.src]
+}
+`, d.template(data))
+}
+
+func (d NetworkWatcherDiagnosticsDataSource) effectiveSecurityRules(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_interface_effective_security_rules" "test" {
+  network_interface_id = azurerm_network_interface.src.id
+
+  depends_on = [azurerm_virtual_machine.src, azurerm_subnet_network_security_group_association.test]
+}
+`, d.template(data))
+}
+
+func (NetworkWatcherDiagnosticsDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_security_group" "test" {
+  name                = "acctestnsg-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  security_rule {
+    name                       = "DenySMTPOutbound"
+    priority                   = 100
+    direction                  = "Outbound"
+    access                     = "Deny"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_range     = "25"
+    source_address_prefix      = "*"
+    destination_address_prefix = "*"
+  }
+}
+
+resource "azurerm_subnet_network_security_group_association" "test" {
+  subnet_id                 = azurerm_subnet.test.id
+  network_security_group_id = azurerm_network_security_group.test.id
+}
+`, NetworkConnectionMonitorResource{}.baseConfig(data), data.RandomInteger)
+}
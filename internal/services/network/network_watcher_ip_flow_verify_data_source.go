@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/networkwatchers"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type NetworkWatcherIPFlowVerifyModel struct {
+	NetworkWatcherId         string `tfschema:"network_watcher_id"`
+	TargetResourceId         string `tfschema:"target_resource_id"`
+	TargetNetworkInterfaceId string `tfschema:"target_network_interface_id"`
+	Direction                string `tfschema:"direction"`
+	Protocol                 string `tfschema:"protocol"`
+	LocalIPAddress           string `tfschema:"local_ip_address"`
+	LocalPort                string `tfschema:"local_port"`
+	RemoteIPAddress          string `tfschema:"remote_ip_address"`
+	RemotePort               string `tfschema:"remote_port"`
+	Access                   string `tfschema:"access"`
+	RuleName                 string `tfschema:"rule_name"`
+}
+
+type NetworkWatcherIPFlowVerifyDataSource struct{}
+
+var _ sdk.DataSource = NetworkWatcherIPFlowVerifyDataSource{}
+
+func (r NetworkWatcherIPFlowVerifyDataSource) ResourceType() string {
+	return "azurerm_network_watcher_ip_flow_verify"
+}
+
+func (r NetworkWatcherIPFlowVerifyDataSource) ModelObject() interface{} {
+	return &NetworkWatcherIPFlowVerifyModel{}
+}
+
+func (r NetworkWatcherIPFlowVerifyDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"network_watcher_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: networkwatchers.ValidateNetworkWatcherID,
+		},
+
+		"target_resource_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+
+		"target_network_interface_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: commonids.ValidateNetworkInterfaceID,
+		},
+
+		"direction": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(networkwatchers.PossibleValuesForDirection(), false),
+		},
+
+		"protocol": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(networkwatchers.PossibleValuesForIPFlowProtocol(), false),
+		},
+
+		"local_ip_address": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsIPAddress,
+		},
+
+		"local_port": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validateNetworkWatcherIPFlowVerifyPort,
+		},
+
+		"remote_ip_address": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsIPAddress,
+		},
+
+		"remote_port": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validateNetworkWatcherIPFlowVerifyPort,
+		},
+	}
+}
+
+func (r NetworkWatcherIPFlowVerifyDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"access": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"rule_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r NetworkWatcherIPFlowVerifyDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkWatchers
+
+			var model NetworkWatcherIPFlowVerifyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := networkwatchers.ParseNetworkWatcherID(model.NetworkWatcherId)
+			if err != nil {
+				return err
+			}
+
+			parameters := networkwatchers.VerificationIPFlowParameters{
+				Direction:        networkwatchers.Direction(model.Direction),
+				Protocol:         networkwatchers.IPFlowProtocol(model.Protocol),
+				LocalIPAddress:   model.LocalIPAddress,
+				LocalPort:        model.LocalPort,
+				RemoteIPAddress:  model.RemoteIPAddress,
+				RemotePort:       model.RemotePort,
+				TargetResourceId: model.TargetResourceId,
+			}
+			if model.TargetNetworkInterfaceId != "" {
+				parameters.TargetNicResourceId = pointer.To(model.TargetNetworkInterfaceId)
+			}
+
+			resp, err := client.VerifyIPFlow(ctx, *id, parameters)
+			if err != nil {
+				return fmt.Errorf("verifying IP Flow for %q using %s: %+v", model.TargetResourceId, id, err)
+			}
+
+			var result networkwatchers.VerificationIPFlowResult
+			if err := networkWatcherDiagnosticResult(ctx, resp.HttpResponse, &resp.Poller, &result); err != nil {
+				return fmt.Errorf("waiting for the IP Flow for %q to be verified using %s: %+v", model.TargetResourceId, id, err)
+			}
+
+			model.Access = string(pointer.From(result.Access))
+			model.RuleName = pointer.From(result.RuleName)
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+// validateNetworkWatcherIPFlowVerifyPort validates a single port, or `*` which is supported for the source port
+func validateNetworkWatcherIPFlowVerifyPort(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if v == "*" {
+		return
+	}
+
+	port, err := strconv.Atoi(v)
+	if err != nil || !regexp.MustCompile(`^[0-9]+$`).MatchString(v) || 65535 < port {
+		errors = append(errors, fmt.Errorf("%q must be a port number between `0` and `65535` or `*` but got %q", k, v))
+	}
+
+	return
+}
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/networkwatchers"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type NetworkWatcherNextHopModel struct {
+	NetworkWatcherId         string `tfschema:"network_watcher_id"`
+	TargetResourceId         string `tfschema:"target_resource_id"`
+	TargetNetworkInterfaceId string `tfschema:"target_network_interface_id"`
+	SourceIPAddress          string `tfschema:"source_ip_address"`
+	DestinationIPAddress     string `tfschema:"destination_ip_address"`
+	NextHopType              string `tfschema:"next_hop_type"`
+	NextHopIPAddress         string `tfschema:"next_hop_ip_address"`
+	RouteTableId             string `tfschema:"route_table_id"`
+}
+
+type NetworkWatcherNextHopDataSource struct{}
+
+var _ sdk.DataSource = NetworkWatcherNextHopDataSource{}
+
+func (r NetworkWatcherNextHopDataSource) ResourceType() string {
+	return "azurerm_network_watcher_next_hop"
+}
+
+func (r NetworkWatcherNextHopDataSource) ModelObject() interface{} {
+	return &NetworkWatcherNextHopModel{}
+}
+
+func (r NetworkWatcherNextHopDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"network_watcher_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: networkwatchers.ValidateNetworkWatcherID,
+		},
+
+		"target_resource_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+
+		"target_network_interface_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: commonids.ValidateNetworkInterfaceID,
+		},
+
+		"source_ip_address": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsIPAddress,
+		},
+
+		"destination_ip_address": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsIPAddress,
+		},
+	}
+}
+
+func (r NetworkWatcherNextHopDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"next_hop_type": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"next_hop_ip_address": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"route_table_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r NetworkWatcherNextHopDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkWatchers
+
+			var model NetworkWatcherNextHopModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := networkwatchers.ParseNetworkWatcherID(model.NetworkWatcherId)
+			if err != nil {
+				return err
+			}
+
+			parameters := networkwatchers.NextHopParameters{
+				SourceIPAddress:      model.SourceIPAddress,
+				DestinationIPAddress: model.DestinationIPAddress,
+				TargetResourceId:     model.TargetResourceId,
+			}
+			if model.TargetNetworkInterfaceId != "" {
+				parameters.TargetNicResourceId = pointer.To(model.TargetNetworkInterfaceId)
+			}
+
+			resp, err := client.GetNextHop(ctx, *id, parameters)
+			if err != nil {
+				return fmt.Errorf("retrieving the Next Hop for %q using %s: %+v", model.TargetResourceId, id, err)
+			}
+
+			var result networkwatchers.NextHopResult
+			if err := networkWatcherDiagnosticResult(ctx, resp.HttpResponse, &resp.Poller, &result); err != nil {
+				return fmt.Errorf("waiting for the Next Hop for %q using %s: %+v", model.TargetResourceId, id, err)
+			}
+
+			model.NextHopType = string(pointer.From(result.NextHopType))
+			model.NextHopIPAddress = pointer.From(result.NextHopIPAddress)
+			model.RouteTableId = pointer.From(result.RouteTableId)
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&model)
+		},
+	}
+}
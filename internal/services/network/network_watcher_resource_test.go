@@ -38,6 +38,12 @@ func TestAccNetworkWatcher(t *testing.T) {
 		"DataSource": {
 			"basic": testAccDataSourceNetworkWatcher_basic,
 		},
+		"Diagnostics": {
+			"ipFlowVerify":           testAccDataSourceNetworkWatcherIPFlowVerify_basic,
+			"nextHop":                testAccDataSourceNetworkWatcherNextHop_basic,
+			"effectiveRoutes":        testAccDataSourceNetworkInterfaceEffectiveRoutes_basic,
+			"effectiveSecurityRules": testAccDataSourceNetworkInterfaceEffectiveSecurityRules_basic,
+		},
 		"ConnectionMonitor": {
 			"addressBasic":                   testAccNetworkConnectionMonitor_addressBasic,
 			"addressComplete":                testAccNetworkConnectionMonitor_addressComplete,
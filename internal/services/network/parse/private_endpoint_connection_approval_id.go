@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = PrivateEndpointConnectionApprovalId{}
+
+const privateEndpointConnectionsSegment = "/privateEndpointConnections/"
+
+// PrivateEndpointConnectionApprovalId is the ID of a Private Endpoint Connection to a Resource which supports
+// Private Link (for example a Storage Account or a Private Link Service), which is a child of that Resource
+type PrivateEndpointConnectionApprovalId struct {
+	TargetResourceId string
+	Name             string
+}
+
+func (p PrivateEndpointConnectionApprovalId) ID() string {
+	return fmt.Sprintf("%s%s%s", p.TargetResourceId, privateEndpointConnectionsSegment, p.Name)
+}
+
+func (p PrivateEndpointConnectionApprovalId) String() string {
+	components := []string{
+		fmt.Sprintf("Target Resource %s", p.TargetResourceId),
+		fmt.Sprintf("Private Endpoint Connection Name %q", p.Name),
+	}
+	return fmt.Sprintf("Private Endpoint Connection: %s", strings.Join(components, " / "))
+}
+
+// ProviderNamespace returns the Resource Provider of the Target Resource, for example `Microsoft.Storage`
+func (p PrivateEndpointConnectionApprovalId) ProviderNamespace() string {
+	namespace, _ := p.providerSegments()
+	return namespace
+}
+
+// ResourceType returns the Resource Type of the Private Endpoint Connection within the Resource Provider,
+// for example `storageAccounts/privateEndpointConnections`
+func (p PrivateEndpointConnectionApprovalId) ResourceType() string {
+	_, resourceTypes := p.providerSegments()
+	return strings.Join(append(resourceTypes, "privateEndpointConnections"), "/")
+}
+
+func (p PrivateEndpointConnectionApprovalId) providerSegments() (string, []string) {
+	index := strings.LastIndex(strings.ToLower(p.TargetResourceId), "/providers/")
+	if index == -1 {
+		return "", nil
+	}
+
+	segments := strings.Split(strings.Trim(p.TargetResourceId[index+len("/providers/"):], "/"), "/")
+	resourceTypes := make([]string, 0)
+	for i := 1; i < len(segments); i += 2 {
+		resourceTypes = append(resourceTypes, segments[i])
+	}
+
+	return segments[0], resourceTypes
+}
+
+func NewPrivateEndpointConnectionApprovalId(targetResourceId string, name string) PrivateEndpointConnectionApprovalId {
+	return PrivateEndpointConnectionApprovalId{
+		TargetResourceId: targetResourceId,
+		Name:             name,
+	}
+}
+
+func PrivateEndpointConnectionApprovalID(input string) (*PrivateEndpointConnectionApprovalId, error) {
+	index := strings.LastIndex(strings.ToLower(input), strings.ToLower(privateEndpointConnectionsSegment))
+	if index == -1 {
+		return nil, fmt.Errorf("expected ID to be in the format {TargetResourceId}/privateEndpointConnections/{Name} but got %q", input)
+	}
+
+	id := PrivateEndpointConnectionApprovalId{
+		TargetResourceId: input[:index],
+		Name:             input[index+len(privateEndpointConnectionsSegment):],
+	}
+	if id.Name == "" || strings.Contains(id.Name, "/") {
+		return nil, fmt.Errorf("expected ID to be in the format {TargetResourceId}/privateEndpointConnections/{Name} but got %q", input)
+	}
+
+	if !strings.HasPrefix(strings.ToLower(id.TargetResourceId), "/subscriptions/") {
+		return nil, fmt.Errorf("expected the Target Resource ID %q to be a Resource ID within a Subscription", id.TargetResourceId)
+	}
+	providersIndex := strings.LastIndex(strings.ToLower(id.TargetResourceId), "/providers/")
+	if providersIndex == -1 {
+		return nil, fmt.Errorf("expected the Target Resource ID %q to be the ID of a Resource within a Resource Provider", id.TargetResourceId)
+	}
+	// the Resource Provider Namespace followed by one or more pairs of Resource Type and Name
+	if segments := strings.Split(id.TargetResourceId[providersIndex+len("/providers/"):], "/"); len(segments) < 3 || len(segments)%2 != 1 {
+		return nil, fmt.Errorf("expected the Target Resource ID %q to be the ID of a Resource within a Resource Provider", id.TargetResourceId)
+	}
+
+	return &id, nil
+}
+
+func PrivateEndpointConnectionApprovalIDValidation(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := PrivateEndpointConnectionApprovalID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"testing"
+)
+
+func TestPrivateEndpointConnectionApprovalID(t *testing.T) {
+	testData := []struct {
+		Name                    string
+		Input                   string
+		Expect                  *PrivateEndpointConnectionApprovalId
+		ExpectProviderNamespace string
+		ExpectResourceType      string
+		Error                   bool
+	}{
+		{
+			Name:  "Empty",
+			Input: "",
+			Error: true,
+		},
+		{
+			Name:  "Target Resource ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1",
+			Error: true,
+		},
+		{
+			Name:  "Missing Name",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1/privateEndpointConnections/",
+			Error: true,
+		},
+		{
+			Name:  "Missing Subscription",
+			Input: "/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1/privateEndpointConnections/connection1",
+			Error: true,
+		},
+		{
+			Name:  "Missing Resource Provider",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/privateEndpointConnections/connection1",
+			Error: true,
+		},
+		{
+			Name:  "Missing Resource Name",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/privateEndpointConnections/connection1",
+			Error: true,
+		},
+		{
+			Name:  "Nested Private Endpoint Connection",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1/privateEndpointConnections/connection1/extra",
+			Error: true,
+		},
+		{
+			Name:  "Storage Account",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1/privateEndpointConnections/connection1",
+			Expect: &PrivateEndpointConnectionApprovalId{
+				TargetResourceId: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1",
+				Name:             "connection1",
+			},
+			ExpectProviderNamespace: "Microsoft.Storage",
+			ExpectResourceType:      "storageAccounts/privateEndpointConnections",
+		},
+		{
+			Name:  "Nested Resource",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/databases/db1/privateEndpointConnections/connection1",
+			Expect: &PrivateEndpointConnectionApprovalId{
+				TargetResourceId: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/databases/db1",
+				Name:             "connection1",
+			},
+			ExpectProviderNamespace: "Microsoft.Sql",
+			ExpectResourceType:      "servers/databases/privateEndpointConnections",
+		},
+		{
+			Name:  "Mixed Casing",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/privateLinkServices/service1/PrivateEndpointConnections/connection1",
+			Expect: &PrivateEndpointConnectionApprovalId{
+				TargetResourceId: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/privateLinkServices/service1",
+				Name:             "connection1",
+			},
+			ExpectProviderNamespace: "Microsoft.Network",
+			ExpectResourceType:      "privateLinkServices/privateEndpointConnections",
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := PrivateEndpointConnectionApprovalID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatalf("Expected an error but got a value for %q", v.Input)
+		}
+
+		if actual.TargetResourceId != v.Expect.TargetResourceId {
+			t.Fatalf("Expected %q but got %q for Target Resource ID", v.Expect.TargetResourceId, actual.TargetResourceId)
+		}
+
+		if actual.Name != v.Expect.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expect.Name, actual.Name)
+		}
+
+		if actual.ProviderNamespace() != v.ExpectProviderNamespace {
+			t.Fatalf("Expected %q but got %q for Provider Namespace", v.ExpectProviderNamespace, actual.ProviderNamespace())
+		}
+
+		if actual.ResourceType() != v.ExpectResourceType {
+			t.Fatalf("Expected %q but got %q for Resource Type", v.ExpectResourceType, actual.ResourceType())
+		}
+	}
+}
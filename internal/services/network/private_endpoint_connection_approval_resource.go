@@ -0,0 +1,311 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const (
+	privateEndpointConnectionStatusApproved = "Approved"
+	privateEndpointConnectionStatusRejected = "Rejected"
+)
+
+type PrivateEndpointConnectionApprovalModel struct {
+	TargetResourceId  string `tfschema:"target_resource_id"`
+	Name              string `tfschema:"name"`
+	Status            string `tfschema:"status"`
+	Description       string `tfschema:"description"`
+	PrivateEndpointId string `tfschema:"private_endpoint_id"`
+}
+
+type PrivateEndpointConnectionApprovalResource struct{}
+
+var _ sdk.ResourceWithUpdate = PrivateEndpointConnectionApprovalResource{}
+
+func (r PrivateEndpointConnectionApprovalResource) ResourceType() string {
+	return "azurerm_private_endpoint_connection_approval"
+}
+
+func (r PrivateEndpointConnectionApprovalResource) ModelObject() interface{} {
+	return &PrivateEndpointConnectionApprovalModel{}
+}
+
+func (r PrivateEndpointConnectionApprovalResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return parse.PrivateEndpointConnectionApprovalIDValidation
+}
+
+func (r PrivateEndpointConnectionApprovalResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"target_resource_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validatePrivateEndpointConnectionTargetResourceId,
+		},
+
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  privateEndpointConnectionStatusApproved,
+			ValidateFunc: validation.StringInSlice([]string{
+				privateEndpointConnectionStatusApproved,
+				privateEndpointConnectionStatusRejected,
+			}, false),
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r PrivateEndpointConnectionApprovalResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"private_endpoint_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r PrivateEndpointConnectionApprovalResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var config PrivateEndpointConnectionApprovalModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := parse.NewPrivateEndpointConnectionApprovalId(config.TargetResourceId, config.Name)
+			if _, err := parse.PrivateEndpointConnectionApprovalID(id.ID()); err != nil {
+				return fmt.Errorf("`target_resource_id` must be the ID of a Resource which supports Private Endpoint Connections: %+v", err)
+			}
+
+			client := metadata.Client.Network.PrivateEndpointConnectionsClient
+
+			locks.ByID(id.TargetResourceId)
+			defer locks.UnlockByID(id.TargetResourceId)
+
+			// the Private Endpoint Connection is created by the Private Endpoint, so must already exist
+			connection, err := client.Get(ctx, id.ProviderNamespace(), id)
+			if err != nil {
+				if response.WasNotFound(connection.HttpResponse) {
+					return fmt.Errorf("%s was not found - the Private Endpoint must be created before the connection can be approved or rejected", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if err := updatePrivateEndpointConnectionStatus(ctx, client, id, connection.Model, config); err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r PrivateEndpointConnectionApprovalResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.PrivateEndpointConnectionApprovalID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			connection, err := metadata.Client.Network.PrivateEndpointConnectionsClient.Get(ctx, id.ProviderNamespace(), *id)
+			if err != nil {
+				if response.WasNotFound(connection.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := PrivateEndpointConnectionApprovalModel{
+				TargetResourceId: id.TargetResourceId,
+				Name:             id.Name,
+			}
+
+			properties := privateEndpointConnectionProperties(connection.Model)
+			if connectionState, ok := properties["privateLinkServiceConnectionState"].(map[string]interface{}); ok {
+				if v, ok := connectionState["status"].(string); ok {
+					state.Status = v
+				}
+				if v, ok := connectionState["description"].(string); ok {
+					state.Description = v
+				}
+			}
+			if privateEndpoint, ok := properties["privateEndpoint"].(map[string]interface{}); ok {
+				if v, ok := privateEndpoint["id"].(string); ok {
+					state.PrivateEndpointId = v
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PrivateEndpointConnectionApprovalResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.PrivateEndpointConnectionApprovalID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config PrivateEndpointConnectionApprovalModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Network.PrivateEndpointConnectionsClient
+
+			locks.ByID(id.TargetResourceId)
+			defer locks.UnlockByID(id.TargetResourceId)
+
+			connection, err := client.Get(ctx, id.ProviderNamespace(), *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			return updatePrivateEndpointConnectionStatus(ctx, client, *id, connection.Model, config)
+		},
+	}
+}
+
+func (r PrivateEndpointConnectionApprovalResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.PrivateEndpointConnectionApprovalID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// the Private Endpoint Connection belongs to the Private Endpoint (and is removed along with it), so rather than
+			// rejecting or resetting the connection it's left in its current status and only removed from the state
+			log.Printf("[DEBUG] %s will be removed from the state but will remain in its current status in Azure", *id)
+			return nil
+		},
+	}
+}
+
+// updatePrivateEndpointConnectionStatus sets the status of the Private Endpoint Connection and waits for the
+// Target Resource to finish provisioning it, since each Resource Provider processes this asynchronously
+func updatePrivateEndpointConnectionStatus(ctx context.Context, connectionsClient *client.PrivateEndpointConnectionsClient, id parse.PrivateEndpointConnectionApprovalId, connection *client.PrivateEndpointConnection, config PrivateEndpointConnectionApprovalModel) error {
+	// the existing properties are sent back since some Resource Providers require the Group IDs and Private Endpoint
+	properties := privateEndpointConnectionProperties(connection)
+	connectionState, ok := properties["privateLinkServiceConnectionState"].(map[string]interface{})
+	if !ok {
+		connectionState = make(map[string]interface{})
+	}
+	connectionState["status"] = config.Status
+	connectionState["description"] = config.Description
+	properties["privateLinkServiceConnectionState"] = connectionState
+	delete(properties, "provisioningState")
+
+	payload := client.PrivateEndpointConnection{
+		Properties: properties,
+	}
+	if err := connectionsClient.CreateOrUpdateThenPoll(ctx, id.ProviderNamespace(), id, payload); err != nil {
+		return fmt.Errorf("setting the status of %s to %q: %+v", id, config.Status, err)
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{"Pending"},
+		Target:     []string{config.Status},
+		Refresh:    privateEndpointConnectionStatusRefreshFunc(ctx, connectionsClient, id, config.Status),
+		MinTimeout: 10 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the status of %s to become %q: %+v", id, config.Status, err)
+	}
+
+	return nil
+}
+
+func privateEndpointConnectionStatusRefreshFunc(ctx context.Context, connectionsClient *client.PrivateEndpointConnectionsClient, id parse.PrivateEndpointConnectionApprovalId, status string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		connection, err := connectionsClient.Get(ctx, id.ProviderNamespace(), id)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		properties := privateEndpointConnectionProperties(connection.Model)
+		provisioningState, _ := properties["provisioningState"].(string)
+		if strings.EqualFold(provisioningState, "Failed") {
+			return connection, "", fmt.Errorf("provisioning of %s failed", id)
+		}
+
+		connectionStatus := ""
+		if connectionState, ok := properties["privateLinkServiceConnectionState"].(map[string]interface{}); ok {
+			connectionStatus, _ = connectionState["status"].(string)
+		}
+
+		// the Provisioning State isn't returned by every Resource Provider
+		provisioned := provisioningState == "" || strings.EqualFold(provisioningState, "Succeeded") || strings.EqualFold(provisioningState, "Ready")
+		if provisioned && strings.EqualFold(connectionStatus, status) {
+			return connection, status, nil
+		}
+
+		return connection, "Pending", nil
+	}
+}
+
+func privateEndpointConnectionProperties(connection *client.PrivateEndpointConnection) map[string]interface{} {
+	if connection != nil && connection.Properties != nil {
+		return connection.Properties
+	}
+	return make(map[string]interface{})
+}
+
+// validatePrivateEndpointConnectionTargetResourceId validates that the Target Resource is within a Resource Provider
+// for which the Private Endpoint Connections can be managed
+func validatePrivateEndpointConnectionTargetResourceId(input interface{}, key string) (warnings []string, errors []error) {
+	warnings, errors = azure.ValidateResourceID(input, key)
+	if len(errors) > 0 {
+		return
+	}
+
+	id := parse.NewPrivateEndpointConnectionApprovalId(input.(string), "validation")
+	namespaces := client.PrivateEndpointConnectionProviderNamespaces()
+	if !slices.Contains(namespaces, strings.ToLower(id.ProviderNamespace())) {
+		errors = append(errors, fmt.Errorf("%q must be a Resource within one of the Resource Providers %s but got %q", key, strings.Join(namespaces, ", "), id.ProviderNamespace()))
+	}
+
+	return
+}
@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type PrivateEndpointConnectionApprovalResource struct{}
+
+func TestAccPrivateEndpointConnectionApproval_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_endpoint_connection_approval", "test")
+	r := PrivateEndpointConnectionApprovalResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("Approved"),
+				check.That(data.ResourceName).Key("private_endpoint_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPrivateEndpointConnectionApproval_rejected(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_endpoint_connection_approval", "test")
+	r := PrivateEndpointConnectionApprovalResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.rejected(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("Rejected"),
+				check.That(data.ResourceName).Key("description").HasValue("Not Expected"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r PrivateEndpointConnectionApprovalResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.PrivateEndpointConnectionApprovalID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the Private Link Service is the Target Resource in these tests
+	resp, err := clients.Resource.ResourcesClient.GetByID(ctx, id.ID(), "2023-11-01")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (r PrivateEndpointConnectionApprovalResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_endpoint_connection_approval" "test" {
+  target_resource_id = azurerm_private_link_service.test.id
+  name               = data.azurerm_private_link_service_endpoint_connections.test.private_endpoint_connections.0.connection_name
+}
+`, r.template(data))
+}
+
+func (r PrivateEndpointConnectionApprovalResource) rejected(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_endpoint_connection_approval" "test" {
+  target_resource_id = azurerm_private_link_service.test.id
+  name               = data.azurerm_private_link_service_endpoint_connections.test.private_endpoint_connections.0.connection_name
+  status             = "Rejected"
+  description        = "Not Expected"
+}
+`, r.template(data))
+}
+
+func (PrivateEndpointConnectionApprovalResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_private_link_service_endpoint_connections" "test" {
+  service_id          = azurerm_private_endpoint.test.private_service_connection.0.private_connection_resource_id
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, PrivateEndpointResource{}.requestMessage(data, "Please Approve"))
+}
@@ -13,6 +13,8 @@ type Registration struct{}
 var (
 	_ sdk.TypedServiceRegistrationWithAGitHubLabel   = Registration{}
 	_ sdk.UntypedServiceRegistrationWithAGitHubLabel = Registration{}
+
+	_ sdk.ServiceRegistrationWithListResources = Registration{}
 )
 
 // Name is the name of this Service
@@ -36,6 +38,10 @@ func (r Registration) DataSources() []sdk.DataSource {
 		ManagerDataSource{},
 		ManagerNetworkGroupDataSource{},
 		ManagerConnectivityConfigurationDataSource{},
+		NetworkInterfaceEffectiveRoutesDataSource{},
+		NetworkInterfaceEffectiveSecurityRulesDataSource{},
+		NetworkWatcherIPFlowVerifyDataSource{},
+		NetworkWatcherNextHopDataSource{},
 	}
 }
 
@@ -45,6 +51,8 @@ func (r Registration) Resources() []sdk.Resource {
 		ManagerAdminRuleResource{},
 		ManagerAdminRuleCollectionResource{},
 		ManagerDeploymentResource{},
+		ManagerIpamPoolResource{},
+		ManagerIpamPoolStaticCidrResource{},
 		ManagerConnectivityConfigurationResource{},
 		ManagerManagementGroupConnectionResource{},
 		ManagerNetworkGroupResource{},
@@ -54,8 +62,17 @@ func (r Registration) Resources() []sdk.Resource {
 		ManagerStaticMemberResource{},
 		ManagerSubscriptionConnectionResource{},
 		PrivateEndpointApplicationSecurityGroupAssociationResource{},
+		PrivateEndpointConnectionApprovalResource{},
 		RouteMapResource{},
 		VirtualHubRoutingIntentResource{},
+		VirtualNetworkPeeringPairResource{},
+	}
+}
+
+// ListResources returns a list of List Resources supported by this Service
+func (r Registration) ListResources() []sdk.ListResource {
+	return []sdk.ListResource{
+		virtualNetworkListResource(),
 	}
 }
 
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/virtualwans"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
@@ -65,6 +66,10 @@ func (r RouteMapResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return virtualwans.ValidateRouteMapID
 }
 
+func (r RouteMapResource) IdentityType() resourceids.ResourceId {
+	return &virtualwans.RouteMapId{}
+}
+
 func (r RouteMapResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -293,6 +293,21 @@ func resourceSubnet() *pluginsdk.Resource {
 		}
 	}
 
+	if features.FivePointOhBeta() {
+		resource.Schema["address_prefixes"] = &pluginsdk.Schema{
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			Computed:     true,
+			MinItems:     1,
+			ExactlyOneOf: []string{"address_prefixes", "ip_address_pool"},
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		}
+		resource.Schema["ip_address_pool"] = ipAddressPoolSchema("address_prefixes")
+	}
+
 	return resource
 }
 
@@ -429,8 +444,15 @@ func resourceSubnetCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 		Properties: &properties,
 	}
 
-	if err := client.CreateOrUpdateThenPoll(ctx, id, subnet); err != nil {
-		return fmt.Errorf("creating %s: %+v", id, err)
+	if features.FivePointOhBeta() && len(d.Get("ip_address_pool").([]interface{})) > 0 {
+		allocationsClient := meta.(*clients.Client).Network.IpamPoolPrefixAllocationsClient
+		if err := allocationsClient.CreateOrUpdateSubnetThenPoll(ctx, id, subnet, expandIpAddressPools(d.Get("ip_address_pool").([]interface{}))); err != nil {
+			return fmt.Errorf("creating %s: %+v", id, err)
+		}
+	} else {
+		if err := client.CreateOrUpdateThenPoll(ctx, id, subnet); err != nil {
+			return fmt.Errorf("creating %s: %+v", id, err)
+		}
 	}
 
 	timeout, _ := ctx.Deadline()
@@ -583,8 +605,17 @@ func resourceSubnetUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 		Properties: &props,
 	}
 
-	if err := client.CreateOrUpdateThenPoll(ctx, *id, subnet); err != nil {
-		return fmt.Errorf("updating %s: %+v", *id, err)
+	// the IPAM Pool Prefix Allocations aren't part of the Subnet model, so they're sent again on every update to
+	// ensure the Address Prefixes remain allocated from the IPAM Pool(s)
+	if features.FivePointOhBeta() && (len(d.Get("ip_address_pool").([]interface{})) > 0 || d.HasChange("ip_address_pool")) {
+		allocationsClient := meta.(*clients.Client).Network.IpamPoolPrefixAllocationsClient
+		if err := allocationsClient.CreateOrUpdateSubnetThenPoll(ctx, *id, subnet, expandIpAddressPools(d.Get("ip_address_pool").([]interface{}))); err != nil {
+			return fmt.Errorf("updating %s: %+v", *id, err)
+		}
+	} else {
+		if err := client.CreateOrUpdateThenPoll(ctx, *id, subnet); err != nil {
+			return fmt.Errorf("updating %s: %+v", *id, err)
+		}
 	}
 
 	timeout, _ := ctx.Deadline()
@@ -641,6 +672,9 @@ func resourceSubnetRead(d *pluginsdk.ResourceData, meta interface{}) error {
 
 	if model := resp.Model; model != nil {
 		if props := model.Properties; props != nil {
+			// checked before `address_prefixes` is set, since it's only absent when importing or using an IPAM Pool
+			_, hasAddressPrefixes := d.GetOk("address_prefixes")
+
 			if props.AddressPrefixes == nil {
 				if props.AddressPrefix != nil && len(*props.AddressPrefix) > 0 {
 					d.Set("address_prefixes", []string{*props.AddressPrefix})
@@ -651,6 +685,22 @@ func resourceSubnetRead(d *pluginsdk.ResourceData, meta interface{}) error {
 				d.Set("address_prefixes", props.AddressPrefixes)
 			}
 
+			// the IPAM Pool Prefix Allocations are only retrieved when they're in use (or when importing), since
+			// they require a newer API Version than the rest of the Subnet
+			if features.FivePointOhBeta() {
+				ipAddressPools := make([]interface{}, 0)
+				if v := d.Get("ip_address_pool").([]interface{}); len(v) > 0 || !hasAddressPrefixes {
+					_, allocations, err := meta.(*clients.Client).Network.IpamPoolPrefixAllocationsClient.GetSubnet(ctx, *id)
+					if err != nil {
+						return fmt.Errorf("retrieving the IPAM Pool Prefix Allocations for %s: %+v", *id, err)
+					}
+					ipAddressPools = flattenIpAddressPools(allocations)
+				}
+				if err := d.Set("ip_address_pool", ipAddressPools); err != nil {
+					return fmt.Errorf("setting `ip_address_pool`: %+v", err)
+				}
+			}
+
 			defaultOutboundAccessEnabled := true
 			if props.DefaultOutboundAccess != nil {
 				defaultOutboundAccessEnabled = *props.DefaultOutboundAccess
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/virtualwans"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -44,6 +45,10 @@ func (r VirtualHubRoutingIntentResource) IDValidationFunc() pluginsdk.SchemaVali
 	return virtualwans.ValidateRoutingIntentID
 }
 
+func (r VirtualHubRoutingIntentResource) IdentityType() resourceids.ResourceId {
+	return &virtualwans.RoutingIntentId{}
+}
+
 func (r VirtualHubRoutingIntentResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/virtualnetworks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+func virtualNetworkListResource() sdk.ListResource {
+	return sdk.ListResource{
+		ResourceType:        "azurerm_virtual_network",
+		Resource:            resourceVirtualNetwork,
+		ResourceGroupScoped: true,
+		List: func(ctx context.Context, client *clients.Client, resourceGroupName *string) ([]resourceids.ResourceId, error) {
+			var items []virtualnetworks.VirtualNetwork
+			if resourceGroupName != nil {
+				resourceGroupId := commonids.NewResourceGroupID(client.Account.SubscriptionId, *resourceGroupName)
+				resp, err := client.Network.VirtualNetworks.ListComplete(ctx, resourceGroupId)
+				if err != nil {
+					return nil, fmt.Errorf("listing Virtual Networks within %s: %+v", resourceGroupId, err)
+				}
+				items = resp.Items
+			} else {
+				subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
+				resp, err := client.Network.VirtualNetworks.ListAllComplete(ctx, subscriptionId)
+				if err != nil {
+					return nil, fmt.Errorf("listing Virtual Networks within %s: %+v", subscriptionId, err)
+				}
+				items = resp.Items
+			}
+
+			output := make([]resourceids.ResourceId, 0)
+			for _, item := range items {
+				id, err := commonids.ParseVirtualNetworkIDInsensitively(pointer.From(item.Id))
+				if err != nil {
+					return nil, err
+				}
+				output = append(output, id)
+			}
+
+			return output, nil
+		},
+	}
+}
@@ -0,0 +1,467 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/virtualnetworkpeerings"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type VirtualNetworkPeeringPairModel struct {
+	Local    []VirtualNetworkPeeringPairPeeringModel `tfschema:"local"`
+	Remote   []VirtualNetworkPeeringPairPeeringModel `tfschema:"remote"`
+	Triggers map[string]string                       `tfschema:"triggers"`
+}
+
+type VirtualNetworkPeeringPairPeeringModel struct {
+	Name                      string `tfschema:"name"`
+	VirtualNetworkId          string `tfschema:"virtual_network_id"`
+	AllowVirtualNetworkAccess bool   `tfschema:"allow_virtual_network_access"`
+	AllowForwardedTraffic     bool   `tfschema:"allow_forwarded_traffic"`
+	AllowGatewayTransit       bool   `tfschema:"allow_gateway_transit"`
+	UseRemoteGateways         bool   `tfschema:"use_remote_gateways"`
+	PeeringState              string `tfschema:"peering_state"`
+}
+
+type VirtualNetworkPeeringPairResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = VirtualNetworkPeeringPairResource{}
+	_ sdk.ResourceWithCustomizeDiff = VirtualNetworkPeeringPairResource{}
+)
+
+func (r VirtualNetworkPeeringPairResource) ResourceType() string {
+	return "azurerm_virtual_network_peering_pair"
+}
+
+func (r VirtualNetworkPeeringPairResource) ModelObject() interface{} {
+	return &VirtualNetworkPeeringPairModel{}
+}
+
+func (r VirtualNetworkPeeringPairResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return func(input interface{}, key string) (warnings []string, errors []error) {
+		v, ok := input.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+			return
+		}
+
+		if _, err := commonids.ParseCompositeResourceID(v, &virtualnetworkpeerings.VirtualNetworkPeeringId{}, &virtualnetworkpeerings.VirtualNetworkPeeringId{}); err != nil {
+			errors = append(errors, err)
+		}
+
+		return
+	}
+}
+
+func (r VirtualNetworkPeeringPairResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"local": r.peeringSchema(),
+
+		"remote": r.peeringSchema(),
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r VirtualNetworkPeeringPairResource) peeringSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"virtual_network_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: commonids.ValidateVirtualNetworkID,
+				},
+
+				"allow_virtual_network_access": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+
+				"allow_forwarded_traffic": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"allow_gateway_transit": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"use_remote_gateways": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"peering_state": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func (r VirtualNetworkPeeringPairResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r VirtualNetworkPeeringPairResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model VirtualNetworkPeeringPairModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			local, remote, err := r.peeringsFromModel(metadata.Client, model)
+			if err != nil {
+				return err
+			}
+
+			id := commonids.NewCompositeResourceID(&local.id, &remote.id)
+			for _, peering := range []virtualNetworkPeeringPairPeering{*local, *remote} {
+				existing, err := peering.client.Get(ctx, peering.id)
+				if err != nil && !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", peering.id, err)
+				}
+				if !response.WasNotFound(existing.HttpResponse) {
+					return metadata.ResourceRequiresImport(r.ResourceType(), id)
+				}
+			}
+
+			locks.ByID(virtualNetworkPeeringResourceType)
+			defer locks.UnlockByID(virtualNetworkPeeringResourceType)
+
+			peerings := []virtualNetworkPeeringPairPeering{*local, *remote}
+			if !virtualNetworkPeeringPairLocalFirst(false, local.config.UseRemoteGateways, false, remote.config.UseRemoteGateways) {
+				peerings = []virtualNetworkPeeringPairPeering{*remote, *local}
+			}
+			for i, peering := range peerings {
+				if err := createVirtualNetworkPeering(ctx, peering.client, peering.id, peering.expand()); err != nil {
+					err = fmt.Errorf("creating %s: %+v", peering.id, err)
+
+					// the Pair is only tracked once both sides exist, so any side which was created is removed
+					// rather than being left behind outside of Terraform
+					for j := i - 1; j >= 0; j-- {
+						created := peerings[j]
+						if deleteErr := created.client.DeleteThenPoll(ctx, created.id); deleteErr != nil {
+							return fmt.Errorf("%+v\n\nadditionally, removing the previously created %s failed, as such this must be removed manually: %+v", err, created.id, deleteErr)
+						}
+					}
+
+					return err
+				}
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r VirtualNetworkPeeringPairResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := commonids.ParseCompositeResourceID(metadata.ResourceData.Id(), &virtualnetworkpeerings.VirtualNetworkPeeringId{}, &virtualnetworkpeerings.VirtualNetworkPeeringId{})
+			if err != nil {
+				return err
+			}
+
+			state := VirtualNetworkPeeringPairModel{
+				Triggers: make(map[string]string),
+			}
+			if v, ok := metadata.ResourceData.GetOk("triggers"); ok {
+				for key, value := range v.(map[string]interface{}) {
+					state.Triggers[key] = value.(string)
+				}
+			}
+
+			for _, peeringId := range []*virtualnetworkpeerings.VirtualNetworkPeeringId{id.First, id.Second} {
+				client, err := virtualNetworkPeeringsClientForSubscription(metadata.Client, peeringId.SubscriptionId)
+				if err != nil {
+					return err
+				}
+
+				resp, err := client.Get(ctx, *peeringId)
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						// the Pair can only be managed as a whole, so if either side is gone it needs to be recreated
+						return metadata.MarkAsGone(id)
+					}
+					return fmt.Errorf("retrieving %s: %+v", *peeringId, err)
+				}
+
+				peering := VirtualNetworkPeeringPairPeeringModel{
+					Name:             peeringId.VirtualNetworkPeeringName,
+					VirtualNetworkId: commonids.NewVirtualNetworkID(peeringId.SubscriptionId, peeringId.ResourceGroupName, peeringId.VirtualNetworkName).ID(),
+				}
+				if model := resp.Model; model != nil {
+					if props := model.Properties; props != nil {
+						peering.AllowVirtualNetworkAccess = pointer.From(props.AllowVirtualNetworkAccess)
+						peering.AllowForwardedTraffic = pointer.From(props.AllowForwardedTraffic)
+						peering.AllowGatewayTransit = pointer.From(props.AllowGatewayTransit)
+						peering.UseRemoteGateways = pointer.From(props.UseRemoteGateways)
+						peering.PeeringState = string(pointer.From(props.PeeringState))
+					}
+				}
+
+				if peeringId == id.First {
+					state.Local = []VirtualNetworkPeeringPairPeeringModel{peering}
+				} else {
+					state.Remote = []VirtualNetworkPeeringPairPeeringModel{peering}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r VirtualNetworkPeeringPairResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model VirtualNetworkPeeringPairModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			local, remote, err := r.peeringsFromModel(metadata.Client, model)
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(virtualNetworkPeeringResourceType)
+			defer locks.UnlockByID(virtualNetworkPeeringResourceType)
+
+			localUsedRemoteGateways, _ := metadata.ResourceData.GetChange("local.0.use_remote_gateways")
+			remoteUsedRemoteGateways, _ := metadata.ResourceData.GetChange("remote.0.use_remote_gateways")
+			peerings := []virtualNetworkPeeringPairPeering{*local, *remote}
+			if !virtualNetworkPeeringPairLocalFirst(localUsedRemoteGateways.(bool), local.config.UseRemoteGateways, remoteUsedRemoteGateways.(bool), remote.config.UseRemoteGateways) {
+				peerings = []virtualNetworkPeeringPairPeering{*remote, *local}
+			}
+
+			// both sides are updated regardless of which changed, so that the address space of each
+			// Peering is synchronised when the `triggers` change
+			for _, peering := range peerings {
+				existing, err := peering.client.Get(ctx, peering.id)
+				if err != nil {
+					return fmt.Errorf("retrieving %s: %+v", peering.id, err)
+				}
+				if existing.Model == nil || existing.Model.Properties == nil {
+					return fmt.Errorf("retrieving %s: `properties` was nil", peering.id)
+				}
+
+				existing.Model.Properties.AllowVirtualNetworkAccess = pointer.To(peering.config.AllowVirtualNetworkAccess)
+				existing.Model.Properties.AllowForwardedTraffic = pointer.To(peering.config.AllowForwardedTraffic)
+				existing.Model.Properties.AllowGatewayTransit = pointer.To(peering.config.AllowGatewayTransit)
+				existing.Model.Properties.UseRemoteGateways = pointer.To(peering.config.UseRemoteGateways)
+
+				if err := peering.client.CreateOrUpdateThenPoll(ctx, peering.id, *existing.Model, virtualnetworkpeerings.CreateOrUpdateOperationOptions{SyncRemoteAddressSpace: pointer.To(virtualnetworkpeerings.SyncRemoteAddressSpaceTrue)}); err != nil {
+					return fmt.Errorf("updating %s: %+v", peering.id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r VirtualNetworkPeeringPairResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model VirtualNetworkPeeringPairModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := commonids.ParseCompositeResourceID(metadata.ResourceData.Id(), &virtualnetworkpeerings.VirtualNetworkPeeringId{}, &virtualnetworkpeerings.VirtualNetworkPeeringId{})
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(virtualNetworkPeeringResourceType)
+			defer locks.UnlockByID(virtualNetworkPeeringResourceType)
+
+			// the side using the Remote Gateways is removed first, so that Gateway Transit isn't removed whilst in use
+			peeringIds := []*virtualnetworkpeerings.VirtualNetworkPeeringId{id.First, id.Second}
+			if len(model.Remote) > 0 && model.Remote[0].UseRemoteGateways {
+				peeringIds = []*virtualnetworkpeerings.VirtualNetworkPeeringId{id.Second, id.First}
+			}
+			for _, peeringId := range peeringIds {
+				client, err := virtualNetworkPeeringsClientForSubscription(metadata.Client, peeringId.SubscriptionId)
+				if err != nil {
+					return err
+				}
+
+				if err := client.DeleteThenPoll(ctx, *peeringId); err != nil {
+					return fmt.Errorf("deleting %s: %+v", *peeringId, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r VirtualNetworkPeeringPairResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var config VirtualNetworkPeeringPairModel
+			if err := metadata.DecodeDiff(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+			if len(config.Local) == 0 || len(config.Remote) == 0 {
+				return nil
+			}
+			local := config.Local[0]
+			remote := config.Remote[0]
+
+			if local.VirtualNetworkId != "" && strings.EqualFold(local.VirtualNetworkId, remote.VirtualNetworkId) {
+				return fmt.Errorf("`local.0.virtual_network_id` and `remote.0.virtual_network_id` must refer to different Virtual Networks")
+			}
+			if local.UseRemoteGateways && remote.UseRemoteGateways {
+				return fmt.Errorf("`use_remote_gateways` can only be enabled for one side of the Virtual Network Peering Pair")
+			}
+			if local.UseRemoteGateways && !remote.AllowGatewayTransit {
+				return fmt.Errorf("`remote.0.allow_gateway_transit` must be enabled when `local.0.use_remote_gateways` is enabled")
+			}
+			if remote.UseRemoteGateways && !local.AllowGatewayTransit {
+				return fmt.Errorf("`local.0.allow_gateway_transit` must be enabled when `remote.0.use_remote_gateways` is enabled")
+			}
+
+			return nil
+		},
+	}
+}
+
+// virtualNetworkPeeringPairPeering is one side of a Virtual Network Peering Pair, along with the client for the
+// Subscription containing that Virtual Network
+type virtualNetworkPeeringPairPeering struct {
+	id                     virtualnetworkpeerings.VirtualNetworkPeeringId
+	remoteVirtualNetworkId commonids.VirtualNetworkId
+	config                 VirtualNetworkPeeringPairPeeringModel
+	client                 *virtualnetworkpeerings.VirtualNetworkPeeringsClient
+}
+
+func (p virtualNetworkPeeringPairPeering) expand() virtualnetworkpeerings.VirtualNetworkPeering {
+	return virtualnetworkpeerings.VirtualNetworkPeering{
+		Properties: &virtualnetworkpeerings.VirtualNetworkPeeringPropertiesFormat{
+			AllowVirtualNetworkAccess: pointer.To(p.config.AllowVirtualNetworkAccess),
+			AllowForwardedTraffic:     pointer.To(p.config.AllowForwardedTraffic),
+			AllowGatewayTransit:       pointer.To(p.config.AllowGatewayTransit),
+			UseRemoteGateways:         pointer.To(p.config.UseRemoteGateways),
+			RemoteVirtualNetwork: &virtualnetworkpeerings.SubResource{
+				Id: pointer.To(p.remoteVirtualNetworkId.ID()),
+			},
+		},
+	}
+}
+
+func (r VirtualNetworkPeeringPairResource) peeringsFromModel(client *clients.Client, model VirtualNetworkPeeringPairModel) (*virtualNetworkPeeringPairPeering, *virtualNetworkPeeringPairPeering, error) {
+	if len(model.Local) == 0 || len(model.Remote) == 0 {
+		return nil, nil, fmt.Errorf("internal-error: `local` and `remote` must be specified")
+	}
+
+	localVirtualNetworkId, err := commonids.ParseVirtualNetworkID(model.Local[0].VirtualNetworkId)
+	if err != nil {
+		return nil, nil, err
+	}
+	remoteVirtualNetworkId, err := commonids.ParseVirtualNetworkID(model.Remote[0].VirtualNetworkId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the Virtual Networks can be in different Subscriptions (and, using the Auxiliary Tenants, different Tenants)
+	localClient, err := virtualNetworkPeeringsClientForSubscription(client, localVirtualNetworkId.SubscriptionId)
+	if err != nil {
+		return nil, nil, err
+	}
+	remoteClient, err := virtualNetworkPeeringsClientForSubscription(client, remoteVirtualNetworkId.SubscriptionId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	local := virtualNetworkPeeringPairPeering{
+		id:                     virtualnetworkpeerings.NewVirtualNetworkPeeringID(localVirtualNetworkId.SubscriptionId, localVirtualNetworkId.ResourceGroupName, localVirtualNetworkId.VirtualNetworkName, model.Local[0].Name),
+		remoteVirtualNetworkId: *remoteVirtualNetworkId,
+		config:                 model.Local[0],
+		client:                 localClient,
+	}
+	remote := virtualNetworkPeeringPairPeering{
+		id:                     virtualnetworkpeerings.NewVirtualNetworkPeeringID(remoteVirtualNetworkId.SubscriptionId, remoteVirtualNetworkId.ResourceGroupName, remoteVirtualNetworkId.VirtualNetworkName, model.Remote[0].Name),
+		remoteVirtualNetworkId: *localVirtualNetworkId,
+		config:                 model.Remote[0],
+		client:                 remoteClient,
+	}
+
+	return &local, &remote, nil
+}
+
+func virtualNetworkPeeringsClientForSubscription(client *clients.Client, subscriptionId string) (*virtualnetworkpeerings.VirtualNetworkPeeringsClient, error) {
+	subscriptionClient, err := client.ForSubscription(subscriptionId)
+	if err != nil {
+		return nil, err
+	}
+
+	return subscriptionClient.Network.VirtualNetworkPeerings, nil
+}
+
+// virtualNetworkPeeringPairLocalFirst returns whether the Local Peering should be created/updated before the Remote
+// Peering, based on the previous and new values of `use_remote_gateways` for each side.
+//
+// A Peering can only use the Remote Gateways once the Peering from the other side allows Gateway Transit, so the side
+// using the Remote Gateways is created/updated last - unless it's no longer using them, in which case it's updated
+// first so that Gateway Transit can then be disabled on the other side.
+func virtualNetworkPeeringPairLocalFirst(localOld, localNew, remoteOld, remoteNew bool) bool {
+	switch {
+	case localOld && !localNew:
+		return true
+	case remoteOld && !remoteNew:
+		return false
+	case localNew:
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,287 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/virtualnetworkpeerings"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type VirtualNetworkPeeringPairResource struct{}
+
+func TestAccVirtualNetworkPeeringPair_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_network_peering_pair", "test")
+	r := VirtualNetworkPeeringPairResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("local.0.peering_state").HasValue("Connected"),
+				check.That(data.ResourceName).Key("remote.0.peering_state").HasValue("Connected"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualNetworkPeeringPair_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_network_peering_pair", "test")
+	r := VirtualNetworkPeeringPairResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccVirtualNetworkPeeringPair_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_network_peering_pair", "test")
+	r := VirtualNetworkPeeringPairResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("local.0.allow_forwarded_traffic").HasValue("true"),
+				check.That(data.ResourceName).Key("remote.0.allow_forwarded_traffic").HasValue("true"),
+			),
+		},
+		// triggers is an arbitrary map which isn't returned from the API
+		data.ImportStep("triggers"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualNetworkPeeringPair_gatewayTransit(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_network_peering_pair", "test")
+	r := VirtualNetworkPeeringPairResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.gatewayTransit(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("local.0.allow_gateway_transit").HasValue("true"),
+				check.That(data.ResourceName).Key("remote.0.use_remote_gateways").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.gatewayTransit(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("local.0.allow_gateway_transit").HasValue("false"),
+				check.That(data.ResourceName).Key("remote.0.use_remote_gateways").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.gatewayTransit(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r VirtualNetworkPeeringPairResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := commonids.ParseCompositeResourceID(state.ID, &virtualnetworkpeerings.VirtualNetworkPeeringId{}, &virtualnetworkpeerings.VirtualNetworkPeeringId{})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, peeringId := range []*virtualnetworkpeerings.VirtualNetworkPeeringId{id.First, id.Second} {
+		resp, err := clients.Network.VirtualNetworkPeerings.Get(ctx, *peeringId)
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, fmt.Errorf("retrieving %s: %+v", *peeringId, err)
+		}
+	}
+
+	return pointer.To(true), nil
+}
+
+func (r VirtualNetworkPeeringPairResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%[1]s
+
+resource "azurerm_virtual_network_peering_pair" "test" {
+  local {
+    name               = "acctestpeer-1-%[2]d"
+    virtual_network_id = azurerm_virtual_network.test1.id
+  }
+
+  remote {
+    name               = "acctestpeer-2-%[2]d"
+    virtual_network_id = azurerm_virtual_network.test2.id
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r VirtualNetworkPeeringPairResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_network_peering_pair" "import" {
+  local {
+    name               = azurerm_virtual_network_peering_pair.test.local.0.name
+    virtual_network_id = azurerm_virtual_network_peering_pair.test.local.0.virtual_network_id
+  }
+
+  remote {
+    name               = azurerm_virtual_network_peering_pair.test.remote.0.name
+    virtual_network_id = azurerm_virtual_network_peering_pair.test.remote.0.virtual_network_id
+  }
+}
+`, r.basic(data))
+}
+
+func (r VirtualNetworkPeeringPairResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%[1]s
+
+resource "azurerm_virtual_network_peering_pair" "test" {
+  local {
+    name                         = "acctestpeer-1-%[2]d"
+    virtual_network_id           = azurerm_virtual_network.test1.id
+    allow_virtual_network_access = true
+    allow_forwarded_traffic      = true
+  }
+
+  remote {
+    name                         = "acctestpeer-2-%[2]d"
+    virtual_network_id           = azurerm_virtual_network.test2.id
+    allow_virtual_network_access = true
+    allow_forwarded_traffic      = true
+  }
+
+  triggers = {
+    local_address_space  = join(",", azurerm_virtual_network.test1.address_space)
+    remote_address_space = join(",", azurerm_virtual_network.test2.address_space)
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r VirtualNetworkPeeringPairResource) gatewayTransit(data acceptance.TestData, enabled bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%[1]s
+
+resource "azurerm_subnet" "gateway" {
+  name                 = "GatewaySubnet"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test1.name
+  address_prefixes     = ["10.0.1.0/27"]
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpip-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  allocation_method   = "Static"
+  sku                 = "Standard"
+}
+
+resource "azurerm_virtual_network_gateway" "test" {
+  name                = "acctestvng-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  type                = "Vpn"
+  vpn_type            = "RouteBased"
+  sku                 = "VpnGw1"
+
+  ip_configuration {
+    public_ip_address_id          = azurerm_public_ip.test.id
+    private_ip_address_allocation = "Dynamic"
+    subnet_id                     = azurerm_subnet.gateway.id
+  }
+}
+
+resource "azurerm_virtual_network_peering_pair" "test" {
+  local {
+    name                  = "acctestpeer-1-%[2]d"
+    virtual_network_id    = azurerm_virtual_network.test1.id
+    allow_gateway_transit = %[3]t
+  }
+
+  remote {
+    name                = "acctestpeer-2-%[2]d"
+    virtual_network_id  = azurerm_virtual_network.test2.id
+    use_remote_gateways = %[3]t
+  }
+
+  depends_on = [azurerm_virtual_network_gateway.test]
+}
+`, r.template(data), data.RandomInteger, enabled)
+}
+
+func (VirtualNetworkPeeringPairResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = %[2]q
+}
+
+resource "azurerm_virtual_network" "test1" {
+  name                = "acctestvirtnet-1-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  address_space       = ["10.0.1.0/24"]
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_virtual_network" "test2" {
+  name                = "acctestvirtnet-2-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  address_space       = ["10.0.2.0/24"]
+  location            = azurerm_resource_group.test.location
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -4,6 +4,7 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -176,39 +177,8 @@ func resourceVirtualNetworkPeeringCreate(d *pluginsdk.ResourceData, meta interfa
 	locks.ByID(virtualNetworkPeeringResourceType)
 	defer locks.UnlockByID(virtualNetworkPeeringResourceType)
 
-	deadline, ok := ctx.Deadline()
-	if !ok {
-		return fmt.Errorf("internal-error: context had no deadline")
-	}
-	stateConf := &pluginsdk.StateChangeConf{
-		Pending: []string{"Pending"},
-		Target:  []string{"Created"},
-		Refresh: func() (interface{}, string, error) {
-			future, err := client.CreateOrUpdate(ctx, id, peer, virtualnetworkpeerings.CreateOrUpdateOperationOptions{SyncRemoteAddressSpace: pointer.To(virtualnetworkpeerings.SyncRemoteAddressSpaceTrue)})
-			if err != nil {
-				if utils.ResponseErrorIsRetryable(err) {
-					return future.HttpResponse, "Pending", err
-				} else {
-					if resp := future.HttpResponse; resp != nil && response.WasBadRequest(resp) && strings.Contains(err.Error(), "ReferencedResourceNotProvisioned") {
-						// Resource is not yet ready, this may be the case if the Vnet was just created or another peering was just initiated.
-						return future.HttpResponse, "Pending", err
-					}
-				}
-
-				return future.HttpResponse, "", err
-			}
-
-			if err = future.Poller.PollUntilDone(ctx); err != nil {
-				return future.HttpResponse, "", err
-			}
-
-			return future.HttpResponse, "Created", nil
-		},
-		Timeout: time.Until(deadline),
-		Delay:   15 * time.Second,
-	}
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
-		return fmt.Errorf("waiting for %s to be created: %+v", id, err)
+	if err := createVirtualNetworkPeering(ctx, client, id, peer); err != nil {
+		return err
 	}
 
 	d.SetId(id.ID())
@@ -337,3 +307,44 @@ func resourceVirtualNetworkPeeringDelete(d *pluginsdk.ResourceData, meta interfa
 
 	return err
 }
+
+// createVirtualNetworkPeering creates the Virtual Network Peering, retrying whilst the Virtual Network (or another
+// Peering) referenced by this Peering is still being provisioned
+func createVirtualNetworkPeering(ctx context.Context, client *virtualnetworkpeerings.VirtualNetworkPeeringsClient, id virtualnetworkpeerings.VirtualNetworkPeeringId, peer virtualnetworkpeerings.VirtualNetworkPeering) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{"Pending"},
+		Target:  []string{"Created"},
+		Refresh: func() (interface{}, string, error) {
+			future, err := client.CreateOrUpdate(ctx, id, peer, virtualnetworkpeerings.CreateOrUpdateOperationOptions{SyncRemoteAddressSpace: pointer.To(virtualnetworkpeerings.SyncRemoteAddressSpaceTrue)})
+			if err != nil {
+				if utils.ResponseErrorIsRetryable(err) {
+					return future.HttpResponse, "Pending", err
+				} else {
+					if resp := future.HttpResponse; resp != nil && response.WasBadRequest(resp) && strings.Contains(err.Error(), "ReferencedResourceNotProvisioned") {
+						// Resource is not yet ready, this may be the case if the Vnet was just created or another peering was just initiated.
+						return future.HttpResponse, "Pending", err
+					}
+				}
+
+				return future.HttpResponse, "", err
+			}
+
+			if err = future.Poller.PollUntilDone(ctx); err != nil {
+				return future.HttpResponse, "", err
+			}
+
+			return future.HttpResponse, "Created", nil
+		},
+		Timeout: time.Until(deadline),
+		Delay:   15 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %s to be created: %+v", id, err)
+	}
+
+	return nil
+}
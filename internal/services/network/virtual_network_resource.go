@@ -43,10 +43,10 @@ func resourceVirtualNetwork() *pluginsdk.Resource {
 		Read:   resourceVirtualNetworkRead,
 		Update: resourceVirtualNetworkUpdate,
 		Delete: resourceVirtualNetworkDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := commonids.ParseVirtualNetworkID(id)
-			return err
-		}),
+
+		Importer: pluginsdk.ImporterValidatingIdentity(&commonids.VirtualNetworkId{}),
+
+		Identity: pluginsdk.GenerateIdentitySchema(&commonids.VirtualNetworkId{}),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -321,6 +321,21 @@ func resourceVirtualNetworkSchema() map[string]*pluginsdk.Schema {
 		}
 	}
 
+	if features.FivePointOhBeta() {
+		s["address_space"] = &pluginsdk.Schema{
+			Type:         pluginsdk.TypeSet,
+			Optional:     true,
+			Computed:     true,
+			MinItems:     1,
+			ExactlyOneOf: []string{"address_space", "ip_address_pool"},
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		}
+		s["ip_address_pool"] = ipAddressPoolSchema("address_space")
+	}
+
 	return s
 }
 
@@ -380,8 +395,15 @@ func resourceVirtualNetworkCreate(d *pluginsdk.ResourceData, meta interface{}) e
 	locks.MultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
 	defer locks.UnlockMultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
 
-	if err := client.CreateOrUpdateThenPoll(ctx, id, vnet); err != nil {
-		return fmt.Errorf("creating %s: %+v", id, err)
+	if features.FivePointOhBeta() && len(d.Get("ip_address_pool").([]interface{})) > 0 {
+		allocationsClient := meta.(*clients.Client).Network.IpamPoolPrefixAllocationsClient
+		if err := allocationsClient.CreateOrUpdateVirtualNetworkThenPoll(ctx, id, vnet, expandIpAddressPools(d.Get("ip_address_pool").([]interface{}))); err != nil {
+			return fmt.Errorf("creating %s: %+v", id, err)
+		}
+	} else {
+		if err := client.CreateOrUpdateThenPoll(ctx, id, vnet); err != nil {
+			return fmt.Errorf("creating %s: %+v", id, err)
+		}
 	}
 
 	timeout, _ := ctx.Deadline()
@@ -422,6 +444,10 @@ func resourceVirtualNetworkRead(d *pluginsdk.ResourceData, meta interface{}) err
 	d.Set("name", id.VirtualNetworkName)
 	d.Set("resource_group_name", id.ResourceGroupName)
 
+	if err := pluginsdk.SetResourceIdentityData(d, id); err != nil {
+		return err
+	}
+
 	if model := resp.Model; model != nil {
 		d.Set("location", location.NormalizeNilable(model.Location))
 		d.Set("edge_zone", flattenEdgeZoneModel(model.ExtendedLocation))
@@ -430,6 +456,9 @@ func resourceVirtualNetworkRead(d *pluginsdk.ResourceData, meta interface{}) err
 			d.Set("guid", props.ResourceGuid)
 			d.Set("flow_timeout_in_minutes", props.FlowTimeoutInMinutes)
 
+			// checked before `address_space` is set, since it's only absent when importing or using an IPAM Pool
+			_, hasAddressPrefixes := d.GetOk("address_space")
+
 			if space := props.AddressSpace; space != nil {
 				if !features.FourPointOhBeta() {
 					d.Set("address_space", utils.FlattenStringSlice(space.AddressPrefixes))
@@ -440,6 +469,22 @@ func resourceVirtualNetworkRead(d *pluginsdk.ResourceData, meta interface{}) err
 				}
 			}
 
+			// the IPAM Pool Prefix Allocations are only retrieved when they're in use (or when importing), since
+			// they require a newer API Version than the rest of the Virtual Network
+			if features.FivePointOhBeta() {
+				ipAddressPools := make([]interface{}, 0)
+				if v := d.Get("ip_address_pool").([]interface{}); len(v) > 0 || !hasAddressPrefixes {
+					_, allocations, err := meta.(*clients.Client).Network.IpamPoolPrefixAllocationsClient.GetVirtualNetwork(ctx, *id)
+					if err != nil {
+						return fmt.Errorf("retrieving the IPAM Pool Prefix Allocations for %s: %+v", *id, err)
+					}
+					ipAddressPools = flattenIpAddressPools(allocations)
+				}
+				if err := d.Set("ip_address_pool", ipAddressPools); err != nil {
+					return fmt.Errorf("setting `ip_address_pool`: %+v", err)
+				}
+			}
+
 			if err := d.Set("ddos_protection_plan", flattenVirtualNetworkDDoSProtectionPlan(props)); err != nil {
 				return fmt.Errorf("setting `ddos_protection_plan`: %+v", err)
 			}
@@ -579,8 +624,17 @@ func resourceVirtualNetworkUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 	locks.MultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
 	defer locks.UnlockMultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
 
-	if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
-		return fmt.Errorf("updating %s: %+v", id, err)
+	// the IPAM Pool Prefix Allocations aren't part of the Virtual Network model, so they're sent again on every
+	// update to ensure the Address Space remains allocated from the IPAM Pool(s)
+	if features.FivePointOhBeta() && (len(d.Get("ip_address_pool").([]interface{})) > 0 || d.HasChange("ip_address_pool")) {
+		allocationsClient := meta.(*clients.Client).Network.IpamPoolPrefixAllocationsClient
+		if err := allocationsClient.CreateOrUpdateVirtualNetworkThenPoll(ctx, *id, *payload, expandIpAddressPools(d.Get("ip_address_pool").([]interface{}))); err != nil {
+			return fmt.Errorf("updating %s: %+v", id, err)
+		}
+	} else {
+		if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
+			return fmt.Errorf("updating %s: %+v", id, err)
+		}
 	}
 
 	timeout, _ := ctx.Deadline()
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/networkfunction/2022-11-01/azuretrafficcollectors"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -43,6 +44,10 @@ func (r NetworkFunctionAzureTrafficCollectorResource) IDValidationFunc() plugins
 	return azuretrafficcollectors.ValidateAzureTrafficCollectorID
 }
 
+func (r NetworkFunctionAzureTrafficCollectorResource) IdentityType() resourceids.ResourceId {
+	return &azuretrafficcollectors.AzureTrafficCollectorId{}
+}
+
 func (r NetworkFunctionAzureTrafficCollectorResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
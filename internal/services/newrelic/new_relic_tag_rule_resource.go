@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/newrelic/2022-07-01/monitors"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/newrelic/2022-07-01/tagrules"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -49,6 +50,10 @@ func (r NewRelicTagRuleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc
 	return tagrules.ValidateTagRuleID
 }
 
+func (r NewRelicTagRuleResource) IdentityType() resourceids.ResourceId {
+	return &tagrules.TagRuleId{}
+}
+
 func (r NewRelicTagRuleResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"monitor_id": {
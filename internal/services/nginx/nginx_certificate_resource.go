@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/nginx/2024-01-01-preview/nginxcertificate"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/nginx/2024-01-01-preview/nginxdeployment"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -226,3 +227,7 @@ func (m CertificateResource) Delete() sdk.ResourceFunc {
 func (m CertificateResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return nginxcertificate.ValidateCertificateID
 }
+
+func (m CertificateResource) IdentityType() resourceids.ResourceId {
+	return &nginxcertificate.CertificateId{}
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/nginx/2024-01-01-preview/nginxconfiguration"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/nginx/2024-01-01-preview/nginxdeployment"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -353,3 +354,7 @@ func (m ConfigurationResource) Delete() sdk.ResourceFunc {
 func (m ConfigurationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return nginxconfiguration.ValidateConfigurationID
 }
+
+func (m ConfigurationResource) IdentityType() resourceids.ResourceId {
+	return &nginxconfiguration.ConfigurationId{}
+}
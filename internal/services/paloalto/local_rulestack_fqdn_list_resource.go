@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/fqdnlistlocalrulestack"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/localrulestacks"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -40,6 +41,10 @@ func (r LocalRulestackFQDNList) IDValidationFunc() pluginsdk.SchemaValidateFunc
 	return fqdnlistlocalrulestack.ValidateLocalRulestackFqdnListID
 }
 
+func (r LocalRulestackFQDNList) IdentityType() resourceids.ResourceId {
+	return &fqdnlistlocalrulestack.LocalRulestackFqdnListId{}
+}
+
 func (r LocalRulestackFQDNList) ResourceType() string {
 	return "azurerm_palo_alto_local_rulestack_fqdn_list"
 }
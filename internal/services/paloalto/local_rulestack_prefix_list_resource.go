@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/localrulestacks"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/prefixlistlocalrulestack"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -36,6 +37,10 @@ func (r LocalRuleStackPrefixList) IDValidationFunc() pluginsdk.SchemaValidateFun
 	return prefixlistlocalrulestack.ValidateLocalRulestackPrefixListID
 }
 
+func (r LocalRuleStackPrefixList) IdentityType() resourceids.ResourceId {
+	return &prefixlistlocalrulestack.LocalRulestackPrefixListId{}
+}
+
 func (r LocalRuleStackPrefixList) ResourceType() string {
 	return "azurerm_palo_alto_local_rulestack_prefix_list"
 }
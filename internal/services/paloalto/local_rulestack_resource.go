@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/localrulestacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -47,6 +48,10 @@ func (r LocalRuleStack) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return localrulestacks.ValidateLocalRulestackID
 }
 
+func (r LocalRuleStack) IdentityType() resourceids.ResourceId {
+	return &localrulestacks.LocalRulestackId{}
+}
+
 func (r LocalRuleStack) ResourceType() string {
 	return "azurerm_palo_alto_local_rulestack"
 }
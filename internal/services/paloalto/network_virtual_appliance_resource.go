@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/networkvirtualappliances"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-11-01/virtualwans"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -40,6 +41,10 @@ func (r NetworkVirtualApplianceResource) IDValidationFunc() pluginsdk.SchemaVali
 	return networkvirtualappliances.ValidateNetworkVirtualApplianceID
 }
 
+func (r NetworkVirtualApplianceResource) IdentityType() resourceids.ResourceId {
+	return &networkvirtualappliances.NetworkVirtualApplianceId{}
+}
+
 func (r NetworkVirtualApplianceResource) Arguments() map[string]*schema.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
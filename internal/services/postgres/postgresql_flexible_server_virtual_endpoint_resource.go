@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2023-06-01-preview/servers"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2023-06-01-preview/virtualendpoints"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
@@ -44,6 +45,10 @@ func (r PostgresqlFlexibleServerVirtualEndpointResource) IDValidationFunc() plug
 	return virtualendpoints.ValidateVirtualEndpointID
 }
 
+func (r PostgresqlFlexibleServerVirtualEndpointResource) IdentityType() resourceids.ResourceId {
+	return &virtualendpoints.VirtualEndpointId{}
+}
+
 func (r PostgresqlFlexibleServerVirtualEndpointResource) Attributes() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{}
 }
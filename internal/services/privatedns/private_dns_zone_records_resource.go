@@ -0,0 +1,483 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package privatedns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2020-06-01/recordsets"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/zonefile"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourcePrivateDnsZoneRecords() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourcePrivateDnsZoneRecordsCreateUpdate,
+		Read:   resourcePrivateDnsZoneRecordsRead,
+		Update: resourcePrivateDnsZoneRecordsCreateUpdate,
+		Delete: resourcePrivateDnsZoneRecordsDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := recordsets.ParsePrivateDnsZoneID(id)
+			return err
+		}),
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourcePrivateDnsZoneRecordsCustomizeDiff),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"private_dns_zone_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: recordsets.ValidatePrivateDnsZoneID,
+			},
+
+			"zone_file": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+
+			"preserve_unmanaged_records": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"record_set": {
+				Type:     pluginsdk.TypeSet,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"ttl": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"records": {
+							Type:     pluginsdk.TypeSet,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourcePrivateDnsZoneRecordsCustomizeDiff parses the zone file during the plan, so that the changes to each Record Set
+// are shown individually rather than as a change to the zone file as a whole
+func resourcePrivateDnsZoneRecordsCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("zone_file") || !diff.NewValueKnown("private_dns_zone_id") {
+		return diff.SetNewComputed("record_set")
+	}
+
+	zoneId, err := recordsets.ParsePrivateDnsZoneID(diff.Get("private_dns_zone_id").(string))
+	if err != nil {
+		return err
+	}
+
+	recordSets, err := parsePrivateDnsZoneFile(diff.Get("zone_file").(string), zoneId.PrivateDnsZoneName)
+	if err != nil {
+		return err
+	}
+
+	return diff.SetNew("record_set", flattenPrivateDnsZoneRecordSets(recordSets))
+}
+
+func resourcePrivateDnsZoneRecordsCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PrivateDns.RecordSetsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	zoneId, err := recordsets.ParsePrivateDnsZoneID(d.Get("private_dns_zone_id").(string))
+	if err != nil {
+		return err
+	}
+
+	desired, err := parsePrivateDnsZoneFile(d.Get("zone_file").(string), zoneId.PrivateDnsZoneName)
+	if err != nil {
+		return err
+	}
+
+	existing, err := listPrivateDnsZoneRecordSets(ctx, client, *zoneId)
+	if err != nil {
+		return err
+	}
+
+	// the Record Sets within the zone are only taken over by importing the resource, so that existing Record Sets aren't
+	// overwritten or deleted unexpectedly
+	if d.IsNewResource() {
+		for _, recordSet := range desired {
+			if _, exists := existing[privateDnsZoneRecordSetKey(recordSet.Name, recordSet.Type)]; exists {
+				return tf.ImportAsExistsError("azurerm_private_dns_zone_records", zoneId.ID())
+			}
+		}
+		if !d.Get("preserve_unmanaged_records").(bool) && len(existing) > 0 {
+			return tf.ImportAsExistsError("azurerm_private_dns_zone_records", zoneId.ID())
+		}
+	}
+
+	// when unmanaged records are preserved only the Record Sets which were previously defined in the zone file are removed
+	var managed map[string]bool
+	if d.Get("preserve_unmanaged_records").(bool) {
+		old, _ := d.GetChange("record_set")
+		managed = privateDnsZoneRecordSetKeys(old.(*pluginsdk.Set).List())
+	}
+
+	desiredKeys := make(map[string]bool)
+	for _, recordSet := range desired {
+		desiredKeys[privateDnsZoneRecordSetKey(recordSet.Name, recordSet.Type)] = true
+	}
+
+	// Record Sets are removed first, since a CNAME Record Set can't coexist with other Record Sets of the same name
+	for key, recordSet := range existing {
+		if desiredKeys[key] || (managed != nil && !managed[key]) {
+			continue
+		}
+
+		id := recordsets.NewRecordTypeID(zoneId.SubscriptionId, zoneId.ResourceGroupName, zoneId.PrivateDnsZoneName, recordsets.RecordType(recordSet.recordSet.Type), recordSet.recordSet.Name)
+		if resp, err := client.Delete(ctx, id, recordsets.DefaultDeleteOperationOptions()); err != nil && !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting %s: %+v", id, err)
+		}
+	}
+
+	for _, recordSet := range desired {
+		current, exists := existing[privateDnsZoneRecordSetKey(recordSet.Name, recordSet.Type)]
+		if exists && privateDnsZoneRecordSetsEqual(current.recordSet, recordSet) {
+			continue
+		}
+
+		id := recordsets.NewRecordTypeID(zoneId.SubscriptionId, zoneId.ResourceGroupName, zoneId.PrivateDnsZoneName, recordsets.RecordType(recordSet.Type), recordSet.Name)
+		parameters, err := expandPrivateDnsZoneRecordSet(recordSet)
+		if err != nil {
+			return fmt.Errorf("expanding %s: %+v", id, err)
+		}
+		if exists {
+			parameters.Properties.Metadata = current.metadata
+		}
+
+		if _, err := client.CreateOrUpdate(ctx, id, *parameters, recordsets.DefaultCreateOrUpdateOperationOptions()); err != nil {
+			return fmt.Errorf("creating/updating %s: %+v", id, err)
+		}
+	}
+
+	d.SetId(zoneId.ID())
+
+	return resourcePrivateDnsZoneRecordsRead(d, meta)
+}
+
+func resourcePrivateDnsZoneRecordsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PrivateDns.RecordSetsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := recordsets.ParsePrivateDnsZoneID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.ListComplete(ctx, *id, recordsets.DefaultListOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.LatestHttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("listing Record Sets within %s: %+v", *id, err)
+	}
+
+	var managed map[string]bool
+	if d.Get("preserve_unmanaged_records").(bool) {
+		managed = privateDnsZoneRecordSetKeys(d.Get("record_set").(*pluginsdk.Set).List())
+	}
+
+	recordSets := make([]zonefile.RecordSet, 0)
+	for _, item := range resp.Items {
+		recordSet, ok := flattenPrivateDnsZoneRecordSet(item)
+		if !ok || (managed != nil && !managed[privateDnsZoneRecordSetKey(recordSet.Name, recordSet.Type)]) {
+			continue
+		}
+		recordSets = append(recordSets, recordSet)
+	}
+
+	d.Set("private_dns_zone_id", id.ID())
+
+	if err := d.Set("record_set", flattenPrivateDnsZoneRecordSets(recordSets)); err != nil {
+		return fmt.Errorf("setting `record_set`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourcePrivateDnsZoneRecordsDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PrivateDns.RecordSetsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	zoneId, err := recordsets.ParsePrivateDnsZoneID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	for _, item := range d.Get("record_set").(*pluginsdk.Set).List() {
+		recordSet := item.(map[string]interface{})
+
+		id := recordsets.NewRecordTypeID(zoneId.SubscriptionId, zoneId.ResourceGroupName, zoneId.PrivateDnsZoneName, recordsets.RecordType(recordSet["type"].(string)), recordSet["name"].(string))
+		if resp, err := client.Delete(ctx, id, recordsets.DefaultDeleteOperationOptions()); err != nil && !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting %s: %+v", id, err)
+		}
+	}
+
+	return nil
+}
+
+// parsePrivateDnsZoneFile parses the zone file, ensuring that it only contains the record types supported by Private DNS Zones
+func parsePrivateDnsZoneFile(input string, zoneName string) ([]zonefile.RecordSet, error) {
+	recordSets, err := zonefile.Parse(input, zoneName)
+	if err != nil {
+		return nil, fmt.Errorf("parsing `zone_file`: %+v", err)
+	}
+
+	for _, recordSet := range recordSets {
+		if recordSet.Type == "CAA" || recordSet.Type == "NS" {
+			return nil, fmt.Errorf("parsing `zone_file`: %s records are not supported in Private DNS Zones but got %q", recordSet.Type, recordSet.Name)
+		}
+	}
+
+	return recordSets, nil
+}
+
+type privateDnsZoneExistingRecordSet struct {
+	recordSet zonefile.RecordSet
+	metadata  *map[string]string
+}
+
+// listPrivateDnsZoneRecordSets returns the Record Sets within the Private DNS Zone which can be defined in a zone file, keyed by their
+// name and type
+func listPrivateDnsZoneRecordSets(ctx context.Context, client *recordsets.RecordSetsClient, zoneId recordsets.PrivateDnsZoneId) (map[string]privateDnsZoneExistingRecordSet, error) {
+	resp, err := client.ListComplete(ctx, zoneId, recordsets.DefaultListOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("listing Record Sets within %s: %+v", zoneId, err)
+	}
+
+	output := make(map[string]privateDnsZoneExistingRecordSet)
+	for _, item := range resp.Items {
+		recordSet, ok := flattenPrivateDnsZoneRecordSet(item)
+		if !ok {
+			continue
+		}
+
+		var metadata *map[string]string
+		if item.Properties != nil {
+			metadata = item.Properties.Metadata
+		}
+
+		output[privateDnsZoneRecordSetKey(recordSet.Name, recordSet.Type)] = privateDnsZoneExistingRecordSet{
+			recordSet: recordSet,
+			metadata:  metadata,
+		}
+	}
+
+	return output, nil
+}
+
+func privateDnsZoneRecordSetKey(name, recordType string) string {
+	return strings.ToLower(name) + "/" + strings.ToUpper(recordType)
+}
+
+func privateDnsZoneRecordSetKeys(input []interface{}) map[string]bool {
+	output := make(map[string]bool)
+	for _, item := range input {
+		recordSet := item.(map[string]interface{})
+		output[privateDnsZoneRecordSetKey(recordSet["name"].(string), recordSet["type"].(string))] = true
+	}
+	return output
+}
+
+func privateDnsZoneRecordSetsEqual(first, second zonefile.RecordSet) bool {
+	if first.TTL != second.TTL || len(first.Records) != len(second.Records) {
+		return false
+	}
+
+	values := make(map[string]bool)
+	for _, record := range first.Records {
+		values[record.Value] = true
+	}
+	for _, record := range second.Records {
+		if !values[record.Value] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func flattenPrivateDnsZoneRecordSets(input []zonefile.RecordSet) []interface{} {
+	output := make([]interface{}, 0)
+	for _, recordSet := range input {
+		records := make([]interface{}, 0)
+		for _, record := range recordSet.Records {
+			records = append(records, record.Value)
+		}
+
+		output = append(output, map[string]interface{}{
+			"name":    recordSet.Name,
+			"type":    recordSet.Type,
+			"ttl":     int(recordSet.TTL),
+			"records": records,
+		})
+	}
+	return output
+}
+
+// flattenPrivateDnsZoneRecordSet converts a Record Set retrieved from Azure into the form parsed from a zone file, returning
+// false for the Record Sets which are managed by Azure or can't be defined in a zone file
+func flattenPrivateDnsZoneRecordSet(input recordsets.RecordSet) (zonefile.RecordSet, bool) {
+	props := input.Properties
+	if input.Name == nil || input.Type == nil || props == nil {
+		return zonefile.RecordSet{}, false
+	}
+
+	name := strings.ToLower(*input.Name)
+	recordType := strings.ToUpper((*input.Type)[strings.LastIndex(*input.Type, "/")+1:])
+
+	// the records of Virtual Machines registered using Virtual Network Links are managed by Azure
+	if recordType == "SOA" || pointer.From(props.IsAutoRegistered) {
+		return zonefile.RecordSet{}, false
+	}
+
+	fields := make([][]string, 0)
+	switch recordType {
+	case "A":
+		for _, v := range pointer.From(props.ARecords) {
+			fields = append(fields, []string{pointer.From(v.IPv4Address)})
+		}
+	case "AAAA":
+		for _, v := range pointer.From(props.AaaaRecords) {
+			fields = append(fields, []string{pointer.From(v.IPv6Address)})
+		}
+	case "CNAME":
+		if props.CnameRecord != nil {
+			fields = append(fields, []string{pointer.From(props.CnameRecord.Cname)})
+		}
+	case "MX":
+		for _, v := range pointer.From(props.MxRecords) {
+			fields = append(fields, []string{strconv.FormatInt(pointer.From(v.Preference), 10), pointer.From(v.Exchange)})
+		}
+	case "PTR":
+		for _, v := range pointer.From(props.PtrRecords) {
+			fields = append(fields, []string{pointer.From(v.Ptrdname)})
+		}
+	case "SRV":
+		for _, v := range pointer.From(props.SrvRecords) {
+			fields = append(fields, []string{strconv.FormatInt(pointer.From(v.Priority), 10), strconv.FormatInt(pointer.From(v.Weight), 10), strconv.FormatInt(pointer.From(v.Port), 10), pointer.From(v.Target)})
+		}
+	case "TXT":
+		for _, v := range pointer.From(props.TxtRecords) {
+			fields = append(fields, pointer.From(v.Value))
+		}
+	default:
+		return zonefile.RecordSet{}, false
+	}
+
+	output := zonefile.RecordSet{
+		Name: name,
+		Type: recordType,
+		TTL:  pointer.From(props.Ttl),
+	}
+	for _, v := range fields {
+		output.Records = append(output.Records, zonefile.NewRecord(recordType, v))
+	}
+
+	return output, true
+}
+
+func expandPrivateDnsZoneRecordSet(input zonefile.RecordSet) (*recordsets.RecordSet, error) {
+	props := recordsets.RecordSetProperties{
+		Ttl: pointer.To(input.TTL),
+	}
+
+	parseInt := func(input string) int64 {
+		v, _ := strconv.ParseInt(input, 10, 64)
+		return v
+	}
+
+	for _, record := range input.Records {
+		fields := record.Fields
+		switch input.Type {
+		case "A":
+			props.ARecords = pointer.To(append(pointer.From(props.ARecords), recordsets.ARecord{
+				IPv4Address: pointer.To(fields[0]),
+			}))
+		case "AAAA":
+			props.AaaaRecords = pointer.To(append(pointer.From(props.AaaaRecords), recordsets.AaaaRecord{
+				IPv6Address: pointer.To(fields[0]),
+			}))
+		case "CNAME":
+			props.CnameRecord = &recordsets.CnameRecord{
+				Cname: pointer.To(fields[0]),
+			}
+		case "MX":
+			props.MxRecords = pointer.To(append(pointer.From(props.MxRecords), recordsets.MxRecord{
+				Preference: pointer.To(parseInt(fields[0])),
+				Exchange:   pointer.To(fields[1]),
+			}))
+		case "PTR":
+			props.PtrRecords = pointer.To(append(pointer.From(props.PtrRecords), recordsets.PtrRecord{
+				Ptrdname: pointer.To(fields[0]),
+			}))
+		case "SRV":
+			props.SrvRecords = pointer.To(append(pointer.From(props.SrvRecords), recordsets.SrvRecord{
+				Priority: pointer.To(parseInt(fields[0])),
+				Weight:   pointer.To(parseInt(fields[1])),
+				Port:     pointer.To(parseInt(fields[2])),
+				Target:   pointer.To(fields[3]),
+			}))
+		case "TXT":
+			props.TxtRecords = pointer.To(append(pointer.From(props.TxtRecords), recordsets.TxtRecord{
+				Value: pointer.To(fields),
+			}))
+		default:
+			return nil, fmt.Errorf("the record type %q is not supported", input.Type)
+		}
+	}
+
+	return &recordsets.RecordSet{
+		Name:       pointer.To(input.Name),
+		Properties: &props,
+	}, nil
+}
@@ -53,5 +53,6 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_private_dns_srv_record":                resourcePrivateDnsSrvRecord(),
 		"azurerm_private_dns_txt_record":                resourcePrivateDnsTxtRecord(),
 		"azurerm_private_dns_zone_virtual_network_link": resourcePrivateDnsZoneVirtualNetworkLink(),
+		"azurerm_private_dns_zone_records":              resourcePrivateDnsZoneRecords(),
 	}
 }
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/dnsforwardingrulesets"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/forwardingrules"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -46,6 +47,10 @@ func (r PrivateDNSResolverForwardingRuleResource) IDValidationFunc() pluginsdk.S
 	return forwardingrules.ValidateForwardingRuleID
 }
 
+func (r PrivateDNSResolverForwardingRuleResource) IdentityType() resourceids.ResourceId {
+	return &forwardingrules.ForwardingRuleId{}
+}
+
 func (r PrivateDNSResolverForwardingRuleResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
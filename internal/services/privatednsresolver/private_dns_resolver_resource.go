@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/dnsresolvers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -42,6 +43,10 @@ func (r PrivateDNSResolverDnsResolverResource) IDValidationFunc() pluginsdk.Sche
 	return dnsresolvers.ValidateDnsResolverID
 }
 
+func (r PrivateDNSResolverDnsResolverResource) IdentityType() resourceids.ResourceId {
+	return &dnsresolvers.DnsResolverId{}
+}
+
 func (r PrivateDNSResolverDnsResolverResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2022-10-01/replicationfabrics"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -156,3 +157,7 @@ func (r HyperVSiteResource) Delete() sdk.ResourceFunc {
 func (r HyperVSiteResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return replicationfabrics.ValidateReplicationFabricID
 }
+
+func (r HyperVSiteResource) IdentityType() resourceids.ResourceId {
+	return &replicationfabrics.ReplicationFabricId{}
+}
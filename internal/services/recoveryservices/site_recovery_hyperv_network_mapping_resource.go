@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservices/2024-01-01/vaults"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2022-10-01/replicationfabrics"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2022-10-01/replicationnetworkmappings"
@@ -94,6 +95,10 @@ func (s HyperVNetworkMappingResource) IDValidationFunc() pluginsdk.SchemaValidat
 	return replicationnetworkmappings.ValidateReplicationNetworkMappingID
 }
 
+func (s HyperVNetworkMappingResource) IdentityType() resourceids.ResourceId {
+	return &replicationnetworkmappings.ReplicationNetworkMappingId{}
+}
+
 func (s HyperVNetworkMappingResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
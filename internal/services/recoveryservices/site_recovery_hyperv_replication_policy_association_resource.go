@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2022-10-01/replicationfabrics"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2022-10-01/replicationpolicies"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2022-10-01/replicationprotectioncontainermappings"
@@ -76,6 +77,10 @@ func (h HyperVReplicationPolicyAssociationResource) IDValidationFunc() pluginsdk
 	return replicationprotectioncontainermappings.ValidateReplicationProtectionContainerMappingID
 }
 
+func (h HyperVReplicationPolicyAssociationResource) IdentityType() resourceids.ResourceId {
+	return &replicationprotectioncontainermappings.ReplicationProtectionContainerMappingId{}
+}
+
 func (h HyperVReplicationPolicyAssociationResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 60 * time.Minute,
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservices/2024-01-01/vaults"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2022-10-01/replicationpolicies"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -230,6 +231,10 @@ func (r ReplicationPolicyHyperVResource) IDValidationFunc() pluginsdk.SchemaVali
 	return replicationpolicies.ValidateReplicationPolicyID
 }
 
+func (r ReplicationPolicyHyperVResource) IdentityType() resourceids.ResourceId {
+	return &replicationpolicies.ReplicationPolicyId{}
+}
+
 func expandHyperVToAzurePolicyDetail(input *replicationpolicies.Policy) (out *replicationpolicies.HyperVReplicaAzurePolicyDetails, isA2A bool) {
 	if input.Properties == nil {
 		return nil, false
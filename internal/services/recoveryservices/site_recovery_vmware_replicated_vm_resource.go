@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-01/proximityplacementgroups"
 	vmwaremachines "github.com/hashicorp/go-azure-sdk/resource-manager/migrate/2020-01-01/machines"
 	vmwarerunasaccounts "github.com/hashicorp/go-azure-sdk/resource-manager/migrate/2020-01-01/runasaccounts"
@@ -86,6 +87,10 @@ func (s VMWareReplicatedVmResource) IDValidationFunc() pluginsdk.SchemaValidateF
 	return replicationprotecteditems.ValidateReplicationProtectedItemID
 }
 
+func (s VMWareReplicatedVmResource) IdentityType() resourceids.ResourceId {
+	return &replicationprotecteditems.ReplicationProtectedItemId{}
+}
+
 var (
 	_ sdk.ResourceWithUpdate        = VMWareReplicatedVmResource{}
 	_ sdk.ResourceWithCustomizeDiff = VMWareReplicatedVmResource{}
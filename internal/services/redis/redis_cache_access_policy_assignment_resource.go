@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/redis/2024-03-01/redis"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -78,6 +79,10 @@ func (r RedisCacheAccessPolicyAssignmentResource) IDValidationFunc() pluginsdk.S
 	return redis.ValidateAccessPolicyAssignmentID
 }
 
+func (r RedisCacheAccessPolicyAssignmentResource) IdentityType() resourceids.ResourceId {
+	return &redis.AccessPolicyAssignmentId{}
+}
+
 func (r RedisCacheAccessPolicyAssignmentResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 5 * time.Minute,
@@ -12,21 +12,25 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-05-01/resourcemanagementprivatelink"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-10-01/deploymentscripts"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2021-07-01/features"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-02-01/templatespecs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-02-01/templatespecversions"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-09-01/providers"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/resourcegroups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2024-03-01/deploymentstacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
 type Client struct {
 	DeploymentScriptsClient             *deploymentscripts.DeploymentScriptsClient
+	DeploymentStacksClient              *deploymentstacks.DeploymentStacksClient
 	FeaturesClient                      *features.FeaturesClient
 	LocksClient                         *managementlocks.ManagementLocksClient
 	PrivateLinkAssociationClient        *privatelinkassociation.PrivateLinkAssociationClient
 	ResourceGroupsClient                *resourcegroups.ResourceGroupsClient
 	ResourceManagementPrivateLinkClient *resourcemanagementprivatelink.ResourceManagementPrivateLinkClient
 	ResourceProvidersClient             *providers.ProvidersClient
+	TemplateSpecsClient                 *templatespecs.TemplateSpecsClient
 	TemplateSpecsVersionsClient         *templatespecversions.TemplateSpecVersionsClient
 	TagsClient                          *tags.TagsClient
 
@@ -47,6 +51,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(deploymentScriptsClient.Client, o.Authorizers.ResourceManager)
 
+	deploymentStacksClient, err := deploymentstacks.NewDeploymentStacksClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building DeploymentStacks client: %+v", err)
+	}
+	o.Configure(deploymentStacksClient.Client, o.Authorizers.ResourceManager)
+
 	featuresClient, err := features.NewFeaturesClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Features client: %+v", err)
@@ -83,6 +93,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(resourceProvidersClient.Client, o.Authorizers.ResourceManager)
 
+	templateSpecsClient, err := templatespecs.NewTemplateSpecsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building TemplateSpecs client: %+v", err)
+	}
+	o.Configure(templateSpecsClient.Client, o.Authorizers.ResourceManager)
+
 	templateSpecsVersionsClient, err := templatespecversions.NewTemplateSpecVersionsClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building TemplateSpecVersions client: %+v", err)
@@ -107,12 +123,14 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		// These come from `hashicorp/go-azure-sdk`
 		DeploymentsClient:                   &deploymentsClient,
 		DeploymentScriptsClient:             deploymentScriptsClient,
+		DeploymentStacksClient:              deploymentStacksClient,
 		FeaturesClient:                      featuresClient,
 		LocksClient:                         locksClient,
 		PrivateLinkAssociationClient:        privateLinkAssociationClient,
 		ResourceManagementPrivateLinkClient: resourceManagementPrivateLinkClient,
 		ResourceGroupsClient:                resourceGroupsClient,
 		ResourceProvidersClient:             resourceProvidersClient,
+		TemplateSpecsClient:                 templateSpecsClient,
 		TemplateSpecsVersionsClient:         templateSpecsVersionsClient,
 		TagsClient:                          tagsClient,
 
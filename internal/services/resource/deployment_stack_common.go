@@ -0,0 +1,398 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2024-03-01/deploymentstacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DeploymentStackActionOnUnmanageModel struct {
+	Resources        string `tfschema:"resources"`
+	ResourceGroups   string `tfschema:"resource_groups"`
+	ManagementGroups string `tfschema:"management_groups"`
+}
+
+type DeploymentStackDenySettingsModel struct {
+	Mode               string   `tfschema:"mode"`
+	ApplyToChildScopes bool     `tfschema:"apply_to_child_scopes"`
+	ExcludedActions    []string `tfschema:"excluded_actions"`
+	ExcludedPrincipals []string `tfschema:"excluded_principals"`
+}
+
+type DeploymentStackManagedResourceModel struct {
+	Id         string `tfschema:"id"`
+	Status     string `tfschema:"status"`
+	DenyStatus string `tfschema:"deny_status"`
+}
+
+// deploymentStackArguments returns the arguments common to the Deployment Stack resources at each scope, where
+// `management_groups` can only be specified within `action_on_unmanage` for a Management Group Deployment Stack
+func deploymentStackArguments(managementGroupScope bool) map[string]*pluginsdk.Schema {
+	actionOnUnmanage := map[string]*pluginsdk.Schema{
+		"resources": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(deploymentstacks.PossibleValuesForDeploymentStacksDeleteDetachEnum(), false),
+		},
+
+		"resource_groups": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(deploymentstacks.DeploymentStacksDeleteDetachEnumDetach),
+			ValidateFunc: validation.StringInSlice(deploymentstacks.PossibleValuesForDeploymentStacksDeleteDetachEnum(), false),
+		},
+	}
+	if managementGroupScope {
+		actionOnUnmanage["management_groups"] = &pluginsdk.Schema{
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(deploymentstacks.DeploymentStacksDeleteDetachEnumDetach),
+			ValidateFunc: validation.StringInSlice(deploymentstacks.PossibleValuesForDeploymentStacksDeleteDetachEnum(), false),
+		}
+	}
+
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.DeploymentStackName,
+		},
+
+		"action_on_unmanage": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: actionOnUnmanage,
+			},
+		},
+
+		"template_content": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsJSON,
+			StateFunc:    utils.NormalizeJson,
+			ExactlyOneOf: []string{
+				"template_content",
+				"template_spec_version_id",
+			},
+		},
+
+		"template_spec_version_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validate.TemplateSpecVersionID,
+			ExactlyOneOf: []string{
+				"template_content",
+				"template_spec_version_id",
+			},
+		},
+
+		"deny_settings": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"mode": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(deploymentstacks.DenySettingsModeDenyDelete),
+							string(deploymentstacks.DenySettingsModeDenyWriteAndDelete),
+						}, false),
+					},
+
+					"apply_to_child_scopes": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"excluded_actions": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 200,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"excluded_principals": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 5,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.IsUUID,
+						},
+					},
+				},
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 4096),
+		},
+
+		"parameters_content": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsJSON,
+			StateFunc:    utils.NormalizeJson,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func deploymentStackAttributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"managed_resource": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"status": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"deny_status": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"output_content": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+			// NOTE: outputs can be strings, ints, objects etc - so these are exposed as JSON which can be parsed
+			// using `jsondecode` as required
+		},
+	}
+}
+
+// deploymentStackCustomizeDiff marks the outputs and managed resources as unknown when the template or parameters
+// change, since these are only known once the Deployment Stack has been deployed
+func deploymentStackCustomizeDiff(metadata sdk.ResourceMetaData) error {
+	for _, key := range []string{"template_content", "template_spec_version_id", "parameters_content"} {
+		if !metadata.ResourceDiff.HasChange(key) {
+			continue
+		}
+
+		o, n := metadata.ResourceDiff.GetChange(key)
+		if key != "template_spec_version_id" {
+			// the json has to be normalized and then compared against to see if a change has occurred
+			n = utils.NormalizeJson(n)
+		}
+		if strings.EqualFold(o.(string), n.(string)) {
+			continue
+		}
+
+		if err := metadata.ResourceDiff.SetNewComputed("output_content"); err != nil {
+			return fmt.Errorf("setting `output_content` to unknown: %+v", err)
+		}
+		if err := metadata.ResourceDiff.SetNewComputed("managed_resource"); err != nil {
+			return fmt.Errorf("setting `managed_resource` to unknown: %+v", err)
+		}
+		return nil
+	}
+
+	return nil
+}
+
+type deploymentStackConfig struct {
+	ActionOnUnmanage      []DeploymentStackActionOnUnmanageModel
+	DenySettings          []DeploymentStackDenySettingsModel
+	Description           string
+	ParametersContent     string
+	TemplateContent       string
+	TemplateSpecVersionId string
+}
+
+func expandDeploymentStackProperties(input deploymentStackConfig) (*deploymentstacks.DeploymentStackProperties, error) {
+	output := deploymentstacks.DeploymentStackProperties{
+		ActionOnUnmanage: expandDeploymentStackActionOnUnmanage(input.ActionOnUnmanage),
+		DenySettings:     expandDeploymentStackDenySettings(input.DenySettings),
+	}
+
+	if input.Description != "" {
+		output.Description = pointer.To(input.Description)
+	}
+
+	if input.TemplateContent != "" {
+		template, err := expandTemplateDeploymentBody(input.TemplateContent)
+		if err != nil {
+			return nil, fmt.Errorf("expanding `template_content`: %+v", err)
+		}
+		output.Template = pointer.To[interface{}](*template)
+	}
+
+	if input.TemplateSpecVersionId != "" {
+		output.TemplateLink = &deploymentstacks.DeploymentStacksTemplateLink{
+			Id: pointer.To(input.TemplateSpecVersionId),
+		}
+	}
+
+	if input.ParametersContent != "" {
+		parameters := make(map[string]deploymentstacks.DeploymentParameter)
+		if err := json.Unmarshal([]byte(input.ParametersContent), &parameters); err != nil {
+			return nil, fmt.Errorf("expanding `parameters_content`: %+v", err)
+		}
+		output.Parameters = &parameters
+	}
+
+	return &output, nil
+}
+
+func expandDeploymentStackActionOnUnmanage(input []DeploymentStackActionOnUnmanageModel) deploymentstacks.ActionOnUnmanage {
+	if len(input) == 0 {
+		return deploymentstacks.ActionOnUnmanage{
+			Resources: deploymentstacks.DeploymentStacksDeleteDetachEnumDetach,
+		}
+	}
+
+	v := input[0]
+	output := deploymentstacks.ActionOnUnmanage{
+		Resources:      deploymentstacks.DeploymentStacksDeleteDetachEnum(v.Resources),
+		ResourceGroups: pointer.To(deploymentstacks.DeploymentStacksDeleteDetachEnum(v.ResourceGroups)),
+	}
+	if v.ManagementGroups != "" {
+		output.ManagementGroups = pointer.To(deploymentstacks.DeploymentStacksDeleteDetachEnum(v.ManagementGroups))
+	}
+
+	return output
+}
+
+func flattenDeploymentStackActionOnUnmanage(input deploymentstacks.ActionOnUnmanage, managementGroupScope bool) []DeploymentStackActionOnUnmanageModel {
+	output := DeploymentStackActionOnUnmanageModel{
+		Resources:      string(input.Resources),
+		ResourceGroups: string(deploymentstacks.DeploymentStacksDeleteDetachEnumDetach),
+	}
+
+	if input.ResourceGroups != nil {
+		output.ResourceGroups = string(*input.ResourceGroups)
+	}
+
+	if managementGroupScope {
+		output.ManagementGroups = string(deploymentstacks.DeploymentStacksDeleteDetachEnumDetach)
+		if input.ManagementGroups != nil {
+			output.ManagementGroups = string(*input.ManagementGroups)
+		}
+	}
+
+	return []DeploymentStackActionOnUnmanageModel{output}
+}
+
+func expandDeploymentStackDenySettings(input []DeploymentStackDenySettingsModel) deploymentstacks.DenySettings {
+	if len(input) == 0 {
+		return deploymentstacks.DenySettings{
+			Mode: deploymentstacks.DenySettingsModeNone,
+		}
+	}
+
+	v := input[0]
+	output := deploymentstacks.DenySettings{
+		Mode:               deploymentstacks.DenySettingsMode(v.Mode),
+		ApplyToChildScopes: pointer.To(v.ApplyToChildScopes),
+	}
+
+	if len(v.ExcludedActions) > 0 {
+		output.ExcludedActions = pointer.To(v.ExcludedActions)
+	}
+
+	if len(v.ExcludedPrincipals) > 0 {
+		output.ExcludedPrincipals = pointer.To(v.ExcludedPrincipals)
+	}
+
+	return output
+}
+
+func flattenDeploymentStackDenySettings(input deploymentstacks.DenySettings) []DeploymentStackDenySettingsModel {
+	if input.Mode == deploymentstacks.DenySettingsModeNone || input.Mode == "" {
+		return []DeploymentStackDenySettingsModel{}
+	}
+
+	return []DeploymentStackDenySettingsModel{
+		{
+			Mode:               string(input.Mode),
+			ApplyToChildScopes: pointer.From(input.ApplyToChildScopes),
+			ExcludedActions:    pointer.From(input.ExcludedActions),
+			ExcludedPrincipals: pointer.From(input.ExcludedPrincipals),
+		},
+	}
+}
+
+func flattenDeploymentStackManagedResources(input *[]deploymentstacks.ManagedResourceReference) []DeploymentStackManagedResourceModel {
+	output := make([]DeploymentStackManagedResourceModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, DeploymentStackManagedResourceModel{
+			Id:         pointer.From(v.Id),
+			Status:     string(pointer.From(v.Status)),
+			DenyStatus: string(pointer.From(v.DenyStatus)),
+		})
+	}
+
+	return output
+}
+
+func flattenDeploymentStackParameters(input *map[string]deploymentstacks.DeploymentParameter) (string, error) {
+	if input == nil || len(*input) == 0 {
+		return "", nil
+	}
+
+	// round-trip the parameters so that the `type` of each parameter returned by the API can be filtered out
+	raw, err := json.Marshal(*input)
+	if err != nil {
+		return "", fmt.Errorf("marshalling json: %+v", err)
+	}
+
+	var parameters interface{}
+	if err := json.Unmarshal(raw, &parameters); err != nil {
+		return "", fmt.Errorf("unmarshalling json: %+v", err)
+	}
+
+	output, err := flattenTemplateDeploymentBody(filterOutTemplateDeploymentParameters(parameters))
+	if err != nil {
+		return "", err
+	}
+
+	return *output, nil
+}
+
+func flattenDeploymentStackContent(input *interface{}) (string, error) {
+	output, err := flattenTemplateDeploymentBody(pointer.From(input))
+	if err != nil {
+		return "", err
+	}
+
+	return *output, nil
+}
@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2024-03-01/deploymentstacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ManagementGroupDeploymentStackResource struct{}
+
+func TestAccManagementGroupDeploymentStack_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_management_group_deployment_stack", "test")
+	r := ManagementGroupDeploymentStackResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccManagementGroupDeploymentStack_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_management_group_deployment_stack", "test")
+	r := ManagementGroupDeploymentStackResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("managed_resource.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ManagementGroupDeploymentStackResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := deploymentstacks.ParseProviders2DeploymentStackID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Resource.DeploymentStacksClient.GetAtManagementGroup(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (ManagementGroupDeploymentStackResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_management_group" "test" {
+  name = "TestAcc-Stack-%[1]d"
+}
+
+resource "azurerm_management_group_deployment_stack" "test" {
+  name                = "acctest-stack-%[1]d"
+  management_group_id = azurerm_management_group.test.id
+  location            = %[2]q
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2019-08-01/managementGroupDeploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "resources": []
+}
+TEMPLATE
+
+  action_on_unmanage {
+    resources = "delete"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (ManagementGroupDeploymentStackResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_management_group" "test" {
+  name = "TestAcc-Stack-%[1]d"
+}
+
+resource "azurerm_management_group_deployment_stack" "test" {
+  name                = "acctest-stack-%[1]d"
+  management_group_id = azurerm_management_group.test.id
+  location            = %[2]q
+  description         = "An acceptance test Deployment Stack"
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2019-08-01/managementGroupDeploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "resources": [
+    {
+      "type": "Microsoft.Authorization/policyDefinitions",
+      "apiVersion": "2021-06-01",
+      "name": "acctest-stack-policy-%[1]d",
+      "properties": {
+        "policyType": "Custom",
+        "mode": "All",
+        "displayName": "acctest-stack-policy-%[1]d",
+        "policyRule": {
+          "if": {
+            "field": "location",
+            "equals": "westus"
+          },
+          "then": {
+            "effect": "audit"
+          }
+        }
+      }
+    }
+  ]
+}
+TEMPLATE
+
+  action_on_unmanage {
+    resources         = "delete"
+    resource_groups   = "delete"
+    management_groups = "detach"
+  }
+
+  tags = {
+    environment = "test"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
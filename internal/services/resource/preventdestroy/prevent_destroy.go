@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package preventdestroy
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-05-01/managementlocks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+// ListDataFunc returns a list of the nested items (for example Blob Containers or Databases) which contain
+// data within the Resource being destroyed
+type ListDataFunc func(ctx context.Context) ([]string, error)
+
+// Check determines whether the Resource can be destroyed, as configured using the `prevent_destroy_if` block
+// within the `features` block of the Provider - returning an error if it can't be.
+//
+// listData is only called when `contains_data` is enabled and can be nil if the Resource can't contain data
+func Check(ctx context.Context, client *clients.Client, id resourceids.Id, listData ListDataFunc) error {
+	if client.Features.PreventDestroyIf.ManagementLockExists {
+		locks, err := managementLocksForResource(ctx, client.Resource.LocksClient, id)
+		if err != nil {
+			return err
+		}
+
+		if len(locks) > 0 {
+			return managementLockExistsError(id, locks)
+		}
+	}
+
+	if client.Features.PreventDestroyIf.ContainsData && listData != nil {
+		items, err := listData(ctx)
+		if err != nil {
+			return fmt.Errorf("checking whether %s contains data: %+v", id, err)
+		}
+
+		if len(items) > 0 {
+			return containsDataError(id, items)
+		}
+	}
+
+	return nil
+}
+
+func managementLocksForResource(ctx context.Context, client *managementlocks.ManagementLocksClient, id resourceids.Id) ([]string, error) {
+	// NOTE: this includes any locks inherited from the Resource Group/Subscription, which also prevent deletion
+	resp, err := client.ListByScopeComplete(ctx, commonids.NewScopeID(id.ID()), managementlocks.DefaultListByScopeOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("listing Management Locks for %s: %+v", id, err)
+	}
+
+	locks := make([]string, 0)
+	for _, item := range resp.Items {
+		locks = append(locks, fmt.Sprintf("`%s` (%s)", pointer.From(item.Id), string(item.Properties.Level)))
+	}
+
+	return locks, nil
+}
+
+func managementLockExistsError(id resourceids.Id, locks []string) error {
+	return fmt.Errorf(`deleting %[1]s: Management Locks exist for this Resource.
+
+Terraform is configured to check for Management Locks before deleting Resources - and raise an error
+if any exist to avoid unintentionally deleting this Resource.
+
+Terraform has detected that the following Management Locks apply to this Resource:
+
+%[2]s
+
+This feature is intended to avoid the unintentional destruction of Resources which have been locked
+outside of this Terraform configuration - as such you must either remove these Management Locks, or
+disable this behaviour using the feature flag 'management_lock_exists' within the 'prevent_destroy_if'
+block of the 'features' block when configuring the Provider, for example:
+
+provider "azurerm" {
+  features {
+    prevent_destroy_if {
+      management_lock_exists = false
+    }
+  }
+}
+`, id, formatItems(locks))
+}
+
+func containsDataError(id resourceids.Id, items []string) error {
+	return fmt.Errorf(`deleting %[1]s: this Resource still contains data.
+
+Terraform is configured to check whether Resources contain data before deleting them - and raise an error
+if data exists to avoid unintentionally deleting this data.
+
+Terraform has detected that the following items still exist within this Resource:
+
+%[2]s
+
+This feature is intended to avoid the unintentional destruction of data which has been written outside
+of this Terraform configuration - as such you must either remove these items, or disable this behaviour
+using the feature flag 'contains_data' within the 'prevent_destroy_if' block of the 'features' block
+when configuring the Provider, for example:
+
+provider "azurerm" {
+  features {
+    prevent_destroy_if {
+      contains_data = false
+    }
+  }
+}
+`, id, formatItems(items))
+}
+
+func formatItems(input []string) string {
+	items := make([]string, 0)
+	for _, v := range input {
+		items = append(items, fmt.Sprintf("* %s", v))
+	}
+	sort.Strings(items)
+
+	return strings.Join(items, "\n")
+}
@@ -12,6 +12,8 @@ import (
 var (
 	_ sdk.TypedServiceRegistration   = Registration{}
 	_ sdk.UntypedServiceRegistration = Registration{}
+
+	_ sdk.ServiceRegistrationWithListResources = Registration{}
 )
 
 type Registration struct{}
@@ -74,5 +76,17 @@ func (r Registration) Resources() []sdk.Resource {
 		ResourceManagementPrivateLinkResource{},
 		ResourceDeploymentScriptAzurePowerShellResource{},
 		ResourceDeploymentScriptAzureCliResource{},
+		TemplateSpecResource{},
+		TemplateSpecVersionResource{},
+		ResourceGroupDeploymentStackResource{},
+		SubscriptionDeploymentStackResource{},
+		ManagementGroupDeploymentStackResource{},
+	}
+}
+
+// ListResources returns a list of List Resources supported by this Service
+func (r Registration) ListResources() []sdk.ListResource {
+	return []sdk.ListResource{
+		resourceGroupListResource(),
 	}
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-10-01/deploymentscripts"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -35,6 +36,10 @@ func (r ResourceDeploymentScriptAzureCliResource) IDValidationFunc() pluginsdk.S
 	return deploymentscripts.ValidateDeploymentScriptID
 }
 
+func (r ResourceDeploymentScriptAzureCliResource) IdentityType() resourceids.ResourceId {
+	return &deploymentscripts.DeploymentScriptId{}
+}
+
 func (r ResourceDeploymentScriptAzureCliResource) Arguments() map[string]*pluginsdk.Schema {
 	return getDeploymentScriptArguments(AzureCliKind)
 }
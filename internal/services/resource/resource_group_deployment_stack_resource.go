@@ -0,0 +1,269 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2024-03-01/deploymentstacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var (
+	_ sdk.ResourceWithUpdate        = ResourceGroupDeploymentStackResource{}
+	_ sdk.ResourceWithCustomizeDiff = ResourceGroupDeploymentStackResource{}
+)
+
+type ResourceGroupDeploymentStackResource struct{}
+
+type ResourceGroupDeploymentStackResourceModel struct {
+	Name                  string                                 `tfschema:"name"`
+	ResourceGroupName     string                                 `tfschema:"resource_group_name"`
+	ActionOnUnmanage      []DeploymentStackActionOnUnmanageModel `tfschema:"action_on_unmanage"`
+	DenySettings          []DeploymentStackDenySettingsModel     `tfschema:"deny_settings"`
+	Description           string                                 `tfschema:"description"`
+	ParametersContent     string                                 `tfschema:"parameters_content"`
+	TemplateContent       string                                 `tfschema:"template_content"`
+	TemplateSpecVersionId string                                 `tfschema:"template_spec_version_id"`
+	Tags                  map[string]string                      `tfschema:"tags"`
+	ManagedResources      []DeploymentStackManagedResourceModel  `tfschema:"managed_resource"`
+	OutputContent         string                                 `tfschema:"output_content"`
+}
+
+func (m ResourceGroupDeploymentStackResourceModel) config() deploymentStackConfig {
+	return deploymentStackConfig{
+		ActionOnUnmanage:      m.ActionOnUnmanage,
+		DenySettings:          m.DenySettings,
+		Description:           m.Description,
+		ParametersContent:     m.ParametersContent,
+		TemplateContent:       m.TemplateContent,
+		TemplateSpecVersionId: m.TemplateSpecVersionId,
+	}
+}
+
+func (r ResourceGroupDeploymentStackResource) ModelObject() interface{} {
+	return &ResourceGroupDeploymentStackResourceModel{}
+}
+
+func (r ResourceGroupDeploymentStackResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return deploymentstacks.ValidateProviderDeploymentStackID
+}
+
+func (r ResourceGroupDeploymentStackResource) IdentityType() resourceids.ResourceId {
+	return &deploymentstacks.ProviderDeploymentStackId{}
+}
+
+func (r ResourceGroupDeploymentStackResource) ResourceType() string {
+	return "azurerm_resource_group_deployment_stack"
+}
+
+func (r ResourceGroupDeploymentStackResource) Arguments() map[string]*pluginsdk.Schema {
+	arguments := deploymentStackArguments(false)
+	arguments["resource_group_name"] = commonschema.ResourceGroupName()
+	return arguments
+}
+
+func (r ResourceGroupDeploymentStackResource) Attributes() map[string]*pluginsdk.Schema {
+	return deploymentStackAttributes()
+}
+
+func (r ResourceGroupDeploymentStackResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			return deploymentStackCustomizeDiff(metadata)
+		},
+	}
+}
+
+func (r ResourceGroupDeploymentStackResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.DeploymentStacksClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var config ResourceGroupDeploymentStackResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := deploymentstacks.NewProviderDeploymentStackID(subscriptionId, config.ResourceGroupName, config.Name)
+
+			existing, err := client.GetAtResourceGroup(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties, err := expandDeploymentStackProperties(config.config())
+			if err != nil {
+				return err
+			}
+
+			payload := deploymentstacks.DeploymentStack{
+				Properties: properties,
+				Tags:       pointer.To(config.Tags),
+			}
+
+			if err := client.ValidateStackAtResourceGroupThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("validating %s: %+v", id, err)
+			}
+
+			if err := client.CreateOrUpdateAtResourceGroupThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ResourceGroupDeploymentStackResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.DeploymentStacksClient
+
+			id, err := deploymentstacks.ParseProviderDeploymentStackID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.GetAtResourceGroup(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ResourceGroupDeploymentStackResourceModel{
+				Name:              id.DeploymentStackName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Tags = pointer.From(model.Tags)
+
+				if props := model.Properties; props != nil {
+					state.ActionOnUnmanage = flattenDeploymentStackActionOnUnmanage(props.ActionOnUnmanage, false)
+					state.DenySettings = flattenDeploymentStackDenySettings(props.DenySettings)
+					state.Description = pointer.From(props.Description)
+					state.ManagedResources = flattenDeploymentStackManagedResources(props.Resources)
+
+					if state.ParametersContent, err = flattenDeploymentStackParameters(props.Parameters); err != nil {
+						return fmt.Errorf("flattening `parameters_content`: %+v", err)
+					}
+
+					if state.OutputContent, err = flattenDeploymentStackContent(props.Outputs); err != nil {
+						return fmt.Errorf("flattening `output_content`: %+v", err)
+					}
+
+					if props.TemplateLink != nil && props.TemplateLink.Id != nil {
+						state.TemplateSpecVersionId = *props.TemplateLink.Id
+					}
+				}
+			}
+
+			if state.TemplateSpecVersionId == "" {
+				template, err := client.ExportTemplateAtResourceGroup(ctx, *id)
+				if err != nil {
+					return fmt.Errorf("retrieving the template for %s: %+v", *id, err)
+				}
+				if model := template.Model; model != nil {
+					if state.TemplateContent, err = flattenDeploymentStackContent(model.Template); err != nil {
+						return fmt.Errorf("flattening `template_content`: %+v", err)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ResourceGroupDeploymentStackResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.DeploymentStacksClient
+
+			id, err := deploymentstacks.ParseProviderDeploymentStackID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ResourceGroupDeploymentStackResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the Deployment Stack is redeployed as a whole, so the complete payload is always sent
+			properties, err := expandDeploymentStackProperties(config.config())
+			if err != nil {
+				return err
+			}
+
+			payload := deploymentstacks.DeploymentStack{
+				Properties: properties,
+				Tags:       pointer.To(config.Tags),
+			}
+
+			if err := client.ValidateStackAtResourceGroupThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("validating %s: %+v", *id, err)
+			}
+
+			if err := client.CreateOrUpdateAtResourceGroupThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ResourceGroupDeploymentStackResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.DeploymentStacksClient
+
+			id, err := deploymentstacks.ParseProviderDeploymentStackID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ResourceGroupDeploymentStackResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the managed resources are deleted or detached by ARM according to `action_on_unmanage`
+			actionOnUnmanage := expandDeploymentStackActionOnUnmanage(config.ActionOnUnmanage)
+			options := deploymentstacks.DeleteAtResourceGroupOperationOptions{
+				UnmanageActionResources:      pointer.To(deploymentstacks.UnmanageActionResourceMode(actionOnUnmanage.Resources)),
+				UnmanageActionResourceGroups: pointer.To(deploymentstacks.UnmanageActionResourceGroupMode(pointer.From(actionOnUnmanage.ResourceGroups))),
+			}
+
+			if err := client.DeleteAtResourceGroupThenPoll(ctx, *id, options); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
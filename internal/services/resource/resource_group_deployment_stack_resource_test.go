@@ -0,0 +1,278 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2024-03-01/deploymentstacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ResourceGroupDeploymentStackResource struct{}
+
+func TestAccResourceGroupDeploymentStack_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group_deployment_stack", "test")
+	r := ResourceGroupDeploymentStackResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("managed_resource.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccResourceGroupDeploymentStack_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group_deployment_stack", "test")
+	r := ResourceGroupDeploymentStackResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccResourceGroupDeploymentStack_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group_deployment_stack", "test")
+	r := ResourceGroupDeploymentStackResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("managed_resource.#").HasValue("1"),
+				check.That(data.ResourceName).Key("managed_resource.0.deny_status").HasValue("denyDelete"),
+				check.That(data.ResourceName).Key("output_content").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccResourceGroupDeploymentStack_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group_deployment_stack", "test")
+	r := ResourceGroupDeploymentStackResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccResourceGroupDeploymentStack_templateSpec(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group_deployment_stack", "test")
+	r := ResourceGroupDeploymentStackResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.templateSpec(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("managed_resource.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ResourceGroupDeploymentStackResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := deploymentstacks.ParseProviderDeploymentStackID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Resource.DeploymentStacksClient.GetAtResourceGroup(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (ResourceGroupDeploymentStackResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+locals {
+  identity_template = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {
+    "identityName": {
+      "type": "string"
+    }
+  },
+  "resources": [
+    {
+      "type": "Microsoft.ManagedIdentity/userAssignedIdentities",
+      "apiVersion": "2023-01-31",
+      "name": "[parameters('identityName')]",
+      "location": "[resourceGroup().location]"
+    }
+  ],
+  "outputs": {
+    "principalId": {
+      "type": "string",
+      "value": "[reference(parameters('identityName')).principalId]"
+    }
+  }
+}
+TEMPLATE
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r ResourceGroupDeploymentStackResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_group_deployment_stack" "test" {
+  name                = "acctest-stack-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  template_content    = local.identity_template
+
+  parameters_content = jsonencode({
+    identityName = {
+      value = "acctest-uai-%d"
+    }
+  })
+
+  action_on_unmanage {
+    resources = "delete"
+  }
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (r ResourceGroupDeploymentStackResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_group_deployment_stack" "import" {
+  name                = azurerm_resource_group_deployment_stack.test.name
+  resource_group_name = azurerm_resource_group_deployment_stack.test.resource_group_name
+  template_content    = azurerm_resource_group_deployment_stack.test.template_content
+  parameters_content  = azurerm_resource_group_deployment_stack.test.parameters_content
+
+  action_on_unmanage {
+    resources = "delete"
+  }
+}
+`, r.basic(data))
+}
+
+func (r ResourceGroupDeploymentStackResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group_deployment_stack" "test" {
+  name                = "acctest-stack-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  description         = "An acceptance test Deployment Stack"
+  template_content    = local.identity_template
+
+  parameters_content = jsonencode({
+    identityName = {
+      value = "acctest-uai-%d"
+    }
+  })
+
+  action_on_unmanage {
+    resources       = "delete"
+    resource_groups = "delete"
+  }
+
+  deny_settings {
+    mode                  = "denyDelete"
+    apply_to_child_scopes = true
+    excluded_actions      = ["Microsoft.ManagedIdentity/userAssignedIdentities/write"]
+    excluded_principals   = [data.azurerm_client_config.current.object_id]
+  }
+
+  tags = {
+    environment = "test"
+  }
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (r ResourceGroupDeploymentStackResource) templateSpec(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_template_spec" "test" {
+  name                = "acctest-ts-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_template_spec_version" "test" {
+  name             = "v1.0.0"
+  template_spec_id = azurerm_template_spec.test.id
+  main_template    = local.identity_template
+}
+
+resource "azurerm_resource_group_deployment_stack" "test" {
+  name                     = "acctest-stack-%[2]d"
+  resource_group_name      = azurerm_resource_group.test.name
+  template_spec_version_id = azurerm_template_spec_version.test.id
+
+  parameters_content = jsonencode({
+    identityName = {
+      value = "acctest-uai-%[2]d"
+    }
+  })
+
+  action_on_unmanage {
+    resources = "delete"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/resourcegroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+func resourceGroupListResource() sdk.ListResource {
+	return sdk.ListResource{
+		ResourceType: "azurerm_resource_group",
+		Resource:     resourceResourceGroup,
+		List: func(ctx context.Context, client *clients.Client, _ *string) ([]resourceids.ResourceId, error) {
+			subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
+
+			resp, err := client.Resource.ResourceGroupsClient.ListComplete(ctx, subscriptionId, resourcegroups.DefaultListOperationOptions())
+			if err != nil {
+				return nil, fmt.Errorf("listing Resource Groups within %s: %+v", subscriptionId, err)
+			}
+
+			output := make([]resourceids.ResourceId, 0)
+			for _, item := range resp.Items {
+				id, err := commonids.ParseResourceGroupIDInsensitively(pointer.From(item.Id))
+				if err != nil {
+					return nil, err
+				}
+				output = append(output, id)
+			}
+
+			return output, nil
+		},
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-05-01/privatelinkassociation"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-05-01/resourcemanagementprivatelink"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -39,6 +40,10 @@ func (r ResourceManagementPrivateLinkAssociationResource) IDValidationFunc() plu
 	return privatelinkassociation.ValidatePrivateLinkAssociationID
 }
 
+func (r ResourceManagementPrivateLinkAssociationResource) IdentityType() resourceids.ResourceId {
+	return &privatelinkassociation.PrivateLinkAssociationId{}
+}
+
 func (r ResourceManagementPrivateLinkAssociationResource) ResourceType() string {
 	return "azurerm_resource_management_private_link_association"
 }
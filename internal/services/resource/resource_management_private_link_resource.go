@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-05-01/resourcemanagementprivatelink"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -36,6 +37,10 @@ func (r ResourceManagementPrivateLinkResource) IDValidationFunc() pluginsdk.Sche
 	return resourcemanagementprivatelink.ValidateResourceManagementPrivateLinkID
 }
 
+func (r ResourceManagementPrivateLinkResource) IdentityType() resourceids.ResourceId {
+	return &resourcemanagementprivatelink.ResourceManagementPrivateLinkId{}
+}
+
 func (r ResourceManagementPrivateLinkResource) ResourceType() string {
 	return "azurerm_resource_management_private_link"
 }
@@ -0,0 +1,272 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2024-03-01/deploymentstacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var (
+	_ sdk.ResourceWithUpdate        = SubscriptionDeploymentStackResource{}
+	_ sdk.ResourceWithCustomizeDiff = SubscriptionDeploymentStackResource{}
+)
+
+type SubscriptionDeploymentStackResource struct{}
+
+type SubscriptionDeploymentStackResourceModel struct {
+	Name                  string                                 `tfschema:"name"`
+	Location              string                                 `tfschema:"location"`
+	ActionOnUnmanage      []DeploymentStackActionOnUnmanageModel `tfschema:"action_on_unmanage"`
+	DenySettings          []DeploymentStackDenySettingsModel     `tfschema:"deny_settings"`
+	Description           string                                 `tfschema:"description"`
+	ParametersContent     string                                 `tfschema:"parameters_content"`
+	TemplateContent       string                                 `tfschema:"template_content"`
+	TemplateSpecVersionId string                                 `tfschema:"template_spec_version_id"`
+	Tags                  map[string]string                      `tfschema:"tags"`
+	ManagedResources      []DeploymentStackManagedResourceModel  `tfschema:"managed_resource"`
+	OutputContent         string                                 `tfschema:"output_content"`
+}
+
+func (m SubscriptionDeploymentStackResourceModel) config() deploymentStackConfig {
+	return deploymentStackConfig{
+		ActionOnUnmanage:      m.ActionOnUnmanage,
+		DenySettings:          m.DenySettings,
+		Description:           m.Description,
+		ParametersContent:     m.ParametersContent,
+		TemplateContent:       m.TemplateContent,
+		TemplateSpecVersionId: m.TemplateSpecVersionId,
+	}
+}
+
+func (r SubscriptionDeploymentStackResource) ModelObject() interface{} {
+	return &SubscriptionDeploymentStackResourceModel{}
+}
+
+func (r SubscriptionDeploymentStackResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return deploymentstacks.ValidateDeploymentStackID
+}
+
+func (r SubscriptionDeploymentStackResource) IdentityType() resourceids.ResourceId {
+	return &deploymentstacks.DeploymentStackId{}
+}
+
+func (r SubscriptionDeploymentStackResource) ResourceType() string {
+	return "azurerm_subscription_deployment_stack"
+}
+
+func (r SubscriptionDeploymentStackResource) Arguments() map[string]*pluginsdk.Schema {
+	arguments := deploymentStackArguments(false)
+	arguments["location"] = commonschema.Location()
+	return arguments
+}
+
+func (r SubscriptionDeploymentStackResource) Attributes() map[string]*pluginsdk.Schema {
+	return deploymentStackAttributes()
+}
+
+func (r SubscriptionDeploymentStackResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			return deploymentStackCustomizeDiff(metadata)
+		},
+	}
+}
+
+func (r SubscriptionDeploymentStackResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.DeploymentStacksClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var config SubscriptionDeploymentStackResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := deploymentstacks.NewDeploymentStackID(subscriptionId, config.Name)
+
+			existing, err := client.GetAtSubscription(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties, err := expandDeploymentStackProperties(config.config())
+			if err != nil {
+				return err
+			}
+
+			payload := deploymentstacks.DeploymentStack{
+				Location:   pointer.To(location.Normalize(config.Location)),
+				Properties: properties,
+				Tags:       pointer.To(config.Tags),
+			}
+
+			if err := client.ValidateStackAtSubscriptionThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("validating %s: %+v", id, err)
+			}
+
+			if err := client.CreateOrUpdateAtSubscriptionThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r SubscriptionDeploymentStackResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.DeploymentStacksClient
+
+			id, err := deploymentstacks.ParseDeploymentStackID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.GetAtSubscription(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := SubscriptionDeploymentStackResourceModel{
+				Name: id.DeploymentStackName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.NormalizeNilable(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				if props := model.Properties; props != nil {
+					state.ActionOnUnmanage = flattenDeploymentStackActionOnUnmanage(props.ActionOnUnmanage, false)
+					state.DenySettings = flattenDeploymentStackDenySettings(props.DenySettings)
+					state.Description = pointer.From(props.Description)
+					state.ManagedResources = flattenDeploymentStackManagedResources(props.Resources)
+
+					if state.ParametersContent, err = flattenDeploymentStackParameters(props.Parameters); err != nil {
+						return fmt.Errorf("flattening `parameters_content`: %+v", err)
+					}
+
+					if state.OutputContent, err = flattenDeploymentStackContent(props.Outputs); err != nil {
+						return fmt.Errorf("flattening `output_content`: %+v", err)
+					}
+
+					if props.TemplateLink != nil && props.TemplateLink.Id != nil {
+						state.TemplateSpecVersionId = *props.TemplateLink.Id
+					}
+				}
+			}
+
+			if state.TemplateSpecVersionId == "" {
+				template, err := client.ExportTemplateAtSubscription(ctx, *id)
+				if err != nil {
+					return fmt.Errorf("retrieving the template for %s: %+v", *id, err)
+				}
+				if model := template.Model; model != nil {
+					if state.TemplateContent, err = flattenDeploymentStackContent(model.Template); err != nil {
+						return fmt.Errorf("flattening `template_content`: %+v", err)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r SubscriptionDeploymentStackResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.DeploymentStacksClient
+
+			id, err := deploymentstacks.ParseDeploymentStackID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config SubscriptionDeploymentStackResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the Deployment Stack is redeployed as a whole, so the complete payload is always sent
+			properties, err := expandDeploymentStackProperties(config.config())
+			if err != nil {
+				return err
+			}
+
+			payload := deploymentstacks.DeploymentStack{
+				Location:   pointer.To(location.Normalize(config.Location)),
+				Properties: properties,
+				Tags:       pointer.To(config.Tags),
+			}
+
+			if err := client.ValidateStackAtSubscriptionThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("validating %s: %+v", *id, err)
+			}
+
+			if err := client.CreateOrUpdateAtSubscriptionThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r SubscriptionDeploymentStackResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.DeploymentStacksClient
+
+			id, err := deploymentstacks.ParseDeploymentStackID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config SubscriptionDeploymentStackResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the managed resources are deleted or detached by ARM according to `action_on_unmanage`
+			actionOnUnmanage := expandDeploymentStackActionOnUnmanage(config.ActionOnUnmanage)
+			options := deploymentstacks.DeleteAtSubscriptionOperationOptions{
+				UnmanageActionResources:      pointer.To(deploymentstacks.UnmanageActionResourceMode(actionOnUnmanage.Resources)),
+				UnmanageActionResourceGroups: pointer.To(deploymentstacks.UnmanageActionResourceGroupMode(pointer.From(actionOnUnmanage.ResourceGroups))),
+			}
+
+			if err := client.DeleteAtSubscriptionThenPoll(ctx, *id, options); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,365 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-02-01/templatespecs"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-02-01/templatespecversions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ sdk.ResourceWithUpdate = TemplateSpecVersionResource{}
+
+type TemplateSpecVersionResource struct{}
+
+type TemplateSpecVersionResourceModel struct {
+	Name             string                                   `tfschema:"name"`
+	TemplateSpecId   string                                   `tfschema:"template_spec_id"`
+	Description      string                                   `tfschema:"description"`
+	MainTemplate     string                                   `tfschema:"main_template"`
+	LinkedTemplates  []TemplateSpecVersionLinkedTemplateModel `tfschema:"linked_templates"`
+	UiFormDefinition string                                   `tfschema:"ui_form_definition"`
+	Tags             map[string]string                        `tfschema:"tags"`
+}
+
+type TemplateSpecVersionLinkedTemplateModel struct {
+	Path     string `tfschema:"path"`
+	Template string `tfschema:"template"`
+}
+
+func (r TemplateSpecVersionResource) ModelObject() interface{} {
+	return &TemplateSpecVersionResourceModel{}
+}
+
+func (r TemplateSpecVersionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return templatespecversions.ValidateTemplateSpecVersionID
+}
+
+func (r TemplateSpecVersionResource) IdentityType() resourceids.ResourceId {
+	return &templatespecversions.TemplateSpecVersionId{}
+}
+
+func (r TemplateSpecVersionResource) ResourceType() string {
+	return "azurerm_template_spec_version"
+}
+
+func (r TemplateSpecVersionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.TemplateSpecVersionName,
+		},
+
+		"template_spec_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: templatespecs.ValidateTemplateSpecID,
+		},
+
+		"main_template": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsJSON,
+			StateFunc:    utils.NormalizeJson,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 4096),
+		},
+
+		"linked_templates": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"path": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"template": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsJSON,
+						StateFunc:    utils.NormalizeJson,
+					},
+				},
+			},
+		},
+
+		"ui_form_definition": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsJSON,
+			StateFunc:    utils.NormalizeJson,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r TemplateSpecVersionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r TemplateSpecVersionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.TemplateSpecsVersionsClient
+			templateSpecsClient := metadata.Client.Resource.TemplateSpecsClient
+
+			var config TemplateSpecVersionResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			templateSpecId, err := templatespecs.ParseTemplateSpecID(config.TemplateSpecId)
+			if err != nil {
+				return err
+			}
+
+			id := templatespecversions.NewTemplateSpecVersionID(templateSpecId.SubscriptionId, templateSpecId.ResourceGroupName, templateSpecId.TemplateSpecName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			// a Template Spec Version must be in the same location as the Template Spec
+			templateSpec, err := templateSpecsClient.Get(ctx, *templateSpecId, templatespecs.DefaultGetOperationOptions())
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *templateSpecId, err)
+			}
+			if templateSpec.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *templateSpecId)
+			}
+
+			properties, err := expandTemplateSpecVersionProperties(config)
+			if err != nil {
+				return err
+			}
+
+			payload := templatespecversions.TemplateSpecVersion{
+				Location:   templateSpec.Model.Location,
+				Properties: *properties,
+				Tags:       pointer.To(config.Tags),
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r TemplateSpecVersionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.TemplateSpecsVersionsClient
+
+			id, err := templatespecversions.ParseTemplateSpecVersionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := TemplateSpecVersionResourceModel{
+				Name:           id.VersionName,
+				TemplateSpecId: templatespecs.NewTemplateSpecID(id.SubscriptionId, id.ResourceGroupName, id.TemplateSpecName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				props := model.Properties
+				state.Description = pointer.From(props.Description)
+				state.Tags = pointer.From(model.Tags)
+
+				mainTemplate, err := flattenTemplateDeploymentBody(pointer.From(props.MainTemplate))
+				if err != nil {
+					return fmt.Errorf("flattening `main_template`: %+v", err)
+				}
+				state.MainTemplate = *mainTemplate
+
+				linkedTemplates, err := flattenTemplateSpecVersionLinkedTemplates(props.LinkedTemplates)
+				if err != nil {
+					return err
+				}
+				state.LinkedTemplates = linkedTemplates
+
+				if props.UiFormDefinition != nil {
+					uiFormDefinition, err := flattenTemplateDeploymentBody(*props.UiFormDefinition)
+					if err != nil {
+						return fmt.Errorf("flattening `ui_form_definition`: %+v", err)
+					}
+					state.UiFormDefinition = *uiFormDefinition
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r TemplateSpecVersionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.TemplateSpecsVersionsClient
+
+			id, err := templatespecversions.ParseTemplateSpecVersionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config TemplateSpecVersionResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			// the content of a Template Spec Version is replaced as a whole, so the complete payload is always sent
+			properties, err := expandTemplateSpecVersionProperties(config)
+			if err != nil {
+				return err
+			}
+			properties.Metadata = existing.Model.Properties.Metadata
+
+			payload := *existing.Model
+			payload.Properties = *properties
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = pointer.To(config.Tags)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r TemplateSpecVersionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.TemplateSpecsVersionsClient
+
+			id, err := templatespecversions.ParseTemplateSpecVersionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandTemplateSpecVersionProperties(input TemplateSpecVersionResourceModel) (*templatespecversions.TemplateSpecVersionProperties, error) {
+	mainTemplate, err := expandTemplateDeploymentBody(input.MainTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("expanding `main_template`: %+v", err)
+	}
+
+	output := templatespecversions.TemplateSpecVersionProperties{
+		MainTemplate: pointer.To[interface{}](*mainTemplate),
+	}
+
+	if input.Description != "" {
+		output.Description = pointer.To(input.Description)
+	}
+
+	if len(input.LinkedTemplates) > 0 {
+		linkedTemplates := make([]templatespecversions.LinkedTemplateArtifact, 0)
+		for i, v := range input.LinkedTemplates {
+			template, err := expandTemplateDeploymentBody(v.Template)
+			if err != nil {
+				return nil, fmt.Errorf("expanding `linked_templates.%d.template`: %+v", i, err)
+			}
+
+			linkedTemplates = append(linkedTemplates, templatespecversions.LinkedTemplateArtifact{
+				Path:     v.Path,
+				Template: *template,
+			})
+		}
+		output.LinkedTemplates = &linkedTemplates
+	}
+
+	if input.UiFormDefinition != "" {
+		uiFormDefinition, err := expandTemplateDeploymentBody(input.UiFormDefinition)
+		if err != nil {
+			return nil, fmt.Errorf("expanding `ui_form_definition`: %+v", err)
+		}
+		output.UiFormDefinition = pointer.To[interface{}](*uiFormDefinition)
+	}
+
+	return &output, nil
+}
+
+func flattenTemplateSpecVersionLinkedTemplates(input *[]templatespecversions.LinkedTemplateArtifact) ([]TemplateSpecVersionLinkedTemplateModel, error) {
+	output := make([]TemplateSpecVersionLinkedTemplateModel, 0)
+	if input == nil {
+		return output, nil
+	}
+
+	for i, v := range *input {
+		template, err := flattenTemplateDeploymentBody(v.Template)
+		if err != nil {
+			return nil, fmt.Errorf("flattening `linked_templates.%d.template`: %+v", i, err)
+		}
+
+		output = append(output, TemplateSpecVersionLinkedTemplateModel{
+			Path:     v.Path,
+			Template: *template,
+		})
+	}
+
+	return output, nil
+}
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import "testing"
+
+func TestDeploymentStackName(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			// empty
+			Input: "",
+			Valid: false,
+		},
+		{
+			// invalid char
+			Input: "stack/name",
+			Valid: false,
+		},
+		{
+			// too long - 91 chars
+			Input: "0123456789012345678901234567890123456789012345678901234567890123456789012345678901234567890",
+			Valid: false,
+		},
+		{
+			// max length - 90 chars
+			Input: "012345678901234567890123456789012345678901234567890123456789012345678901234567890123456789",
+			Valid: true,
+		},
+		{
+			// valid special
+			Input: "stack_(1).v-2",
+			Valid: true,
+		},
+		{
+			// sensible value
+			Input: "production-workload",
+			Valid: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing value %s", tc.Input)
+		_, errors := DeploymentStackName(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
 	sentinelmetadata "github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-10-01-preview/metadata"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -348,6 +349,10 @@ func (a MetadataResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return sentinelmetadata.ValidateMetadataID
 }
 
+func (a MetadataResource) IdentityType() resourceids.ResourceId {
+	return &sentinelmetadata.MetadataId{}
+}
+
 func (a MetadataResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
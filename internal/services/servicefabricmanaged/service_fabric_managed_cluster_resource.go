@@ -14,6 +14,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicefabricmanagedcluster/2021-05-01/managedcluster"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicefabricmanagedcluster/2021-05-01/nodetype"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -552,6 +553,10 @@ func (k ClusterResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return managedcluster.ValidateManagedClusterID
 }
 
+func (k ClusterResource) IdentityType() resourceids.ResourceId {
+	return &managedcluster.ManagedClusterId{}
+}
+
 func flattenClusterProperties(cluster *managedcluster.ManagedCluster) *ClusterResourceModel {
 	model := &ClusterResourceModel{}
 	if cluster == nil {
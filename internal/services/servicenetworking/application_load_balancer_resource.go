@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicenetworking/2023-11-01/trafficcontrollerinterface"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -72,6 +73,10 @@ func (t ApplicationLoadBalancerResource) IDValidationFunc() pluginsdk.SchemaVali
 	return trafficcontrollerinterface.ValidateTrafficControllerID
 }
 
+func (t ApplicationLoadBalancerResource) IdentityType() resourceids.ResourceId {
+	return &trafficcontrollerinterface.TrafficControllerId{}
+}
+
 func (t ApplicationLoadBalancerResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
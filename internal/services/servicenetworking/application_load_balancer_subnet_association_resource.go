@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicenetworking/2023-11-01/associationsinterface"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicenetworking/2023-11-01/trafficcontrollerinterface"
@@ -64,6 +65,10 @@ func (t ApplicationLoadBalancerSubnetAssociationResource) IDValidationFunc() plu
 	return associationsinterface.ValidateAssociationID
 }
 
+func (t ApplicationLoadBalancerSubnetAssociationResource) IdentityType() resourceids.ResourceId {
+	return &associationsinterface.AssociationId{}
+}
+
 func (t ApplicationLoadBalancerSubnetAssociationResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
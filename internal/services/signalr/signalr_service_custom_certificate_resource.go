@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/signalr/2023-02-01/signalr"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -243,6 +244,10 @@ func (r CustomCertSignalrServiceResource) IDValidationFunc() pluginsdk.SchemaVal
 	return signalr.ValidateCustomCertificateID
 }
 
+func (r CustomCertSignalrServiceResource) IdentityType() resourceids.ResourceId {
+	return &signalr.CustomCertificateId{}
+}
+
 func signalrServiceCustomCertificateDeleteRefreshFunc(ctx context.Context, client *signalr.SignalRClient, id signalr.CustomCertificateId) pluginsdk.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		res, err := client.CustomCertificatesGet(ctx, id)
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/signalr/2023-02-01/signalr"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -237,6 +238,10 @@ func (r CustomDomainSignalrServiceResource) IDValidationFunc() pluginsdk.SchemaV
 	return signalr.ValidateCustomDomainID
 }
 
+func (r CustomDomainSignalrServiceResource) IdentityType() resourceids.ResourceId {
+	return &signalr.CustomDomainId{}
+}
+
 func signalrServiceCustomDomainProvisioningStateRefreshFunc(ctx context.Context, client *signalr.SignalRClient, id signalr.CustomDomainId) pluginsdk.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		res, err := client.CustomDomainsGet(ctx, id)
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/webpubsub/2023-02-01/webpubsub"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -238,6 +239,10 @@ func (r CustomCertWebPubsubResource) IDValidationFunc() pluginsdk.SchemaValidate
 	return webpubsub.ValidateCustomCertificateID
 }
 
+func (r CustomCertWebPubsubResource) IdentityType() resourceids.ResourceId {
+	return &webpubsub.CustomCertificateId{}
+}
+
 func webPubsubCustomCertificateDeleteRefreshFunc(ctx context.Context, client *webpubsub.WebPubSubClient, id webpubsub.CustomCertificateId) pluginsdk.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		res, err := client.CustomCertificatesGet(ctx, id)
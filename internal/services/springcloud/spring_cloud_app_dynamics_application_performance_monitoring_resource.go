@@ -51,6 +51,10 @@ func (s SpringCloudAppDynamicsApplicationPerformanceMonitoringResource) IDValida
 	return appplatform.ValidateApmID
 }
 
+func (s SpringCloudAppDynamicsApplicationPerformanceMonitoringResource) IdentityType() resourceids.ResourceId {
+	return &appplatform.ApmId{}
+}
+
 func (s SpringCloudAppDynamicsApplicationPerformanceMonitoringResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
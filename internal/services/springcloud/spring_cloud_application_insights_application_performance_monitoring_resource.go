@@ -48,6 +48,10 @@ func (s SpringCloudApplicationInsightsApplicationPerformanceMonitoringResource)
 	return appplatform.ValidateApmID
 }
 
+func (s SpringCloudApplicationInsightsApplicationPerformanceMonitoringResource) IdentityType() resourceids.ResourceId {
+	return &appplatform.ApmId{}
+}
+
 func (s SpringCloudApplicationInsightsApplicationPerformanceMonitoringResource) Arguments() map[string]*schema.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/appplatform/2024-01-01-preview/appplatform"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -72,6 +73,10 @@ func (s SpringCloudCustomizedAcceleratorResource) IDValidationFunc() pluginsdk.S
 	return appplatform.ValidateCustomizedAcceleratorID
 }
 
+func (s SpringCloudCustomizedAcceleratorResource) IdentityType() resourceids.ResourceId {
+	return &appplatform.CustomizedAcceleratorId{}
+}
+
 func (s SpringCloudCustomizedAcceleratorResource) StateUpgraders() sdk.StateUpgradeData {
 	return sdk.StateUpgradeData{
 		SchemaVersion: 1,
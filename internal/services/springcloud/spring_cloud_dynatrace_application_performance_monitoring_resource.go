@@ -47,6 +47,10 @@ func (s SpringCloudDynatraceApplicationPerformanceMonitoringResource) IDValidati
 	return appplatform.ValidateApmID
 }
 
+func (s SpringCloudDynatraceApplicationPerformanceMonitoringResource) IdentityType() resourceids.ResourceId {
+	return &appplatform.ApmId{}
+}
+
 func (s SpringCloudDynatraceApplicationPerformanceMonitoringResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -45,6 +45,10 @@ func (s SpringCloudElasticApplicationPerformanceMonitoringResource) IDValidation
 	return appplatform.ValidateApmID
 }
 
+func (s SpringCloudElasticApplicationPerformanceMonitoringResource) IdentityType() resourceids.ResourceId {
+	return &appplatform.ApmId{}
+}
+
 func (s SpringCloudElasticApplicationPerformanceMonitoringResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
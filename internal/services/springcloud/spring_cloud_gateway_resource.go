@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/appplatform/2024-01-01-preview/appplatform"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/springcloud/migration"
@@ -95,6 +96,10 @@ func (s SpringCloudGatewayResource) IDValidationFunc() pluginsdk.SchemaValidateF
 	return appplatform.ValidateGatewayID
 }
 
+func (s SpringCloudGatewayResource) IdentityType() resourceids.ResourceId {
+	return &appplatform.GatewayId{}
+}
+
 func (s SpringCloudGatewayResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -53,6 +53,10 @@ func (s SpringCloudNewRelicApplicationPerformanceMonitoringResource) IDValidatio
 	return appplatform.ValidateApmID
 }
 
+func (s SpringCloudNewRelicApplicationPerformanceMonitoringResource) IdentityType() resourceids.ResourceId {
+	return &appplatform.ApmId{}
+}
+
 func (s SpringCloudNewRelicApplicationPerformanceMonitoringResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -12,8 +12,8 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagesync/2020-03-01/serverendpointresource"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagesync/2020-03-01/storagesyncservicesresource"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagesync/2020-03-01/syncgroupresource"
-	"github.com/hashicorp/go-azure-sdk/sdk/auth"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
@@ -31,7 +31,9 @@ type Client struct {
 	SyncServerEndpointsClient  *serverendpointresource.ServerEndpointResourceClient
 	SyncServiceClient          *storagesyncservicesresource.StorageSyncServicesResourceClient
 
-	authConfigForAzureAD *auth.Credentials
+	// authorizerFuncForAzureAD and storageApiForAzureAD are only set when authenticating to the Data Plane using Azure AD
+	authorizerFuncForAzureAD common.ApiAuthorizerFunc
+	storageApiForAzureAD     environments.Api
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -94,7 +96,8 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 
 	if o.StorageUseAzureAD {
-		client.authConfigForAzureAD = o.AuthConfig
+		client.authorizerFuncForAzureAD = o.Authorizers.AuthorizerFunc
+		client.storageApiForAzureAD = o.Environment.Storage
 	}
 
 	return &client, nil
@@ -69,6 +69,17 @@ func (w DataPlaneStorageContainerWrapper) Get(ctx context.Context, containerName
 	}, nil
 }
 
+func (w DataPlaneStorageContainerWrapper) HasBlobs(ctx context.Context, containerName string) (bool, error) {
+	input := containers.ListBlobsInput{
+		MaxResults: pointer.To(1),
+	}
+	resp, err := w.client.ListBlobs(ctx, containerName, input)
+	if err != nil {
+		return false, fmt.Errorf("listing blobs: %+v", err)
+	}
+	return len(resp.Blobs.Blobs) > 0, nil
+}
+
 func (w DataPlaneStorageContainerWrapper) UpdateAccessLevel(ctx context.Context, containerName string, level containers.AccessLevel) error {
 	input := containers.SetAccessControlInput{
 		AccessLevel: level,
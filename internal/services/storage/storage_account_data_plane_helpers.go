@@ -10,6 +10,11 @@ import (
 	"slices"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/blobcontainers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/fileshares"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/queueservice"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/storageaccounts"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/client"
@@ -21,6 +26,7 @@ type storageAccountServiceSupportLevel struct {
 	supportQueue         bool
 	supportShare         bool
 	supportStaticWebsite bool
+	supportTable         bool
 }
 
 func availableFunctionalityForAccount(kind storageaccounts.Kind, tier storageaccounts.SkuTier, replicationType string) storageAccountServiceSupportLevel {
@@ -43,6 +49,9 @@ func availableFunctionalityForAccount(kind storageaccounts.Kind, tier storageacc
 			// GZRS and RAGZRS is invalid, while ZRS is valid but has no file endpoint.
 			slices.Contains([]string{"LRS", "GRS", "RAGRS"}, replicationType))))
 
+	// Table is supported for the same account kinds, sku tiers and replication types as Queue.
+	supportTable := supportQueue
+
 	// Static Website is only supported for StorageV2 (not for Storage(v1)) and BlockBlobStorage
 	supportStaticWebSite := kind == storageaccounts.KindStorageVTwo || kind == storageaccounts.KindBlockBlobStorage
 
@@ -51,6 +60,7 @@ func availableFunctionalityForAccount(kind storageaccounts.Kind, tier storageacc
 		supportQueue:         supportQueue,
 		supportShare:         supportShare,
 		supportStaticWebsite: supportStaticWebSite,
+		supportTable:         supportTable,
 	}
 }
 
@@ -107,3 +117,74 @@ func waitForDataPlaneToBecomeAvailableForAccount(ctx context.Context, client *cl
 
 	return nil
 }
+
+// storageAccountDataItems returns the non-empty Containers, and the File Shares, Queues and Tables which exist within the Storage Account,
+// only listing the services which are supported by the kind, tier and replication type of the Storage Account
+func storageAccountDataItems(ctx context.Context, client *client.Client, id commonids.StorageAccountId, supportLevel storageAccountServiceSupportLevel) ([]string, error) {
+	items := make([]string, 0)
+
+	if supportLevel.supportBlob {
+		containers, err := client.ResourceManager.BlobContainers.ListComplete(ctx, id, blobcontainers.DefaultListOperationOptions())
+		if err != nil {
+			return nil, fmt.Errorf("listing Containers: %+v", err)
+		}
+
+		if len(containers.Items) > 0 {
+			account, err := client.FindAccount(ctx, id.SubscriptionId, id.StorageAccountName)
+			if err != nil {
+				return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if account == nil {
+				return nil, fmt.Errorf("unable to locate %s", id)
+			}
+
+			containersDataPlaneClient, err := client.ContainersDataPlaneClient(ctx, *account, client.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return nil, fmt.Errorf("building Containers Client: %+v", err)
+			}
+
+			for _, item := range containers.Items {
+				name := pointer.From(item.Name)
+				hasBlobs, err := containersDataPlaneClient.HasBlobs(ctx, name)
+				if err != nil {
+					return nil, fmt.Errorf("checking whether Container %q contains any Blobs: %+v", name, err)
+				}
+				if hasBlobs {
+					items = append(items, fmt.Sprintf("Container %q", name))
+				}
+			}
+		}
+	}
+
+	if supportLevel.supportShare {
+		shares, err := client.ResourceManager.FileShares.ListComplete(ctx, id, fileshares.DefaultListOperationOptions())
+		if err != nil {
+			return nil, fmt.Errorf("listing File Shares: %+v", err)
+		}
+		for _, item := range shares.Items {
+			items = append(items, fmt.Sprintf("File Share %q", pointer.From(item.Name)))
+		}
+	}
+
+	if supportLevel.supportQueue {
+		queues, err := client.ResourceManager.QueueService.QueueListComplete(ctx, id, queueservice.DefaultQueueListOperationOptions())
+		if err != nil {
+			return nil, fmt.Errorf("listing Queues: %+v", err)
+		}
+		for _, item := range queues.Items {
+			items = append(items, fmt.Sprintf("Queue %q", pointer.From(item.Name)))
+		}
+	}
+
+	if supportLevel.supportTable {
+		tables, err := client.ResourceManager.TableService.TableListComplete(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("listing Tables: %+v", err)
+		}
+		for _, item := range tables.Items {
+			items = append(items, fmt.Sprintf("Table %q", pointer.From(item.Name)))
+		}
+	}
+
+	return items, nil
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/storageaccounts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+func storageAccountListResource() sdk.ListResource {
+	return sdk.ListResource{
+		ResourceType:        "azurerm_storage_account",
+		Resource:            resourceStorageAccount,
+		ResourceGroupScoped: true,
+		List: func(ctx context.Context, client *clients.Client, resourceGroupName *string) ([]resourceids.ResourceId, error) {
+			var items []storageaccounts.StorageAccount
+			if resourceGroupName != nil {
+				resourceGroupId := commonids.NewResourceGroupID(client.Account.SubscriptionId, *resourceGroupName)
+				resp, err := client.Storage.ResourceManager.StorageAccounts.ListByResourceGroupComplete(ctx, resourceGroupId)
+				if err != nil {
+					return nil, fmt.Errorf("listing Storage Accounts within %s: %+v", resourceGroupId, err)
+				}
+				items = resp.Items
+			} else {
+				subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
+				resp, err := client.Storage.ResourceManager.StorageAccounts.ListComplete(ctx, subscriptionId)
+				if err != nil {
+					return nil, fmt.Errorf("listing Storage Accounts within %s: %+v", subscriptionId, err)
+				}
+				items = resp.Items
+			}
+
+			output := make([]resourceids.ResourceId, 0)
+			for _, item := range items {
+				id, err := commonids.ParseStorageAccountIDInsensitively(pointer.From(item.Id))
+				if err != nil {
+					return nil, err
+				}
+				output = append(output, id)
+			}
+
+			return output, nil
+		},
+	}
+}
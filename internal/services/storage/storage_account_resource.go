@@ -34,6 +34,7 @@ import (
 	managedHsmParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/managedhsm/parse"
 	managedHsmValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/managedhsm/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/preventdestroy"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/helpers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
@@ -78,10 +79,9 @@ func resourceStorageAccount() *pluginsdk.Resource {
 			3: migration.AccountV3ToV4{},
 		}),
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := commonids.ParseStorageAccountID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&commonids.StorageAccountId{}),
+
+		Identity: pluginsdk.GenerateIdentitySchema(&commonids.StorageAccountId{}),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
@@ -2038,6 +2038,10 @@ func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 	d.Set("name", id.StorageAccountName)
 	d.Set("resource_group_name", id.ResourceGroupName)
 
+	if err := pluginsdk.SetResourceIdentityData(d, id); err != nil {
+		return err
+	}
+
 	supportLevel := storageAccountServiceSupportLevel{
 		supportBlob:          false,
 		supportQueue:         false,
@@ -2296,6 +2300,13 @@ func resourceStorageAccountDelete(d *pluginsdk.ResourceData, meta interface{}) e
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
+	if err := preventdestroy.Check(ctx, meta.(*clients.Client), id, func(ctx context.Context) ([]string, error) {
+		supportLevel := availableFunctionalityForAccount(storageaccounts.Kind(d.Get("account_kind").(string)), storageaccounts.SkuTier(d.Get("account_tier").(string)), d.Get("account_replication_type").(string))
+		return storageAccountDataItems(ctx, storageClient, *id, supportLevel)
+	}); err != nil {
+		return err
+	}
+
 	// the networking api's only allow a single change to be made to a network layout at once, so let's lock to handle that
 	virtualNetworkNames := make([]string, 0)
 	if model := existing.Model; model != nil && model.Properties != nil {
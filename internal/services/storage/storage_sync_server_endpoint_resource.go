@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagesync/2020-03-01/registeredserverresource"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagesync/2020-03-01/serverendpointresource"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
@@ -43,6 +44,10 @@ func (r SyncServerEndpointResource) IDValidationFunc() pluginsdk.SchemaValidateF
 	return serverendpointresource.ValidateServerEndpointID
 }
 
+func (r SyncServerEndpointResource) IdentityType() resourceids.ResourceId {
+	return &serverendpointresource.ServerEndpointId{}
+}
+
 func (r SyncServerEndpointResource) ResourceType() string {
 	return "azurerm_storage_sync_server_endpoint"
 }
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2020-03-01/streamingjobs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2021-10-01-preview/outputs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -249,6 +250,10 @@ func (r OutputCosmosDBResource) IDValidationFunc() pluginsdk.SchemaValidateFunc
 	return outputs.ValidateOutputID
 }
 
+func (r OutputCosmosDBResource) IdentityType() resourceids.ResourceId {
+	return &outputs.OutputId{}
+}
+
 func (r OutputCosmosDBResource) Update() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2020-03-01/streamingjobs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2021-10-01-preview/outputs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -334,6 +335,10 @@ func (r OutputPowerBIResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return outputs.ValidateOutputID
 }
 
+func (r OutputPowerBIResource) IdentityType() resourceids.ResourceId {
+	return &outputs.OutputId{}
+}
+
 func (r OutputPowerBIResource) CustomImporter() sdk.ResourceRunFunc {
 	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 		id, err := outputs.ParseOutputID(metadata.ResourceData.Id())
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2020-03-01/inputs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2020-03-01/streamingjobs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -366,6 +367,10 @@ func (r StreamInputEventHubV2Resource) IDValidationFunc() pluginsdk.SchemaValida
 	return inputs.ValidateInputID
 }
 
+func (r StreamInputEventHubV2Resource) IdentityType() resourceids.ResourceId {
+	return &inputs.InputId{}
+}
+
 func (r StreamInputEventHubV2Resource) CustomImporter() sdk.ResourceRunFunc {
 	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 		id, err := inputs.ParseInputID(metadata.ResourceData.Id())
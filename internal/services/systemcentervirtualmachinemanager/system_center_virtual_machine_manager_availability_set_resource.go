@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/extendedlocation/2021-08-15/customlocations"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/systemcentervirtualmachinemanager/2023-10-07/availabilitysets"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/systemcentervirtualmachinemanager/2023-10-07/vmmservers"
@@ -43,6 +44,10 @@ func (r SystemCenterVirtualMachineManagerAvailabilitySetResource) IDValidationFu
 	return availabilitysets.ValidateAvailabilitySetID
 }
 
+func (r SystemCenterVirtualMachineManagerAvailabilitySetResource) IdentityType() resourceids.ResourceId {
+	return &availabilitysets.AvailabilitySetId{}
+}
+
 func (r SystemCenterVirtualMachineManagerAvailabilitySetResource) ResourceType() string {
 	return "azurerm_system_center_virtual_machine_manager_availability_set"
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/extendedlocation/2021-08-15/customlocations"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/systemcentervirtualmachinemanager/2023-10-07/clouds"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/systemcentervirtualmachinemanager/2023-10-07/inventoryitems"
@@ -43,6 +44,10 @@ func (r SystemCenterVirtualMachineManagerCloudResource) IDValidationFunc() plugi
 	return clouds.ValidateCloudID
 }
 
+func (r SystemCenterVirtualMachineManagerCloudResource) IdentityType() resourceids.ResourceId {
+	return &clouds.CloudId{}
+}
+
 func (r SystemCenterVirtualMachineManagerCloudResource) ResourceType() string {
 	return "azurerm_system_center_virtual_machine_manager_cloud"
 }
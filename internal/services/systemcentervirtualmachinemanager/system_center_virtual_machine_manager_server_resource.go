@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/extendedlocation/2021-08-15/customlocations"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/systemcentervirtualmachinemanager/2023-10-07/inventoryitems"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/systemcentervirtualmachinemanager/2023-10-07/vmmservers"
@@ -46,6 +47,10 @@ func (r SystemCenterVirtualMachineManagerServerResource) IDValidationFunc() plug
 	return vmmservers.ValidateVMmServerID
 }
 
+func (r SystemCenterVirtualMachineManagerServerResource) IdentityType() resourceids.ResourceId {
+	return &vmmservers.VMmServerId{}
+}
+
 func (r SystemCenterVirtualMachineManagerServerResource) ResourceType() string {
 	return "azurerm_system_center_virtual_machine_manager_server"
 }
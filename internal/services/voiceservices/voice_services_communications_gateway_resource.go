@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/voiceservices/2023-04-03/communicationsgateways"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -92,6 +93,10 @@ func (r CommunicationsGatewayResource) IDValidationFunc() pluginsdk.SchemaValida
 	return communicationsgateways.ValidateCommunicationsGatewayID
 }
 
+func (r CommunicationsGatewayResource) IdentityType() resourceids.ResourceId {
+	return &communicationsgateways.CommunicationsGatewayId{}
+}
+
 func (r CommunicationsGatewayResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
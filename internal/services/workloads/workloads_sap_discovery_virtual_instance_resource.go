@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourcegroups"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/workloads/2023-04-01/sapvirtualinstances"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
@@ -53,6 +54,10 @@ func (r WorkloadsSAPDiscoveryVirtualInstanceResource) IDValidationFunc() plugins
 	return sapvirtualinstances.ValidateSapVirtualInstanceID
 }
 
+func (r WorkloadsSAPDiscoveryVirtualInstanceResource) IdentityType() resourceids.ResourceId {
+	return &sapvirtualinstances.SapVirtualInstanceId{}
+}
+
 func (r WorkloadsSAPDiscoveryVirtualInstanceResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
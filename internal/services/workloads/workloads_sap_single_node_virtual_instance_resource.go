@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourcegroups"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/workloads/2023-04-01/sapvirtualinstances"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
@@ -103,6 +104,10 @@ func (r WorkloadsSAPSingleNodeVirtualInstanceResource) IDValidationFunc() plugin
 	return sapvirtualinstances.ValidateSapVirtualInstanceID
 }
 
+func (r WorkloadsSAPSingleNodeVirtualInstanceResource) IdentityType() resourceids.ResourceId {
+	return &sapvirtualinstances.SapVirtualInstanceId{}
+}
+
 func (r WorkloadsSAPSingleNodeVirtualInstanceResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
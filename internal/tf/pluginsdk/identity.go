@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pluginsdk
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type ResourceIdentity = schema.ResourceIdentity
+
+// identityField maps a single Resource Identity attribute to the Resource ID Segment it represents
+type identityField struct {
+	attributeName string
+	segmentName   string
+}
+
+// identityFieldsForResourceId returns the Resource Identity attributes for the specified Resource ID type.
+//
+// Each user-specifiable Segment (e.g. the Subscription ID, Resource Group Name and any user-specified names)
+// becomes an attribute named for that Segment in snake_case - with the final user-specified name exposed as
+// `name`, such that a Virtual Network ID becomes `subscription_id`, `resource_group_name` and `name`.
+func identityFieldsForResourceId(id resourceids.ResourceId) []identityField {
+	output := make([]identityField, 0)
+	nameIndex := -1
+	for _, segment := range id.Segments() {
+		switch segment.Type {
+		case resourceids.ConstantSegmentType, resourceids.ScopeSegmentType, resourceids.SubscriptionIdSegmentType:
+			// these are user-specifiable, but are never the name of the Resource
+		case resourceids.ResourceGroupSegmentType, resourceids.UserSpecifiedSegmentType:
+			nameIndex = len(output)
+		default:
+			continue
+		}
+
+		output = append(output, identityField{
+			attributeName: segmentNameToAttributeName(segment.Name),
+			segmentName:   segment.Name,
+		})
+	}
+
+	if nameIndex >= 0 {
+		output[nameIndex].attributeName = "name"
+	}
+
+	return output
+}
+
+// GenerateIdentitySchema returns a Resource Identity for the specified Resource ID type, allowing the
+// Resource to be imported using its Identity rather than the Resource ID.
+func GenerateIdentitySchema(id resourceids.ResourceId) *ResourceIdentity {
+	return &ResourceIdentity{
+		SchemaFunc: func() map[string]*Schema {
+			output := make(map[string]*Schema)
+			for _, field := range identityFieldsForResourceId(id) {
+				output[field.attributeName] = &Schema{
+					Type:              TypeString,
+					RequiredForImport: true,
+				}
+			}
+			return output
+		},
+	}
+}
+
+// IdentityAttributesFromResourceId returns the values for each of the Resource Identity attributes for the
+// specified Resource ID.
+func IdentityAttributesFromResourceId(id resourceids.ResourceId) (map[string]string, error) {
+	parsed, err := resourceids.NewParserFromResourceIdType(id).Parse(id.ID(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make(map[string]string)
+	for _, field := range identityFieldsForResourceId(id) {
+		value, ok := parsed.Parsed[field.segmentName]
+		if !ok {
+			return nil, fmt.Errorf("the segment %q was not found in %q", field.segmentName, id.ID())
+		}
+		output[field.attributeName] = value
+	}
+
+	return output, nil
+}
+
+// SetResourceIdentityData sets the Resource Identity for the specified Resource ID into the ResourceData.
+func SetResourceIdentityData(d *ResourceData, id resourceids.ResourceId) error {
+	identity, err := d.Identity()
+	if err != nil {
+		return fmt.Errorf("retrieving identity: %+v", err)
+	}
+
+	attributes, err := IdentityAttributesFromResourceId(id)
+	if err != nil {
+		return fmt.Errorf("building identity for %s: %+v", id, err)
+	}
+
+	for k, v := range attributes {
+		if err := identity.Set(k, v); err != nil {
+			return fmt.Errorf("setting `%s` in identity: %+v", k, err)
+		}
+	}
+
+	return nil
+}
+
+// ImporterValidatingIdentity validates the Resource ID (or the Resource Identity) provided at import time is
+// valid for the specified Resource ID type.
+func ImporterValidatingIdentity(id resourceids.ResourceId) *schema.ResourceImporter {
+	thenFunc := func(ctx context.Context, d *ResourceData, meta interface{}) ([]*ResourceData, error) {
+		return []*ResourceData{d}, nil
+	}
+	return ImporterValidatingIdentityThen(id, thenFunc)
+}
+
+// ImporterValidatingIdentityThen validates the Resource ID (or the Resource Identity) provided at import time
+// is valid for the specified Resource ID type then runs the 'thenFunc', allowing the import to be customised.
+func ImporterValidatingIdentityThen(id resourceids.ResourceId, thenFunc ImporterFunc) *schema.ResourceImporter {
+	parser := resourceids.NewParserFromResourceIdType(id)
+	importer := ImporterValidatingResourceIdThen(func(input string) error {
+		_, err := parser.Parse(input, false)
+		return err
+	}, thenFunc)
+
+	return ImporterSupportingIdentity(id, importer)
+}
+
+// ImporterSupportingIdentity wraps the specified importer so that, when the Resource is imported using its
+// Identity rather than the Resource ID, the Resource ID is built from the Identity prior to calling the importer.
+func ImporterSupportingIdentity(id resourceids.ResourceId, importer *schema.ResourceImporter) *schema.ResourceImporter {
+	stateContext := importer.StateContext
+	importer.StateContext = func(ctx context.Context, d *ResourceData, meta interface{}) ([]*ResourceData, error) {
+		if d.Id() == "" {
+			identity, err := d.Identity()
+			if err != nil {
+				return nil, fmt.Errorf("retrieving identity: %+v", err)
+			}
+
+			log.Printf("[DEBUG] Importing Resource - building the Resource ID from the Identity")
+
+			parsed := resourceids.ParseResult{
+				Parsed: map[string]string{},
+			}
+			for _, segment := range id.Segments() {
+				if segment.FixedValue != nil {
+					parsed.Parsed[segment.Name] = *segment.FixedValue
+				}
+			}
+			for _, field := range identityFieldsForResourceId(id) {
+				v, ok := identity.GetOk(field.attributeName)
+				if !ok {
+					return nil, fmt.Errorf("the identity attribute `%s` must be specified", field.attributeName)
+				}
+				parsed.Parsed[field.segmentName] = v.(string)
+			}
+
+			// a new instance of the Resource ID type is used, since the one provided is shared across imports
+			importedId := reflect.New(reflect.TypeOf(id).Elem()).Interface().(resourceids.ResourceId)
+			if err := importedId.FromParseResult(parsed); err != nil {
+				return nil, fmt.Errorf("building Resource ID from identity: %+v", err)
+			}
+			d.SetId(importedId.ID())
+		}
+
+		return stateContext(ctx, d, meta)
+	}
+
+	return importer
+}
+
+// segmentNameToAttributeName converts the camelCased name of a Resource ID Segment (e.g. `resourceGroupName`)
+// into the snake_cased name used for the Resource Identity attribute (e.g. `resource_group_name`)
+func segmentNameToAttributeName(input string) string {
+	output := strings.Builder{}
+	for i, r := range input {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				output.WriteRune('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		output.WriteRune(r)
+	}
+	return output.String()
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pluginsdk
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+func TestIdentityAttributesFromResourceId(t *testing.T) {
+	testData := []struct {
+		input    resourceids.ResourceId
+		expected map[string]string
+	}{
+		{
+			input: pointer.To(commonids.NewResourceGroupID("12345678-1234-9876-4563-123456789012", "example-resources")),
+			expected: map[string]string{
+				"subscription_id": "12345678-1234-9876-4563-123456789012",
+				"name":            "example-resources",
+			},
+		},
+		{
+			input: pointer.To(commonids.NewVirtualNetworkID("12345678-1234-9876-4563-123456789012", "example-resources", "example-network")),
+			expected: map[string]string{
+				"subscription_id":     "12345678-1234-9876-4563-123456789012",
+				"resource_group_name": "example-resources",
+				"name":                "example-network",
+			},
+		},
+		{
+			input: pointer.To(commonids.NewSubnetID("12345678-1234-9876-4563-123456789012", "example-resources", "example-network", "example-subnet")),
+			expected: map[string]string{
+				"subscription_id":      "12345678-1234-9876-4563-123456789012",
+				"resource_group_name":  "example-resources",
+				"virtual_network_name": "example-network",
+				"name":                 "example-subnet",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.input.ID())
+
+		actual, err := IdentityAttributesFromResourceId(v.input)
+		if err != nil {
+			t.Fatalf("building identity: %+v", err)
+		}
+
+		if !reflect.DeepEqual(v.expected, actual) {
+			t.Fatalf("expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
@@ -239,10 +239,40 @@ func StringDoesNotContainAny(chars string) func(interface{}, string) ([]string,
 // will test with in lower case if ignoreCase is true
 func StringInSlice(valid []string, ignoreCase bool) func(interface{}, string) ([]string, []error) {
 	return func(i interface{}, k string) ([]string, []error) {
+		if request, ok := i.(*possibleValuesRequest); ok {
+			request.values = append(make([]string, 0), valid...)
+			return nil, nil
+		}
+
 		return validation.StringInSlice(valid, ignoreCase)(i, k)
 	}
 }
 
+// possibleValuesRequest is passed to a SchemaValidateFunc by PossibleValuesForValidateFunc, to retrieve the
+// values accepted by a SchemaValidateFunc returned from StringInSlice
+type possibleValuesRequest struct {
+	values []string
+}
+
+// PossibleValuesForValidateFunc returns the values accepted by the SchemaValidateFunc when it was returned from
+// StringInSlice, otherwise nil - for example to document the possible values for a field.
+func PossibleValuesForValidateFunc(validateFunc func(interface{}, string) ([]string, []error)) (values []string) {
+	if validateFunc == nil {
+		return nil
+	}
+
+	// other SchemaValidateFuncs may assume the value is of the type defined in the Schema, so could panic
+	defer func() {
+		if r := recover(); r != nil {
+			values = nil
+		}
+	}()
+
+	request := &possibleValuesRequest{}
+	validateFunc(request, "")
+	return request.values
+}
+
 // StringIsBase64 is a ValidateFunc that ensures a string can be parsed as Base64
 func StringIsBase64(i interface{}, k string) ([]string, []error) {
 	return validation.StringIsBase64(i, k)
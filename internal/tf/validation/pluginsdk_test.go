@@ -4,6 +4,7 @@
 package validation
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -51,3 +52,41 @@ func TestValidateFloatInSlice(t *testing.T) {
 		}
 	}
 }
+
+func TestPossibleValuesForValidateFunc(t *testing.T) {
+	cases := map[string]struct {
+		ValidateFunc pluginsdk.SchemaValidateFunc
+		Expected     []string
+	}{
+		"string in slice": {
+			ValidateFunc: StringInSlice([]string{"Basic", "Standard"}, false),
+			Expected:     []string{"Basic", "Standard"},
+		},
+		"other validate func": {
+			ValidateFunc: StringIsNotEmpty,
+		},
+		"validate func assuming the type": {
+			ValidateFunc: func(i interface{}, k string) ([]string, []error) {
+				_ = i.(string)
+				return nil, nil
+			},
+		},
+		"nil": {},
+	}
+
+	for tn, tc := range cases {
+		actual := PossibleValuesForValidateFunc(tc.ValidateFunc)
+		if strings.Join(actual, ",") != strings.Join(tc.Expected, ",") {
+			t.Errorf("%s: expected %v but got %v", tn, tc.Expected, actual)
+		}
+	}
+
+	// the values accepted by the SchemaValidateFunc should be unaffected
+	validateFunc := StringInSlice([]string{"Basic", "Standard"}, false)
+	if _, errors := validateFunc("Basic", "sku"); len(errors) > 0 {
+		t.Errorf("unexpected errors %s", errors)
+	}
+	if _, errors := validateFunc("Premium", "sku"); len(errors) == 0 {
+		t.Errorf("expected errors but got none")
+	}
+}
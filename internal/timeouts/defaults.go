@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// Defaults are the timeouts specified in the `default_timeouts` block within the Provider block, which are
+// used in place of the timeouts defined by each Resource - but which can still be overridden by users using
+// the `timeouts` block within a Resource.
+type Defaults struct {
+	// Create, Read, Update and Delete (when specified) are used for all Resources which support that operation
+	Create *time.Duration
+	Read   *time.Duration
+	Update *time.Duration
+	Delete *time.Duration
+
+	// Multiplier is applied to the timeouts defined by each Resource, where no timeout has been specified
+	// for that operation (either for the Resource Type or for all Resources)
+	Multiplier float64
+
+	// Resources contains the timeouts for a specific Resource Type (e.g. `azurerm_kubernetes_cluster`), which
+	// take precedence over those specified for all Resources.
+	Resources map[string]ResourceDefaults
+}
+
+// ResourceDefaults are the timeouts specified for a specific Resource Type in the `default_timeouts` block
+type ResourceDefaults struct {
+	Create *time.Duration
+	Read   *time.Duration
+	Update *time.Duration
+	Delete *time.Duration
+}
+
+// Apply returns the timeouts which should be used for the Resource Type, based on the timeouts defined by the
+// Resource (builtIn). Only the operations supported by the Resource (that is, those defined in builtIn) are set.
+//
+// For each operation the timeout is taken from (in order): the timeouts specified for this Resource Type, the
+// timeouts specified for all Resources, or the timeout defined by the Resource multiplied by the Multiplier.
+func (d Defaults) Apply(resourceType string, builtIn *pluginsdk.ResourceTimeout) *pluginsdk.ResourceTimeout {
+	if builtIn == nil {
+		return nil
+	}
+
+	overrides := d.Resources[resourceType]
+	return &pluginsdk.ResourceTimeout{
+		Create:  d.timeoutFor(builtIn.Create, overrides.Create, d.Create),
+		Read:    d.timeoutFor(builtIn.Read, overrides.Read, d.Read),
+		Update:  d.timeoutFor(builtIn.Update, overrides.Update, d.Update),
+		Delete:  d.timeoutFor(builtIn.Delete, overrides.Delete, d.Delete),
+		Default: d.timeoutFor(builtIn.Default, nil, nil),
+	}
+}
+
+func (d Defaults) timeoutFor(builtIn *time.Duration, resourceType *time.Duration, allResources *time.Duration) *time.Duration {
+	if builtIn == nil {
+		return nil
+	}
+
+	output := *builtIn
+	switch {
+	case resourceType != nil:
+		output = *resourceType
+	case allResources != nil:
+		output = *allResources
+	case d.Multiplier > 0:
+		output = time.Duration(float64(output) * d.Multiplier)
+	}
+
+	return &output
+}
+
+// ValidateDefaultDuration validates a timeout specified within the `default_timeouts` block, such as `30m` or `2h`
+func ValidateDefaultDuration(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	duration, err := time.ParseDuration(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a duration such as `30m` or `2h`: %+v", k, err))
+		return
+	}
+	if duration <= 0 {
+		errors = append(errors, fmt.Errorf("%q must be greater than 0", k))
+	}
+
+	return
+}
@@ -4,6 +4,7 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"log"
 	"os"
@@ -18,38 +19,71 @@ const (
 )
 
 func main() {
-	if len(os.Args) != 2 {
-		log.Fatal("Usage: generator-schema-snapshot <reource_type>")
+	fs := flag.NewFlagSet("generator-schema-snapshot", flag.ExitOnError)
+
+	// state migration related flags
+	migration := fs.Bool("migration", false, "Whether to generate a State Migration (and test) into the `migration` package of the Service, rather than printing the Schema")
+	resourceId := fs.String("resource-id", "", "An example Resource ID for this Resource, used in the generated test. Required when `-migration` is set.")
+	idParser := fs.String("id-parser", "", "The fully qualified function used to parse the Resource ID insensitively (e.g. `github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-09-01/resourcegroups.ParseResourceGroupIDInsensitively`). Defaults to one derived from the Resource's `IDValidationFunc`.")
+
+	fs.Usage = func() {
+		log.Print("Usage: generator-schema-snapshot [-migration -resource-id <resource_id> [-id-parser <func>]] <resource_type>")
+		fs.PrintDefaults()
 	}
-	rt := os.Args[1]
+	_ = fs.Parse(os.Args[1:])
+
+	if fs.NArg() != 1 {
+		fs.Usage()
+		os.Exit(1)
+	}
+	rt := fs.Arg(0)
 	res, ok := provider.AzureProvider().ResourcesMap[rt]
 	if !ok {
 		log.Fatalf("unknown resource type %q", rt)
 	}
 
+	if *migration {
+		if *resourceId == "" {
+			log.Fatal("an example Resource ID must be specified via `-resource-id` when generating a State Migration")
+		}
+
+		if err := generateMigration(rt, res, *resourceId, *idParser); err != nil {
+			log.Fatalf("generating State Migration for %q: %+v", rt, err)
+		}
+		return
+	}
+
 	f := NewFile("main")
 	f.ImportName("github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk", "")
 
-	f.Var().Id("_").Op("=").Add(SchemaMap(res.Schema))
+	f.Var().Id("_").Op("=").Add(schemaGenerator{}.SchemaMap(res.Schema))
 
 	fmt.Printf("%#v", f)
 }
 
-func ResourceValue(res *pluginsdk.Resource) Dict {
+// schemaGenerator generates the Go code for a snapshot of a Schema
+type schemaGenerator struct {
+	// omitSetFunc specifies that the Set function should be omitted rather than output as a `TODO` placeholder,
+	// which is the case for State Migrations since it isn't required to decode the existing state - meaning
+	// the generated code compiles as-is
+	omitSetFunc bool
+}
+
+func (g schemaGenerator) ResourceValue(res *pluginsdk.Resource) Dict {
 	return Dict{
-		Id("Schema"): SchemaMap(res.Schema),
+		Id("Schema"): g.SchemaMap(res.Schema),
 	}
 }
 
-func SchemaMap(m map[string]*pluginsdk.Schema) *Statement {
+func (g schemaGenerator) SchemaMap(m map[string]*pluginsdk.Schema) *Statement {
 	dict := Dict{}
 	for k, v := range m {
-		dict[Lit(k)] = Values(SchemaValue(v))
+		dict[Lit(k)] = Values(g.SchemaValue(v))
 	}
 	return Map(String()).Op("*").Qual(SchemaPath, "Schema").Values(dict)
 }
 
-func SchemaValue(sch *pluginsdk.Schema) Dict {
+func (g schemaGenerator) SchemaValue(sch *pluginsdk.Schema) Dict {
 	out := Dict{}
 
 	var t Code
@@ -92,12 +126,12 @@ func SchemaValue(sch *pluginsdk.Schema) Dict {
 
 	switch sch := sch.Elem.(type) {
 	case *pluginsdk.Schema:
-		out[Id("Elem")] = Op("&").Qual(SchemaPath, "Schema").Values(SchemaValue(sch))
+		out[Id("Elem")] = Op("&").Qual(SchemaPath, "Schema").Values(g.SchemaValue(sch))
 	case *pluginsdk.Resource:
-		out[Id("Elem")] = Op("&").Qual(SchemaPath, "Resource").Values(ResourceValue(sch))
+		out[Id("Elem")] = Op("&").Qual(SchemaPath, "Resource").Values(g.ResourceValue(sch))
 	}
 
-	if sch.Set != nil {
+	if sch.Set != nil && !g.omitSetFunc {
 		out[Id("Set")] = Id("TODO")
 	}
 
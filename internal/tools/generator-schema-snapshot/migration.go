@@ -0,0 +1,654 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+
+	. "github.com/dave/jennifer/jen"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+const (
+	ModulePath  = "github.com/hashicorp/terraform-provider-azurerm"
+	PointerPath = "github.com/hashicorp/go-azure-helpers/lang/pointer"
+	SdkPath     = "github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+// generateMigration generates a State Migration (and a test for it) from the current Schema of the Resource into
+// the `migration` package for the Service - and for Typed Resources registers it within the `StateUpgraders` function
+func generateMigration(resourceType string, res *pluginsdk.Resource, resourceId, idParser string) error {
+	var typed sdk.Resource
+	for _, service := range provider.SupportedTypedServices() {
+		for _, r := range service.Resources() {
+			if r.ResourceType() == resourceType {
+				typed = r
+			}
+		}
+	}
+
+	var readFunc interface{}
+	if typed != nil {
+		readFunc = typed.Read().Func
+	} else {
+		readFunc = res.Read //nolint:staticcheck
+		if res.ReadContext != nil {
+			readFunc = res.ReadContext
+		}
+	}
+	serviceDirectory, err := directoryForFunc(readFunc)
+	if err != nil {
+		return fmt.Errorf("determining the Service directory: %+v", err)
+	}
+	idx := strings.Index(serviceDirectory, "internal/services/")
+	if idx == -1 {
+		return fmt.Errorf("the Resource is defined in %q which isn't within `internal/services`", serviceDirectory)
+	}
+	migrationImportPath := fmt.Sprintf("%s/%s/migration", ModulePath, serviceDirectory[idx:])
+
+	if idParser == "" {
+		if typed == nil {
+			return fmt.Errorf("the ID Parser can only be determined for Typed Resources - specify it using `-id-parser`")
+		}
+
+		idParser, err = idParserForResource(typed)
+		if err != nil {
+			return err
+		}
+	}
+	idx = strings.LastIndex(idParser, ".")
+	if idx == -1 {
+		return fmt.Errorf("the ID Parser %q should be in the format `{package}.{function}`", idParser)
+	}
+	parserPackage, parserFunc := idParser[:idx], idParser[idx+1:]
+
+	fromVersion := res.SchemaVersion
+	toVersion := fromVersion + 1
+	typeName := fmt.Sprintf("%sV%dToV%d", friendlyNameForResourceType(resourceType), fromVersion, toVersion)
+	fileName := fmt.Sprintf("%s_v%d_to_v%d", strings.TrimPrefix(resourceType, "azurerm_"), fromVersion, toVersion)
+
+	migrationDirectory := filepath.Join(serviceDirectory, "migration")
+	if err := os.MkdirAll(migrationDirectory, 0755); err != nil {
+		return fmt.Errorf("creating directory %q: %+v", migrationDirectory, err)
+	}
+
+	migrationFile := migrationFileForSchema(typeName, res.Schema, parserPackage, parserFunc)
+	if err := migrationFile.Save(filepath.Join(migrationDirectory, fileName+".go")); err != nil {
+		return fmt.Errorf("saving State Migration: %+v", err)
+	}
+
+	testFile := migrationTestFileForSchema(typeName, res.Schema, resourceId)
+	if err := testFile.Save(filepath.Join(migrationDirectory, fileName+"_test.go")); err != nil {
+		return fmt.Errorf("saving State Migration test: %+v", err)
+	}
+	log.Printf("Generated the State Migration %q in %q", typeName, migrationDirectory)
+
+	if typed == nil {
+		log.Printf("Untyped Resources must be updated manually: set `SchemaVersion` to %d and add `%d: migration.%s{}` to the `StateUpgraders`", toVersion, fromVersion, typeName)
+		return nil
+	}
+
+	if err := registerStateUpgrader(serviceDirectory, typed, migrationImportPath, typeName, fromVersion, toVersion); err != nil {
+		return fmt.Errorf("registering the State Migration: %+v", err)
+	}
+
+	return nil
+}
+
+func migrationFileForSchema(typeName string, schema map[string]*pluginsdk.Schema, parserPackage, parserFunc string) *File {
+	f := NewFile("migration")
+	f.HeaderComment("Copyright (c) HashiCorp, Inc.")
+	f.HeaderComment("SPDX-License-Identifier: MPL-2.0")
+	f.ImportName(SchemaPath, "pluginsdk")
+
+	f.Var().Id("_").Qual(SchemaPath, "StateUpgrade").Op("=").Id(typeName).Values()
+
+	f.Type().Id(typeName).Struct()
+
+	f.Func().Params(Id(typeName)).Id("Schema").Params().Map(String()).Op("*").Qual(SchemaPath, "Schema").Block(
+		Return(schemaGenerator{omitSetFunc: true}.SchemaMap(schema)),
+	)
+
+	f.Func().Params(Id(typeName)).Id("UpgradeFunc").Params().Qual(SchemaPath, "StateUpgraderFunc").Block(
+		Return(Func().Params(
+			Id("ctx").Qual("context", "Context"),
+			Id("rawState").Map(String()).Interface(),
+			Id("meta").Interface(),
+		).Params(Map(String()).Interface(), Error()).Block(
+			Id("oldId").Op(":=").Id("rawState").Index(Lit("id")).Assert(String()),
+			Line(),
+			List(Id("id"), Err()).Op(":=").Qual(parserPackage, parserFunc).Call(Id("oldId")),
+			If(Err().Op("!=").Nil()).Block(
+				Return(Id("rawState"), Qual("fmt", "Errorf").Call(Lit("parsing ID %q to upgrade: %+v"), Id("oldId"), Err())),
+			),
+			Line(),
+			Id("newId").Op(":=").Id("id").Dot("ID").Call(),
+			Qual("log", "Printf").Call(Lit("[DEBUG] Updating ID from %q to %q"), Id("oldId"), Id("newId")),
+			Id("rawState").Index(Lit("id")).Op("=").Id("newId"),
+			Line(),
+			Comment("TODO: update any other fields within the state which have changed between these Schema versions"),
+			Line(),
+			Return(Id("rawState"), Nil()),
+		)),
+	)
+
+	return f
+}
+
+func migrationTestFileForSchema(typeName string, schema map[string]*pluginsdk.Schema, resourceId string) *File {
+	f := NewFile("migration")
+	f.HeaderComment("Copyright (c) HashiCorp, Inc.")
+	f.HeaderComment("SPDX-License-Identifier: MPL-2.0")
+
+	stateWithId := func(id string) Dict {
+		state := sampleStateForSchema(schema)
+		state[Lit("id")] = Lit(id)
+		return state
+	}
+
+	testCases := []Code{
+		Values(Dict{
+			Id("name"):     Lit("existing id"),
+			Id("input"):    Map(String()).Interface().Values(stateWithId(resourceId)),
+			Id("expected"): Qual(PointerPath, "To").Call(Lit(resourceId)),
+		}),
+	}
+	if strings.Contains(resourceId, "/resourceGroups/") {
+		testCases = append(testCases, Values(Dict{
+			Id("name"):     Lit("existing id - mixed case"),
+			Id("input"):    Map(String()).Interface().Values(stateWithId(strings.Replace(resourceId, "/resourceGroups/", "/resourcegroups/", 1))),
+			Id("expected"): Qual(PointerPath, "To").Call(Lit(resourceId)),
+		}))
+	}
+	testCases = append(testCases, Values(Dict{
+		Id("name"):     Lit("invalid id"),
+		Id("input"):    Map(String()).Interface().Values(stateWithId("not-a-valid-id")),
+		Id("expected"): Nil(),
+	}))
+
+	f.Func().Id(fmt.Sprintf("Test%s", typeName)).Params(Id("t").Op("*").Qual("testing", "T")).Block(
+		Id("testData").Op(":=").Index().Struct(
+			Id("name").String(),
+			Id("input").Map(String()).Interface(),
+			Id("expected").Op("*").String(),
+		).Values(testCases...),
+		For(List(Id("_"), Id("test")).Op(":=").Range().Id("testData")).Block(
+			Id("t").Dot("Logf").Call(Lit("Testing %q..."), Id("test").Dot("name")),
+			List(Id("result"), Err()).Op(":=").Id(typeName).Values().Dot("UpgradeFunc").Call().Call(Qual("context", "TODO").Call(), Id("test").Dot("input"), Nil()),
+			If(Err().Op("!=").Nil().Op("&&").Id("test").Dot("expected").Op("==").Nil()).Block(
+				Continue(),
+			).Else().Block(
+				If(Err().Op("==").Nil().Op("&&").Id("test").Dot("expected").Op("==").Nil()).Block(
+					Id("t").Dot("Fatalf").Call(Lit("Expected an error but didn't get one")),
+				).Else().If(Err().Op("!=").Nil().Op("&&").Id("test").Dot("expected").Op("!=").Nil()).Block(
+					Id("t").Dot("Fatalf").Call(Lit("Expected no error but got: %+v"), Err()),
+				),
+			),
+			Line(),
+			Id("actualId").Op(":=").Id("result").Index(Lit("id")).Assert(String()),
+			If(Op("*").Id("test").Dot("expected").Op("!=").Id("actualId")).Block(
+				Id("t").Dot("Fatalf").Call(Lit("expected %q but got %q!"), Op("*").Id("test").Dot("expected"), Id("actualId")),
+			),
+		),
+	)
+
+	return f
+}
+
+// sampleStateForSchema returns a raw state containing an example value for every field within the Schema
+func sampleStateForSchema(schema map[string]*pluginsdk.Schema) Dict {
+	out := Dict{}
+	for k, v := range schema {
+		out[Lit(k)] = sampleValueForSchema(v)
+	}
+	return out
+}
+
+func sampleValueForSchema(sch *pluginsdk.Schema) Code {
+	switch sch.Type {
+	case pluginsdk.TypeBool:
+		return False()
+	case pluginsdk.TypeInt:
+		return Lit(1)
+	case pluginsdk.TypeFloat:
+		return Lit(1.5)
+	case pluginsdk.TypeMap:
+		return Map(String()).Interface().Values(Dict{
+			Lit("key"): Lit("value"),
+		})
+	case pluginsdk.TypeList, pluginsdk.TypeSet:
+		switch elem := sch.Elem.(type) {
+		case *pluginsdk.Resource:
+			return Index().Interface().Values(Map(String()).Interface().Values(sampleStateForSchema(elem.Schema)))
+		case *pluginsdk.Schema:
+			return Index().Interface().Values(sampleValueForSchema(elem))
+		}
+		return Index().Interface().Values()
+	}
+
+	return Lit("example")
+}
+
+// registerStateUpgrader bumps the `SchemaVersion` and registers the State Migration within the `StateUpgraders`
+// function for the Typed Resource - adding this function (and the `sdk.ResourceWithStateMigration` interface) if necessary
+func registerStateUpgrader(serviceDirectory string, resource sdk.Resource, migrationImportPath, typeName string, fromVersion, toVersion int) error {
+	resourceType := reflect.TypeOf(resource)
+	if resourceType.Kind() == reflect.Ptr {
+		resourceType = resourceType.Elem()
+	}
+	receiverType := resourceType.Name()
+
+	fileName, err := sourceFileForReceiverType(serviceDirectory, receiverType)
+	if err != nil {
+		return err
+	}
+
+	contents, err := os.ReadFile(fileName)
+	if err != nil {
+		return err
+	}
+
+	updated, err := addStateUpgrader(fileName, contents, stateUpgraderRegistration{
+		receiverType:        receiverType,
+		migrationImportPath: migrationImportPath,
+		typeName:            typeName,
+		fromVersion:         fromVersion,
+		toVersion:           toVersion,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(fileName, updated, 0644); err != nil {
+		return fmt.Errorf("writing %q: %+v", fileName, err)
+	}
+
+	log.Printf("Updated the `StateUpgraders` for %q in %q to `SchemaVersion` %d", receiverType, fileName, toVersion)
+	return nil
+}
+
+// sourceFileForReceiverType returns the file within the directory which contains the `StateUpgraders` method for
+// the receiver type, falling back to the file containing the `ResourceType` method when this doesn't exist
+func sourceFileForReceiverType(directory, receiverType string) (string, error) {
+	fileNames, err := filepath.Glob(filepath.Join(directory, "*.go"))
+	if err != nil {
+		return "", err
+	}
+	sort.Strings(fileNames)
+
+	var resourceTypeFileName string
+	fileSet := token.NewFileSet()
+	for _, name := range fileNames {
+		if strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fileSet, name, nil, 0)
+		if err != nil {
+			return "", fmt.Errorf("parsing %q: %+v", name, err)
+		}
+
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Recv == nil || len(funcDecl.Recv.List) == 0 || receiverTypeName(funcDecl) != receiverType {
+				continue
+			}
+
+			switch funcDecl.Name.Name {
+			case "StateUpgraders":
+				return name, nil
+			case "ResourceType":
+				if resourceTypeFileName == "" {
+					resourceTypeFileName = name
+				}
+			}
+		}
+	}
+
+	if resourceTypeFileName == "" {
+		return "", fmt.Errorf("the source file for %q was not found in %q", receiverType, directory)
+	}
+
+	return resourceTypeFileName, nil
+}
+
+type stateUpgraderRegistration struct {
+	receiverType        string
+	migrationImportPath string
+	typeName            string
+	fromVersion         int
+	toVersion           int
+}
+
+// addStateUpgrader returns the formatted contents of the source file with the State Migration registered within the
+// `StateUpgraders` function for the receiver type - which is added to the end of the file if it doesn't exist
+func addStateUpgrader(fileName string, contents []byte, input stateUpgraderRegistration) ([]byte, error) {
+	fileSet := token.NewFileSet()
+	file, err := parser.ParseFile(fileSet, fileName, contents, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", fileName, err)
+	}
+
+	stateUpgraders, receiverName := findStateUpgraders(file, input.receiverType)
+
+	upgrader := fmt.Sprintf("%d: migration.%s{}", input.fromVersion, input.typeName)
+	if stateUpgraders != nil {
+		schemaVersion, upgraders, err := stateUpgradeDataFields(stateUpgraders)
+		if err != nil {
+			return nil, fmt.Errorf("the `StateUpgraders` function in %q: %+v", fileName, err)
+		}
+
+		if literal, ok := schemaVersion.Value.(*ast.BasicLit); !ok || literal.Value != strconv.Itoa(input.fromVersion) {
+			return nil, fmt.Errorf("expected the `SchemaVersion` in %q to be %d", fileName, input.fromVersion)
+		}
+
+		// the `Upgraders` map is rebuilt from the source of the existing entries (including any comments), rather
+		// than inserting before the closing brace, so that this works regardless of how the existing map is formatted
+		for _, elt := range upgraders.Elts {
+			if kv, ok := elt.(*ast.KeyValueExpr); ok {
+				if key, ok := kv.Key.(*ast.BasicLit); ok && key.Value == strconv.Itoa(input.fromVersion) {
+					return nil, fmt.Errorf("a State Upgrader is already registered for version %d in %q", input.fromVersion, fileName)
+				}
+			}
+		}
+		existing := ""
+		if len(upgraders.Elts) > 0 {
+			start := fileSet.Position(upgraders.Lbrace).Offset + 1
+			lastEnd := fileSet.Position(upgraders.Elts[len(upgraders.Elts)-1].End()).Offset
+			remaining := strings.TrimSpace(string(contents[lastEnd:fileSet.Position(upgraders.Rbrace).Offset]))
+			remaining = strings.TrimPrefix(remaining, ",")
+			existing = fmt.Sprintf("%s,%s", strings.TrimRight(string(contents[start:lastEnd]), " \t"), remaining)
+			if !strings.HasPrefix(strings.TrimLeft(existing, " \t"), "\n") {
+				existing = "\n" + existing
+			}
+		}
+
+		type edit struct {
+			offset int
+			end    int
+			text   string
+		}
+		edits := []edit{
+			{
+				offset: fileSet.Position(schemaVersion.Value.Pos()).Offset,
+				end:    fileSet.Position(schemaVersion.Value.End()).Offset,
+				text:   strconv.Itoa(input.toVersion),
+			},
+			{
+				offset: fileSet.Position(upgraders.Lbrace).Offset,
+				end:    fileSet.Position(upgraders.Rbrace).Offset + 1,
+				text:   fmt.Sprintf("{%s\n%s,\n}", existing, upgrader),
+			},
+		}
+		sort.Slice(edits, func(i, j int) bool {
+			return edits[i].offset > edits[j].offset
+		})
+
+		updated := make([]byte, 0, len(contents))
+		updated = append(updated, contents...)
+		for _, e := range edits {
+			updated = append(updated[:e.offset:e.offset], append([]byte(e.text), updated[e.end:]...)...)
+		}
+		contents = updated
+	} else {
+		if receiverName == "" {
+			receiverName = "r"
+		}
+
+		contents = append(contents[:len(contents):len(contents)], []byte(fmt.Sprintf(`
+var _ sdk.ResourceWithStateMigration = %[2]s{}
+
+func (%[1]s %[2]s) StateUpgraders() sdk.StateUpgradeData {
+	return sdk.StateUpgradeData{
+		SchemaVersion: %[3]d,
+		Upgraders: map[int]pluginsdk.StateUpgrade{
+			%[4]s,
+		},
+	}
+}
+`, receiverName, input.receiverType, input.toVersion, upgrader))...)
+	}
+
+	// finally ensure the necessary imports are present and format the file
+	contents, err = addImports(fileName, contents, input.migrationImportPath, SdkPath, SchemaPath)
+	if err != nil {
+		return nil, err
+	}
+
+	formatted, err := format.Source(contents)
+	if err != nil {
+		return nil, fmt.Errorf("formatting %q: %+v", fileName, err)
+	}
+
+	// re-parse the updated file to confirm that the State Migration has been registered as expected
+	if err := verifyStateUpgrader(fileName, formatted, input); err != nil {
+		return nil, err
+	}
+
+	return formatted, nil
+}
+
+// findStateUpgraders returns the `StateUpgraders` method for the receiver type (if it exists), and the receiver
+// name used by the methods for this type
+func findStateUpgraders(file *ast.File, receiverType string) (stateUpgraders *ast.FuncDecl, receiverName string) {
+	for _, decl := range file.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+		if !ok || funcDecl.Recv == nil || len(funcDecl.Recv.List) == 0 || receiverTypeName(funcDecl) != receiverType {
+			continue
+		}
+
+		if funcDecl.Name.Name == "StateUpgraders" {
+			stateUpgraders = funcDecl
+		}
+		if receiverName == "" && len(funcDecl.Recv.List[0].Names) > 0 {
+			receiverName = funcDecl.Recv.List[0].Names[0].Name
+		}
+	}
+
+	return
+}
+
+// stateUpgradeDataFields returns the `SchemaVersion` field and the `Upgraders` map literal from the
+// `sdk.StateUpgradeData` returned by the `StateUpgraders` function
+func stateUpgradeDataFields(stateUpgraders *ast.FuncDecl) (*ast.KeyValueExpr, *ast.CompositeLit, error) {
+	var schemaVersion *ast.KeyValueExpr
+	var upgraders *ast.CompositeLit
+	var err error
+	ast.Inspect(stateUpgraders.Body, func(node ast.Node) bool {
+		if err != nil {
+			return false
+		}
+		kv, ok := node.(*ast.KeyValueExpr)
+		if !ok {
+			return true
+		}
+		key, ok := kv.Key.(*ast.Ident)
+		if !ok {
+			return true
+		}
+
+		switch key.Name {
+		case "SchemaVersion":
+			if schemaVersion != nil {
+				err = fmt.Errorf("multiple `SchemaVersion` fields were found")
+			}
+			schemaVersion = kv
+		case "Upgraders":
+			if upgraders != nil {
+				err = fmt.Errorf("multiple `Upgraders` fields were found")
+			}
+			literal, ok := kv.Value.(*ast.CompositeLit)
+			if !ok {
+				err = fmt.Errorf("the `Upgraders` field must be a map literal to be updated")
+				return false
+			}
+			upgraders = literal
+		}
+		return true
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if schemaVersion == nil || upgraders == nil {
+		return nil, nil, fmt.Errorf("the `SchemaVersion` and `Upgraders` fields were not found")
+	}
+
+	return schemaVersion, upgraders, nil
+}
+
+// verifyStateUpgrader confirms that the `StateUpgraders` function in the source file has the expected `SchemaVersion`
+// and contains the State Migration being registered
+func verifyStateUpgrader(fileName string, contents []byte, input stateUpgraderRegistration) error {
+	file, err := parser.ParseFile(token.NewFileSet(), fileName, contents, 0)
+	if err != nil {
+		return fmt.Errorf("parsing the updated %q: %+v", fileName, err)
+	}
+
+	stateUpgraders, _ := findStateUpgraders(file, input.receiverType)
+	if stateUpgraders == nil {
+		return fmt.Errorf("the `StateUpgraders` function was not found in the updated %q", fileName)
+	}
+	schemaVersion, upgraders, err := stateUpgradeDataFields(stateUpgraders)
+	if err != nil {
+		return fmt.Errorf("the updated `StateUpgraders` function in %q: %+v", fileName, err)
+	}
+
+	if literal, ok := schemaVersion.Value.(*ast.BasicLit); !ok || literal.Value != strconv.Itoa(input.toVersion) {
+		return fmt.Errorf("expected the updated `SchemaVersion` in %q to be %d", fileName, input.toVersion)
+	}
+
+	for _, elt := range upgraders.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		key, ok := kv.Key.(*ast.BasicLit)
+		if !ok || key.Value != strconv.Itoa(input.fromVersion) {
+			continue
+		}
+		value, ok := kv.Value.(*ast.CompositeLit)
+		if !ok {
+			continue
+		}
+		if selector, ok := value.Type.(*ast.SelectorExpr); ok && selector.Sel.Name == input.typeName {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("the State Migration %q was not registered for version %d in the updated %q", input.typeName, input.fromVersion, fileName)
+}
+
+// addImports adds any of the specified import paths which aren't already imported to the first import block in the file
+func addImports(fileName string, contents []byte, importPaths ...string) ([]byte, error) {
+	fileSet := token.NewFileSet()
+	file, err := parser.ParseFile(fileSet, fileName, contents, parser.ImportsOnly)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", fileName, err)
+	}
+
+	existing := make(map[string]struct{})
+	for _, v := range file.Imports {
+		if path, err := strconv.Unquote(v.Path.Value); err == nil {
+			existing[path] = struct{}{}
+		}
+	}
+
+	missing := ""
+	if n := len(file.Imports); n > 0 {
+		// separate the imports being added from the standard library imports, as goimports would
+		if path, err := strconv.Unquote(file.Imports[n-1].Path.Value); err == nil && !strings.Contains(strings.Split(path, "/")[0], ".") {
+			missing = "\n"
+		}
+	}
+	for _, path := range importPaths {
+		if _, ok := existing[path]; !ok {
+			missing += fmt.Sprintf("\t%q\n", path)
+		}
+	}
+	if strings.TrimSpace(missing) == "" {
+		return contents, nil
+	}
+
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.IMPORT || !genDecl.Rparen.IsValid() {
+			continue
+		}
+
+		offset := fileSet.Position(genDecl.Rparen).Offset
+		return append(contents[:offset:offset], append([]byte(missing), contents[offset:]...)...), nil
+	}
+
+	return nil, fmt.Errorf("an import block was not found in %q", fileName)
+}
+
+// idParserForResource determines the function used to parse the Resource ID insensitively from the IDValidationFunc,
+// for example `commonids.ValidateResourceGroupID` becomes `commonids.ParseResourceGroupIDInsensitively` and
+// `validate.ExampleID` becomes `parse.ExampleIDInsensitively`
+func idParserForResource(resource sdk.Resource) (string, error) {
+	name := runtime.FuncForPC(reflect.ValueOf(resource.IDValidationFunc()).Pointer()).Name()
+	idx := strings.LastIndex(name, ".")
+	if idx == -1 || strings.Contains(name[idx+1:], "func") {
+		return "", fmt.Errorf("the ID Parser couldn't be determined from the IDValidationFunc %q - specify it using `-id-parser`", name)
+	}
+	packagePath, funcName := name[:idx], name[idx+1:]
+
+	if strings.HasPrefix(funcName, "Validate") {
+		return fmt.Sprintf("%s.Parse%sInsensitively", packagePath, strings.TrimPrefix(funcName, "Validate")), nil
+	}
+
+	if strings.HasSuffix(packagePath, "/validate") {
+		return fmt.Sprintf("%s/parse.%sInsensitively", strings.TrimSuffix(packagePath, "/validate"), funcName), nil
+	}
+
+	return "", fmt.Errorf("the ID Parser couldn't be determined from the IDValidationFunc %q - specify it using `-id-parser`", name)
+}
+
+func directoryForFunc(input interface{}) (string, error) {
+	v := reflect.ValueOf(input)
+	if input == nil || v.IsNil() {
+		return "", fmt.Errorf("the function was nil")
+	}
+
+	pc := v.Pointer()
+	file, _ := runtime.FuncForPC(pc).FileLine(pc)
+	return filepath.Dir(file), nil
+}
+
+// friendlyNameForResourceType converts the Resource Type into a Go type name, e.g. `azurerm_resource_group` becomes `ResourceGroup`
+func friendlyNameForResourceType(input string) string {
+	output := ""
+	for _, segment := range strings.Split(strings.TrimPrefix(input, "azurerm_"), "_") {
+		if segment == "" {
+			continue
+		}
+		output += strings.ToUpper(segment[:1]) + segment[1:]
+	}
+	return output
+}
+
+func receiverTypeName(input *ast.FuncDecl) string {
+	expr := input.Recv.List[0].Type
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
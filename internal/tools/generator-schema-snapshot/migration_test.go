@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var update = flag.Bool("update", false, "update the golden files in the testdata directory")
+
+const exampleMigrationImportPath = "github.com/hashicorp/terraform-provider-azurerm/internal/services/example/migration"
+
+func exampleSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+		"ip_addresses": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+			Set: pluginsdk.HashString,
+		},
+		"rule": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"priority": {
+						Type:     pluginsdk.TypeInt,
+						Required: true,
+					},
+				},
+			},
+		},
+		"tags": {
+			Type:     pluginsdk.TypeMap,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func TestSchemaMapSetFunc(t *testing.T) {
+	withSetFunc := fmt.Sprintf("%#v", schemaGenerator{}.SchemaMap(exampleSchema()))
+	if !regexp.MustCompile(`Set:\s+TODO`).MatchString(withSetFunc) {
+		t.Fatalf("expected the Set function to be output as a placeholder but got:\n%s", withSetFunc)
+	}
+
+	withoutSetFunc := fmt.Sprintf("%#v", schemaGenerator{omitSetFunc: true}.SchemaMap(exampleSchema()))
+	if strings.Contains(withoutSetFunc, "Set:") {
+		t.Fatalf("expected the Set function to be omitted but got:\n%s", withoutSetFunc)
+	}
+}
+
+func TestMigrationFileForSchema(t *testing.T) {
+	file := migrationFileForSchema("ExampleV0ToV1", exampleSchema(), "github.com/hashicorp/go-azure-helpers/resourcemanager/commonids", "ParseResourceGroupIDInsensitively")
+	assertGolden(t, "migration.golden", []byte(fmt.Sprintf("%#v", file)))
+}
+
+func TestMigrationTestFileForSchema(t *testing.T) {
+	file := migrationTestFileForSchema("ExampleV0ToV1", exampleSchema(), "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1")
+	assertGolden(t, "migration_test.golden", []byte(fmt.Sprintf("%#v", file)))
+}
+
+func TestAddStateUpgrader(t *testing.T) {
+	testData := []struct {
+		name          string
+		fromVersion   int
+		expectedError string
+	}{
+		{
+			name:        "state_upgraders_existing",
+			fromVersion: 1,
+		},
+		{
+			name:        "state_upgraders_single_line",
+			fromVersion: 1,
+		},
+		{
+			name:        "state_upgraders_missing",
+			fromVersion: 0,
+		},
+		{
+			name:          "state_upgraders_existing",
+			fromVersion:   0,
+			expectedError: "expected the `SchemaVersion`",
+		},
+		{
+			name:          "state_upgraders_not_literal",
+			fromVersion:   1,
+			expectedError: "the `Upgraders` field must be a map literal",
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(fmt.Sprintf("%s_from_v%d", v.name, v.fromVersion), func(t *testing.T) {
+			fileName := filepath.Join("testdata", v.name+".input")
+			contents, err := os.ReadFile(fileName)
+			if err != nil {
+				t.Fatalf("reading %q: %+v", fileName, err)
+			}
+
+			actual, err := addStateUpgrader(fileName, contents, stateUpgraderRegistration{
+				receiverType:        "ExampleResource",
+				migrationImportPath: exampleMigrationImportPath,
+				typeName:            fmt.Sprintf("ExampleV%dToV%d", v.fromVersion, v.fromVersion+1),
+				fromVersion:         v.fromVersion,
+				toVersion:           v.fromVersion + 1,
+			})
+			if v.expectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), v.expectedError) {
+					t.Fatalf("expected an error containing %q but got: %+v", v.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("adding the State Upgrader: %+v", err)
+			}
+
+			assertGolden(t, v.name+".golden", actual)
+		})
+	}
+}
+
+func TestAddStateUpgraderAlreadyRegistered(t *testing.T) {
+	fileName := filepath.Join("testdata", "state_upgraders_existing.golden")
+	contents, err := os.ReadFile(fileName)
+	if err != nil {
+		t.Fatalf("reading %q: %+v", fileName, err)
+	}
+
+	// re-registering the same State Migration after bumping the SchemaVersion should fail
+	contents = []byte(strings.Replace(string(contents), "SchemaVersion: 2", "SchemaVersion: 1", 1))
+	_, err = addStateUpgrader(fileName, contents, stateUpgraderRegistration{
+		receiverType:        "ExampleResource",
+		migrationImportPath: exampleMigrationImportPath,
+		typeName:            "ExampleV1ToV2",
+		fromVersion:         1,
+		toVersion:           2,
+	})
+	if err == nil || !strings.Contains(err.Error(), "already registered for version 1") {
+		t.Fatalf("expected an error as the State Upgrader is already registered but got: %+v", err)
+	}
+}
+
+func assertGolden(t *testing.T, name string, actual []byte) {
+	t.Helper()
+
+	fileName := filepath.Join("testdata", name)
+	if *update {
+		if err := os.WriteFile(fileName, actual, 0644); err != nil {
+			t.Fatalf("writing %q: %+v", fileName, err)
+		}
+	}
+
+	expected, err := os.ReadFile(fileName)
+	if err != nil {
+		t.Fatalf("reading %q: %+v", fileName, err)
+	}
+	if string(expected) != string(actual) {
+		t.Fatalf("the output didn't match %q (run with `-update` to update)\n\nexpected:\n%s\n\nactual:\n%s", fileName, expected, actual)
+	}
+}
@@ -14,7 +14,12 @@ import (
 )
 
 var allRules = map[string]rules.Rule{
-	rules.TypedSDKBitCheck{}.Name(): rules.TypedSDKBitCheck{},
+	rules.TypedSDKBitCheck{}.Name():            rules.TypedSDKBitCheck{},
+	rules.TypedSDKSchemaTagCheck{}.Name():      rules.TypedSDKSchemaTagCheck{},
+	rules.TypedSDKMarkAsGoneCheck{}.Name():     rules.TypedSDKMarkAsGoneCheck{},
+	rules.TypedSDKRequiresImportCheck{}.Name(): rules.TypedSDKRequiresImportCheck{},
+	rules.TypedSDKForceNewUpdateCheck{}.Name(): rules.TypedSDKForceNewUpdateCheck{},
+	rules.TypedSDKHasChangeCheck{}.Name():      rules.TypedSDKHasChangeCheck{},
 }
 
 func main() {
@@ -22,6 +27,8 @@ func main() {
 
 	rulesToCheck := f.String("rules", "all", "Comma separated list of rules to run. Defaults to all. ")
 	failOnError := f.Bool("fail-on-error", true, "If set to true will fail on error, otherwise will only log. Defaults to true.")
+	baselinePath := f.String("baseline", "", "Path to a file containing the known violations, which will be logged rather than failing. Defaults to none.")
+	writeBaseline := f.Bool("write-baseline", false, "If set to true will overwrite the file specified in -baseline with the violations found. Defaults to false.")
 
 	if err := f.Parse(os.Args[1:]); err != nil {
 		log.Fatalf("failed to parse flags: %v", err)
@@ -30,6 +37,9 @@ func main() {
 	if len(*rulesToCheck) == 0 {
 		log.Fatalf("no rules specified")
 	}
+	if *writeBaseline && *baselinePath == "" {
+		log.Fatalf("-write-baseline requires -baseline to be specified")
+	}
 	specifiedRules := strings.Split(*rulesToCheck, ",")
 
 	// If `all` is in the list, just reset it to `all`
@@ -37,19 +47,51 @@ func main() {
 		specifiedRules = []string{"all"}
 	}
 
+	ruleNames := make([]string, 0)
 	errors := make([]error, 0)
 	for _, rule := range specifiedRules {
 		if strings.EqualFold(rule, "all") {
-			for _, r := range allRules {
+			for name, r := range allRules {
+				ruleNames = append(ruleNames, name)
 				errors = append(errors, r.Run()...)
 			}
 		}
 
 		if r, ok := allRules[rule]; ok {
+			ruleNames = append(ruleNames, rule)
 			errors = append(errors, r.Run()...)
 		}
 	}
 
+	if *baselinePath != "" {
+		if *writeBaseline {
+			if err := os.WriteFile(*baselinePath, []byte(rules.BaselineFor(errors)), 0o644); err != nil {
+				log.Fatalf("writing baseline to %q: %+v", *baselinePath, err)
+			}
+			log.Printf("wrote the baseline to %q", *baselinePath)
+			os.Exit(0)
+		}
+
+		file, err := os.Open(*baselinePath)
+		if err != nil {
+			log.Fatalf("opening baseline %q: %+v", *baselinePath, err)
+		}
+		baseline, err := rules.ParseBaseline(file)
+		file.Close()
+		if err != nil {
+			log.Fatalf("parsing baseline %q: %+v", *baselinePath, err)
+		}
+
+		var known []error
+		errors, known = baseline.Filter(errors)
+		if len(known) > 0 {
+			log.Printf("ignoring %d violations which are present in the baseline", len(known))
+		}
+		if stale := baseline.Stale(ruleNames, known); len(stale) > 0 {
+			log.Printf("the following baseline entries no longer apply and can be removed from %q:\n%s", *baselinePath, strings.Join(stale, "\n"))
+		}
+	}
+
 	if len(errors) > 0 {
 		if *failOnError {
 			log.Fatalf("failed to run rules: %v", errors)
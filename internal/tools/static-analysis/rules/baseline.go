@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// Baseline is the set of known Violations which exist in the codebase today, these are reported but don't
+// cause the Static Analysis to fail - allowing new Violations to be caught whilst the existing ones are fixed
+type Baseline map[string]struct{}
+
+// ParseBaseline parses a Baseline containing one entry per line, empty lines and lines starting with `#` are ignored
+func ParseBaseline(input io.Reader) (*Baseline, error) {
+	output := Baseline{}
+
+	scanner := bufio.NewScanner(input)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		output[strings.Join(strings.Fields(line), " ")] = struct{}{}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading baseline: %+v", err)
+	}
+
+	return &output, nil
+}
+
+// Filter splits the input errors into those which are new and those which are Violations present in the Baseline
+func (b Baseline) Filter(input []error) (unknown []error, known []error) {
+	for _, err := range input {
+		var violation Violation
+		if errors.As(err, &violation) {
+			if _, ok := b[violation.BaselineEntry()]; ok {
+				known = append(known, err)
+				continue
+			}
+		}
+
+		unknown = append(unknown, err)
+	}
+
+	return
+}
+
+// Stale returns the Baseline entries for the specified Rules which didn't match any of the input errors,
+// which can be removed from the Baseline since they've been fixed
+func (b Baseline) Stale(ruleNames []string, input []error) []string {
+	found := map[string]struct{}{}
+	for _, err := range input {
+		var violation Violation
+		if errors.As(err, &violation) {
+			found[violation.BaselineEntry()] = struct{}{}
+		}
+	}
+
+	output := make([]string, 0)
+	for entry := range b {
+		if _, ok := found[entry]; ok {
+			continue
+		}
+		if !nameIn(strings.Fields(entry)[0], ruleNames) {
+			continue
+		}
+		output = append(output, entry)
+	}
+	sort.Strings(output)
+
+	return output
+}
+
+const baselineHeader = `# This file contains the known violations of the Static Analysis rules, which are logged rather than failing.
+# Entries should be removed once fixed - and new violations should be fixed rather than being added here.
+#
+# This file can be regenerated using:
+#   go run internal/tools/static-analysis/main.go -baseline=internal/tools/static-analysis/baseline.txt -write-baseline
+
+`
+
+// BaselineFor returns the Baseline file contents covering all of the Violations within the input errors
+func BaselineFor(input []error) string {
+	entries := map[string]struct{}{}
+	for _, err := range input {
+		var violation Violation
+		if errors.As(err, &violation) {
+			entries[violation.BaselineEntry()] = struct{}{}
+		}
+	}
+
+	lines := make([]string, 0, len(entries))
+	for k := range entries {
+		lines = append(lines, k)
+	}
+	sort.Strings(lines)
+
+	return baselineHeader + strings.Join(lines, "\n") + "\n"
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestBaseline(t *testing.T) {
+	input := `
+# comments and empty lines are ignored
+checkReadMarksAsGone azurerm_known
+
+checkHasChangeKeys   azurerm_known   tags
+checkHasChangeKeys azurerm_fixed tags
+checkSchemaTags azurerm_other Model.Field
+`
+	baseline, err := ParseBaseline(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("parsing baseline: %+v", err)
+	}
+
+	knownReadViolation := Violation{Rule: "checkReadMarksAsGone", ResourceType: "azurerm_known"}
+	knownHasChangeViolation := Violation{Rule: "checkHasChangeKeys", ResourceType: "azurerm_known", Key: "tags"}
+	newViolation := Violation{Rule: "checkHasChangeKeys", ResourceType: "azurerm_known", Key: "name"}
+	otherError := fmt.Errorf("azurerm_known: locating the Read function")
+
+	unknown, known := baseline.Filter([]error{knownReadViolation, newViolation, knownHasChangeViolation, otherError})
+	if !reflect.DeepEqual(unknown, []error{newViolation, otherError}) {
+		t.Fatalf("expected the unknown errors to be the new violation and other error but got %+v", unknown)
+	}
+	if !reflect.DeepEqual(known, []error{knownReadViolation, knownHasChangeViolation}) {
+		t.Fatalf("expected the known errors to be the baselined violations but got %+v", known)
+	}
+
+	stale := baseline.Stale([]string{"checkReadMarksAsGone", "checkHasChangeKeys"}, known)
+	if !reflect.DeepEqual(stale, []string{"checkHasChangeKeys azurerm_fixed tags"}) {
+		t.Fatalf("expected a single stale entry but got %+v", stale)
+	}
+}
+
+func TestBaselineFor(t *testing.T) {
+	input := []error{
+		Violation{Rule: "checkSchemaTags", ResourceType: "azurerm_example", Key: "Model.Field"},
+		Violation{Rule: "checkReadMarksAsGone", ResourceType: "azurerm_example"},
+		Violation{Rule: "checkSchemaTags", ResourceType: "azurerm_example", Key: "Model.Field"},
+		fmt.Errorf("not a violation"),
+	}
+
+	expected := baselineHeader + "checkReadMarksAsGone azurerm_example\ncheckSchemaTags azurerm_example Model.Field\n"
+	if actual := BaselineFor(input); actual != expected {
+		t.Fatalf("expected %q but got %q", expected, actual)
+	}
+}
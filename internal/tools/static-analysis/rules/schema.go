@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// combinedSchema returns both the Arguments and Attributes for the specified Typed Resource/Data Source
+func combinedSchema(input interface {
+	Arguments() map[string]*pluginsdk.Schema
+	Attributes() map[string]*pluginsdk.Schema
+},
+) map[string]*pluginsdk.Schema {
+	output := make(map[string]*pluginsdk.Schema)
+	for k, v := range input.Arguments() {
+		output[k] = v
+	}
+	for k, v := range input.Attributes() {
+		output[k] = v
+	}
+	return output
+}
+
+// lookupSchemaPath returns the Schema for the specified path (e.g. `identity.0.type`) within the input Schema
+// the returned bool is false when the path doesn't exist
+func lookupSchemaPath(input map[string]*pluginsdk.Schema, path string) (*pluginsdk.Schema, bool) {
+	segments := strings.Split(path, ".")
+
+	current := input
+	var field *pluginsdk.Schema
+	for i := 0; i < len(segments); i++ {
+		v, ok := current[segments[i]]
+		if !ok {
+			return nil, false
+		}
+		field = v
+
+		// list/set indexes (or the count) can be skipped
+		if i+1 < len(segments) {
+			if _, err := strconv.Atoi(segments[i+1]); err == nil || segments[i+1] == "#" {
+				i++
+			}
+		}
+
+		resource, ok := field.Elem.(*pluginsdk.Resource)
+		if !ok {
+			// a map key or similar, which we can't validate
+			return field, true
+		}
+		current = resource.Schema
+	}
+
+	return field, true
+}
+
+// argumentsAreAllForceNew returns whether every Argument within the Resource is ForceNew
+func argumentsAreAllForceNew(resource sdk.Resource) bool {
+	arguments := resource.Arguments()
+	if len(arguments) == 0 {
+		return false
+	}
+
+	for _, v := range arguments {
+		if !v.ForceNew {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,257 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+// packageSource is the parsed source code for a single Go package (excluding tests)
+type packageSource struct {
+	fileSet *token.FileSet
+	files   map[string]*ast.File
+
+	// functions is a map of the package-level functions, keyed by name
+	functions map[string]*ast.FuncDecl
+
+	// methods is a map of the methods, keyed by `{ReceiverType}.{Name}`
+	methods map[string]*ast.FuncDecl
+}
+
+// resourceFuncSource is the source code for the function literal backing an sdk.ResourceFunc
+type resourceFuncSource struct {
+	pkg *packageSource
+
+	// receiverType is the type name of the receiver for the method containing the function literal, if any
+	receiverType string
+
+	funcLit *ast.FuncLit
+}
+
+var parsedPackages = map[string]*packageSource{}
+
+func parsePackage(directory string) (*packageSource, error) {
+	if pkg, ok := parsedPackages[directory]; ok {
+		return pkg, nil
+	}
+
+	entries, err := os.ReadDir(directory)
+	if err != nil {
+		return nil, fmt.Errorf("reading directory %q: %+v", directory, err)
+	}
+
+	pkg := &packageSource{
+		fileSet:   token.NewFileSet(),
+		files:     map[string]*ast.File{},
+		functions: map[string]*ast.FuncDecl{},
+		methods:   map[string]*ast.FuncDecl{},
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".go") || strings.HasSuffix(entry.Name(), "_test.go") {
+			continue
+		}
+
+		fileName := filepath.Join(directory, entry.Name())
+		file, err := parser.ParseFile(pkg.fileSet, fileName, nil, 0)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %q: %+v", fileName, err)
+		}
+		pkg.files[fileName] = file
+
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Body == nil {
+				continue
+			}
+
+			if funcDecl.Recv == nil {
+				pkg.functions[funcDecl.Name.Name] = funcDecl
+				continue
+			}
+
+			if receiverType := receiverTypeName(funcDecl); receiverType != "" {
+				pkg.methods[fmt.Sprintf("%s.%s", receiverType, funcDecl.Name.Name)] = funcDecl
+			}
+		}
+	}
+
+	parsedPackages[directory] = pkg
+	return pkg, nil
+}
+
+// sourceForResourceFunc locates the function literal used as the `Func` for the specified sdk.ResourceFunc
+func sourceForResourceFunc(input sdk.ResourceFunc) (*resourceFuncSource, error) {
+	if input.Func == nil {
+		return nil, fmt.Errorf("the ResourceFunc has no Func defined")
+	}
+
+	pc := reflect.ValueOf(input.Func).Pointer()
+	fileName, line := runtime.FuncForPC(pc).FileLine(pc)
+
+	pkg, err := parsePackage(filepath.Dir(fileName))
+	if err != nil {
+		return nil, err
+	}
+
+	file, ok := pkg.files[fileName]
+	if !ok {
+		return nil, fmt.Errorf("the source file %q was not found", fileName)
+	}
+
+	output := resourceFuncSource{
+		pkg: pkg,
+	}
+	for _, decl := range file.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+		if !ok || funcDecl.Body == nil {
+			continue
+		}
+		if pkg.fileSet.Position(funcDecl.Pos()).Line > line || pkg.fileSet.Position(funcDecl.End()).Line < line {
+			continue
+		}
+
+		// the innermost function literal containing this line is the one we're looking for
+		ast.Inspect(funcDecl.Body, func(node ast.Node) bool {
+			funcLit, ok := node.(*ast.FuncLit)
+			if !ok {
+				return true
+			}
+			if pkg.fileSet.Position(funcLit.Pos()).Line > line || pkg.fileSet.Position(funcLit.End()).Line < line {
+				return false
+			}
+
+			output.funcLit = funcLit
+			output.receiverType = receiverTypeName(funcDecl)
+			return true
+		})
+	}
+
+	if output.funcLit == nil {
+		return nil, fmt.Errorf("a function literal was not found at %s:%d", fileName, line)
+	}
+
+	return &output, nil
+}
+
+// callsAnyOf returns whether the function literal calls a function or method with one of the specified names,
+// either directly or via a function/method defined within the same package
+func (s resourceFuncSource) callsAnyOf(names ...string) bool {
+	visited := map[*ast.FuncDecl]struct{}{}
+
+	var inspect func(node ast.Node, receiverType string) bool
+	inspect = func(node ast.Node, receiverType string) bool {
+		found := false
+		ast.Inspect(node, func(n ast.Node) bool {
+			if found {
+				return false
+			}
+			call, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+
+			var next *ast.FuncDecl
+			switch fun := call.Fun.(type) {
+			case *ast.Ident:
+				if nameIn(fun.Name, names) {
+					found = true
+					return false
+				}
+				next = s.pkg.functions[fun.Name]
+
+			case *ast.SelectorExpr:
+				if nameIn(fun.Sel.Name, names) {
+					found = true
+					return false
+				}
+				if receiverType != "" {
+					next = s.pkg.methods[fmt.Sprintf("%s.%s", receiverType, fun.Sel.Name)]
+				}
+			}
+
+			if next != nil {
+				if _, ok := visited[next]; !ok {
+					visited[next] = struct{}{}
+					if inspect(next.Body, receiverTypeName(next)) {
+						found = true
+						return false
+					}
+				}
+			}
+
+			return true
+		})
+		return found
+	}
+
+	return inspect(s.funcLit.Body, s.receiverType)
+}
+
+// stringArgumentsForCallsTo returns the string literal arguments passed to any function or method
+// with one of the specified names within the function literal
+func (s resourceFuncSource) stringArgumentsForCallsTo(names ...string) []string {
+	output := make([]string, 0)
+	ast.Inspect(s.funcLit.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		selector, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || !nameIn(selector.Sel.Name, names) {
+			return true
+		}
+
+		for _, arg := range call.Args {
+			literal, ok := arg.(*ast.BasicLit)
+			if !ok || literal.Kind != token.STRING {
+				continue
+			}
+			if v, err := strconv.Unquote(literal.Value); err == nil {
+				output = append(output, v)
+			}
+		}
+		return true
+	})
+	return output
+}
+
+func (s resourceFuncSource) position() string {
+	return s.pkg.fileSet.Position(s.funcLit.Pos()).String()
+}
+
+func receiverTypeName(input *ast.FuncDecl) string {
+	if input.Recv == nil || len(input.Recv.List) == 0 {
+		return ""
+	}
+
+	expr := input.Recv.List[0].Type
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+
+	return ""
+}
+
+func nameIn(name string, names []string) bool {
+	for _, v := range names {
+		if v == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testfixtures
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// InvalidResource is a Typed Resource which violates each of the Static Analysis rules
+type InvalidResource struct{}
+
+var _ sdk.ResourceWithUpdate = InvalidResource{}
+
+type InvalidResourceModel struct {
+	Name     string                `tfschema:"name"`
+	Location string                `tfschema:"location"`
+	Settings []InvalidSettingModel `tfschema:"setting"`
+	Missing  string                `tfschema:"missing"`
+}
+
+type InvalidSettingModel struct {
+	Enabled bool   `tfschema:"enabled"`
+	Mode    string `tfschema:"mode"`
+}
+
+func (r InvalidResource) ResourceType() string {
+	return "azurerm_invalid"
+}
+
+func (r InvalidResource) ModelObject() interface{} {
+	return &InvalidResourceModel{}
+}
+
+func (r InvalidResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return commonids.ValidateResourceGroupID
+}
+
+func (r InvalidResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"location": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"setting": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r InvalidResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r InvalidResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model InvalidResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			metadata.SetID(commonids.NewResourceGroupID(metadata.Client.Account.SubscriptionId, model.Name))
+			return nil
+		},
+	}
+}
+
+func (r InvalidResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := commonids.ParseResourceGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("%s was not found", id)
+		},
+	}
+}
+
+func (r InvalidResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			if metadata.ResourceData.HasChange("location") {
+				return nil
+			}
+
+			if metadata.ResourceData.HasChanges("setting.0.mode", "tags") {
+				return nil
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r InvalidResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			return nil
+		},
+	}
+}
+
+// AllForceNewResource is a Typed Resource which implements Update despite every Argument being ForceNew
+type AllForceNewResource struct {
+	InvalidResource
+}
+
+var _ sdk.ResourceWithUpdate = AllForceNewResource{}
+
+func (r AllForceNewResource) ResourceType() string {
+	return "azurerm_all_force_new"
+}
+
+func (r AllForceNewResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+	}
+}
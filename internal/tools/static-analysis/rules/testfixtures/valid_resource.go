@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package testfixtures
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// ValidResource is a Typed Resource which conforms to each of the Static Analysis rules
+type ValidResource struct{}
+
+var _ sdk.ResourceWithUpdate = ValidResource{}
+
+type ValidResourceModel struct {
+	Name     string              `tfschema:"name"`
+	Settings []ValidSettingModel `tfschema:"setting"`
+	Tags     map[string]string   `tfschema:"tags"`
+	Status   string              `tfschema:"status"`
+}
+
+type ValidSettingModel struct {
+	Enabled bool `tfschema:"enabled"`
+}
+
+func (r ValidResource) ResourceType() string {
+	return "azurerm_valid"
+}
+
+func (r ValidResource) ModelObject() interface{} {
+	return &ValidResourceModel{}
+}
+
+func (r ValidResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return commonids.ValidateResourceGroupID
+}
+
+func (r ValidResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"setting": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+					},
+				},
+			},
+		},
+
+		"tags": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r ValidResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ValidResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ValidResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := commonids.NewResourceGroupID(metadata.Client.Account.SubscriptionId, model.Name)
+			if exists(ctx, id) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ValidResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := commonids.ParseResourceGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if !exists(ctx, *id) {
+				// the call to MarkAsGone happens within a method on the Resource, which should be followed
+				return r.markAsGone(metadata, *id)
+			}
+
+			return metadata.Encode(&ValidResourceModel{
+				Name: id.ResourceGroupName,
+			})
+		},
+	}
+}
+
+func (r ValidResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			if metadata.ResourceData.HasChange("tags") {
+				return nil
+			}
+
+			if metadata.ResourceData.HasChanges("setting.0.enabled", "setting") {
+				return nil
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ValidResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			return nil
+		},
+	}
+}
+
+func (r ValidResource) markAsGone(metadata sdk.ResourceMetaData, id commonids.ResourceGroupId) error {
+	return metadata.MarkAsGone(id)
+}
+
+func exists(_ context.Context, _ commonids.ResourceGroupId) bool {
+	return false
+}
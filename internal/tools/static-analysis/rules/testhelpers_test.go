@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// assertViolationKeys checks that the input contains only Violations, with the expected Baseline entries
+func assertViolationKeys(t *testing.T, input []error, expected []string) {
+	t.Helper()
+
+	actual := make([]string, 0)
+	for _, err := range input {
+		var violation Violation
+		if !errors.As(err, &violation) {
+			t.Fatalf("expected a Violation but got: %+v", err)
+		}
+		actual = append(actual, violation.BaselineEntry())
+	}
+
+	sort.Strings(actual)
+	sort.Strings(expected)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected the violations %+v but got %+v", expected, actual)
+	}
+}
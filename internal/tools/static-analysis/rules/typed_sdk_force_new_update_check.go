@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ Rule = TypedSDKForceNewUpdateCheck{}
+
+type TypedSDKForceNewUpdateCheck struct{}
+
+func (r TypedSDKForceNewUpdateCheck) Run() (errors []error) {
+	for _, s := range provider.SupportedTypedServices() {
+		for _, resource := range s.Resources() {
+			errors = append(errors, r.checkResource(resource)...)
+		}
+	}
+
+	return
+}
+
+func (r TypedSDKForceNewUpdateCheck) checkResource(resource sdk.Resource) (errors []error) {
+	updater, ok := resource.(sdk.ResourceWithUpdate)
+	if !ok {
+		return nil
+	}
+
+	if argumentsAreAllForceNew(resource) {
+		return []error{Violation{
+			Rule:         r.Name(),
+			ResourceType: resource.ResourceType(),
+			Message:      "every argument is ForceNew, so this resource should not implement `sdk.ResourceWithUpdate`",
+		}}
+	}
+
+	source, err := sourceForResourceFunc(updater.Update())
+	if err != nil {
+		return []error{fmt.Errorf("%s: locating the Update function: %+v\n", resource.ResourceType(), err)}
+	}
+
+	schema := combinedSchema(resource)
+	for _, key := range source.stringArgumentsForCallsTo("HasChange", "HasChanges") {
+		if field, ok := lookupSchemaPath(schema, key); ok && field.ForceNew {
+			errors = append(errors, Violation{
+				Rule:         r.Name(),
+				ResourceType: resource.ResourceType(),
+				Key:          key,
+				Message:      fmt.Sprintf("the Update function at %s checks for a change to %q which is ForceNew", source.position(), key),
+			})
+		}
+	}
+
+	return
+}
+
+func (r TypedSDKForceNewUpdateCheck) Name() string {
+	return "checkForceNewInUpdate"
+}
+
+func (r TypedSDKForceNewUpdateCheck) Description() string {
+	return fmt.Sprintf(`
+The '%s' check function is used to check that TypedSDK Resources implementing 'sdk.ResourceWithUpdate' have at least one argument which isn't ForceNew, and that the Update function doesn't check for changes to ForceNew fields.
+`, r.Name())
+}
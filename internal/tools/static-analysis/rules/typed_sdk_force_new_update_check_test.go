@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/static-analysis/rules/testfixtures"
+)
+
+func TestTypedSDKForceNewUpdateCheck(t *testing.T) {
+	testData := []struct {
+		resource sdk.Resource
+		expected []string
+	}{
+		{
+			resource: testfixtures.ValidResource{},
+			expected: []string{},
+		},
+		{
+			resource: testfixtures.InvalidResource{},
+			expected: []string{
+				"checkForceNewInUpdate azurerm_invalid location",
+			},
+		},
+		{
+			resource: testfixtures.AllForceNewResource{},
+			expected: []string{
+				"checkForceNewInUpdate azurerm_all_force_new",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.resource.ResourceType(), func(t *testing.T) {
+			assertViolationKeys(t, TypedSDKForceNewUpdateCheck{}.checkResource(v.resource), v.expected)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ Rule = TypedSDKHasChangeCheck{}
+
+type TypedSDKHasChangeCheck struct{}
+
+func (r TypedSDKHasChangeCheck) Run() (errors []error) {
+	for _, s := range provider.SupportedTypedServices() {
+		for _, resource := range s.Resources() {
+			errors = append(errors, r.checkResource(resource)...)
+		}
+	}
+
+	return
+}
+
+func (r TypedSDKHasChangeCheck) checkResource(resource sdk.Resource) (errors []error) {
+	updater, ok := resource.(sdk.ResourceWithUpdate)
+	if !ok {
+		return nil
+	}
+
+	source, err := sourceForResourceFunc(updater.Update())
+	if err != nil {
+		return []error{fmt.Errorf("%s: locating the Update function: %+v\n", resource.ResourceType(), err)}
+	}
+
+	schema := combinedSchema(resource)
+	for _, key := range source.stringArgumentsForCallsTo("HasChange", "HasChanges", "GetChange") {
+		if _, ok := lookupSchemaPath(schema, key); !ok {
+			errors = append(errors, Violation{
+				Rule:         r.Name(),
+				ResourceType: resource.ResourceType(),
+				Key:          key,
+				Message:      fmt.Sprintf("the Update function at %s references %q which is not defined in the Schema", source.position(), key),
+			})
+		}
+	}
+
+	return
+}
+
+func (r TypedSDKHasChangeCheck) Name() string {
+	return "checkHasChangeKeys"
+}
+
+func (r TypedSDKHasChangeCheck) Description() string {
+	return fmt.Sprintf(`
+The '%s' check function is used to check that the keys passed to 'metadata.ResourceData.HasChange' (and 'HasChanges'/'GetChange') in the Update function of TypedSDK Resources exist in the Schema.
+`, r.Name())
+}
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ Rule = TypedSDKMarkAsGoneCheck{}
+
+type TypedSDKMarkAsGoneCheck struct{}
+
+func (r TypedSDKMarkAsGoneCheck) Run() (errors []error) {
+	for _, s := range provider.SupportedTypedServices() {
+		for _, resource := range s.Resources() {
+			errors = append(errors, r.checkResource(resource)...)
+		}
+	}
+
+	return
+}
+
+func (r TypedSDKMarkAsGoneCheck) checkResource(resource sdk.Resource) []error {
+	source, err := sourceForResourceFunc(resource.Read())
+	if err != nil {
+		return []error{fmt.Errorf("%s: locating the Read function: %+v\n", resource.ResourceType(), err)}
+	}
+
+	if !source.callsAnyOf("MarkAsGone") {
+		return []error{Violation{
+			Rule:         r.Name(),
+			ResourceType: resource.ResourceType(),
+			Message:      fmt.Sprintf("the Read function at %s should call `metadata.MarkAsGone` when the resource is not found", source.position()),
+		}}
+	}
+
+	return nil
+}
+
+func (r TypedSDKMarkAsGoneCheck) Name() string {
+	return "checkReadMarksAsGone"
+}
+
+func (r TypedSDKMarkAsGoneCheck) Description() string {
+	return fmt.Sprintf(`
+The '%s' check function is used to check that the Read function for TypedSDK Resources calls 'metadata.MarkAsGone' when the resource returns a 404, rather than raising an error.
+`, r.Name())
+}
@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/static-analysis/rules/testfixtures"
+)
+
+func TestTypedSDKMarkAsGoneCheck(t *testing.T) {
+	testData := []struct {
+		resource sdk.Resource
+		expected []string
+	}{
+		{
+			resource: testfixtures.ValidResource{},
+			expected: []string{},
+		},
+		{
+			resource: testfixtures.InvalidResource{},
+			expected: []string{
+				"checkReadMarksAsGone azurerm_invalid",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.resource.ResourceType(), func(t *testing.T) {
+			assertViolationKeys(t, TypedSDKMarkAsGoneCheck{}.checkResource(v.resource), v.expected)
+		})
+	}
+}
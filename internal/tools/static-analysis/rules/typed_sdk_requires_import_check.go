@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ Rule = TypedSDKRequiresImportCheck{}
+
+type TypedSDKRequiresImportCheck struct{}
+
+func (r TypedSDKRequiresImportCheck) Run() (errors []error) {
+	for _, s := range provider.SupportedTypedServices() {
+		for _, resource := range s.Resources() {
+			errors = append(errors, r.checkResource(resource)...)
+		}
+	}
+
+	return
+}
+
+func (r TypedSDKRequiresImportCheck) checkResource(resource sdk.Resource) []error {
+	source, err := sourceForResourceFunc(resource.Create())
+	if err != nil {
+		return []error{fmt.Errorf("%s: locating the Create function: %+v\n", resource.ResourceType(), err)}
+	}
+
+	if !source.callsAnyOf("ResourceRequiresImport", "ImportAsExistsError", "ImportAsExistsAssociationError") {
+		return []error{Violation{
+			Rule:         r.Name(),
+			ResourceType: resource.ResourceType(),
+			Message:      fmt.Sprintf("the Create function at %s should check for an existing resource and return `metadata.ResourceRequiresImport`", source.position()),
+		}}
+	}
+
+	return nil
+}
+
+func (r TypedSDKRequiresImportCheck) Name() string {
+	return "checkCreateRequiresImport"
+}
+
+func (r TypedSDKRequiresImportCheck) Description() string {
+	return fmt.Sprintf(`
+The '%s' check function is used to check that the Create function for TypedSDK Resources checks for an existing resource and returns 'metadata.ResourceRequiresImport' if one exists.
+`, r.Name())
+}
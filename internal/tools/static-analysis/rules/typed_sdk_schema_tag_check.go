@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ Rule = TypedSDKSchemaTagCheck{}
+
+type TypedSDKSchemaTagCheck struct{}
+
+// typedModelWithSchema is the subset of a Typed Resource/Data Source needed to check its Model against its Schema
+type typedModelWithSchema interface {
+	Arguments() map[string]*pluginsdk.Schema
+	Attributes() map[string]*pluginsdk.Schema
+	ModelObject() interface{}
+	ResourceType() string
+}
+
+func (r TypedSDKSchemaTagCheck) Run() (errors []error) {
+	for _, s := range provider.SupportedTypedServices() {
+		for _, resource := range s.Resources() {
+			errors = append(errors, r.checkResource(resource)...)
+		}
+		for _, datasource := range s.DataSources() {
+			errors = append(errors, r.checkResource(datasource)...)
+		}
+	}
+
+	return
+}
+
+func (r TypedSDKSchemaTagCheck) checkResource(resource typedModelWithSchema) []error {
+	modelType := reflect.TypeOf(resource.ModelObject())
+	if modelType == nil || modelType.Kind() != reflect.Ptr || modelType.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+
+	return r.checkModel(resource.ResourceType(), modelType.Elem(), combinedSchema(resource))
+}
+
+func (r TypedSDKSchemaTagCheck) checkModel(resourceType string, model reflect.Type, schema map[string]*pluginsdk.Schema) (errors []error) {
+	for i := 0; i < model.NumField(); i++ {
+		field := model.Field(i)
+		tag, ok := field.Tag.Lookup("tfschema")
+		if !ok {
+			continue
+		}
+
+		key := strings.TrimSpace(strings.Split(tag, ",")[0])
+		value, ok := schema[key]
+		if !ok {
+			errors = append(errors, Violation{
+				Rule:         r.Name(),
+				ResourceType: resourceType,
+				Key:          fmt.Sprintf("%s.%s", model.Name(), field.Name),
+				Message:      fmt.Sprintf("property %s in model %s has the tfschema tag %q which is not defined in the Schema", field.Name, model.Name(), key),
+			})
+			continue
+		}
+
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Slice || fieldType.Kind() == reflect.Array {
+			fieldType = fieldType.Elem()
+		}
+		if fieldType.Kind() != reflect.Struct {
+			continue
+		}
+
+		if nested, ok := value.Elem.(*pluginsdk.Resource); ok {
+			errors = append(errors, r.checkModel(resourceType, fieldType, nested.Schema)...)
+		}
+	}
+
+	return
+}
+
+func (r TypedSDKSchemaTagCheck) Name() string {
+	return "checkSchemaTags"
+}
+
+func (r TypedSDKSchemaTagCheck) Description() string {
+	return fmt.Sprintf(`
+The '%s' check function is used to check that each 'tfschema' struct tag in a TypedSDK model matches a key in the Schema.
+`, r.Name())
+}
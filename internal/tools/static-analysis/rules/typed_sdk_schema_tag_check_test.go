@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/static-analysis/rules/testfixtures"
+)
+
+func TestTypedSDKSchemaTagCheck(t *testing.T) {
+	testData := []struct {
+		resource sdk.Resource
+		expected []string
+	}{
+		{
+			resource: testfixtures.ValidResource{},
+			expected: []string{},
+		},
+		{
+			resource: testfixtures.InvalidResource{},
+			expected: []string{
+				"checkSchemaTags azurerm_invalid InvalidResourceModel.Missing",
+				"checkSchemaTags azurerm_invalid InvalidSettingModel.Mode",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.resource.ResourceType(), func(t *testing.T) {
+			assertViolationKeys(t, TypedSDKSchemaTagCheck{}.checkResource(v.resource), v.expected)
+		})
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Violation is a finding raised by a Rule against a specific Resource, which (unlike other errors) can be
+// suppressed by including it in the Baseline of known violations
+type Violation struct {
+	// Rule is the name of the Rule which raised this Violation
+	Rule string
+
+	// ResourceType is the Terraform Resource Type (e.g. `azurerm_resource_group`) this Violation is for
+	ResourceType string
+
+	// Key identifies the Violation within the Resource - for example the Schema key or Model field
+	Key string
+
+	// Message is a human-readable description of the Violation
+	Message string
+}
+
+func (v Violation) Error() string {
+	return fmt.Sprintf("%s: %s\n", v.ResourceType, v.Message)
+}
+
+// BaselineEntry returns the line used to represent this Violation in the Baseline file
+func (v Violation) BaselineEntry() string {
+	return strings.TrimSpace(fmt.Sprintf("%s %s %s", v.Rule, v.ResourceType, v.Key))
+}
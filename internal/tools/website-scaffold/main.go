@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/magodo/terraform-provider-azurerm-example-gen/examplegen"
 )
 
@@ -38,6 +39,10 @@ func main() {
 	servicePkg := f.String("service-pkg", "", "The service package where the AccTest resides in. Required when `-example` is set.")
 	testCase := f.String("testcase", "", "The name of the AccTest where the Terraform configuration derives from. Required when `-example` is set.")
 
+	// reference regeneration related flags
+	regenerate := f.Bool("regenerate-reference", false, "Whether to regenerate the Arguments/Attributes Reference sections of existing documentation, rather than scaffolding a new page")
+	checkOnly := f.Bool("check", false, "Whether to only check that the Arguments/Attributes Reference sections are up to date, rather than writing them. Only valid with `-regenerate-reference`.")
+
 	_ = f.Parse(os.Args[1:])
 
 	quitWithError := func(message string) {
@@ -45,6 +50,29 @@ func main() {
 		os.Exit(1)
 	}
 
+	if *regenerate {
+		if websitePath == nil || *websitePath == "" {
+			quitWithError("The Relative Website Path must be specified via `-website-path`")
+			return
+		}
+
+		outdated, err := regenerateReference(*resourceName, *brandName, *websitePath, *checkOnly)
+		if err != nil {
+			quitWithError(err.Error())
+			return
+		}
+		if len(outdated) > 0 {
+			quitWithError(fmt.Sprintf("The Arguments/Attributes Reference is out of date for:\n\n* %s\n\nRun `make website-reference` to regenerate it.", strings.Join(outdated, "\n* ")))
+			return
+		}
+		return
+	}
+
+	if *checkOnly {
+		quitWithError("`-check` can only be specified alongside `-regenerate-reference`")
+		return
+	}
+
 	if resourceName == nil || *resourceName == "" {
 		quitWithError("The name of the Data Source/Resource must be specified via `-name`")
 		return
@@ -203,6 +231,202 @@ func saveContent(resourceName string, websitePath string, content string, isReso
 	return file.Sync()
 }
 
+// regenerateReference re-renders the Arguments/Attributes Reference sections of the existing documentation
+// for a Resource from it's Schema, leaving the remainder of the page (e.g. the Example Usage) untouched.
+//
+// When no resourceName is specified all Typed Resources where every argument has a Description are processed,
+// since these are the Resources which opt-in to having their documentation generated - the Typed Resources which
+// are skipped are logged, alongside the first argument missing a Description.
+//
+// When checkOnly is set the documentation isn't updated, instead the names of the Resources which are
+// out of date are returned.
+func regenerateReference(resourceName, brandName, websitePath string, checkOnly bool) ([]string, error) {
+	resources := make(map[string]*schema.Resource)
+	skipped := make([]string, 0)
+	for _, service := range provider.SupportedTypedServices() {
+		for _, rs := range service.Resources() {
+			if resourceName != "" && rs.ResourceType() != resourceName {
+				continue
+			}
+
+			wrapper := sdk.NewResourceWrapper(rs)
+			rsWrapper, err := wrapper.Resource()
+			if err != nil {
+				return nil, fmt.Errorf("wrapping Resource %q: %+v", rs.ResourceType(), err)
+			}
+
+			if resourceName == "" {
+				if field := undescribedArgument(rsWrapper.Schema); field != "" {
+					skipped = append(skipped, fmt.Sprintf("%s (`%s` has no Description)", rs.ResourceType(), field))
+					continue
+				}
+			}
+
+			resources[rs.ResourceType()] = rsWrapper
+		}
+	}
+	if resourceName != "" {
+		for _, service := range provider.SupportedUntypedServices() {
+			for key, rs := range service.SupportedResources() {
+				if key == resourceName {
+					resources[key] = rs
+				}
+			}
+		}
+
+		if len(resources) == 0 {
+			return nil, fmt.Errorf("Resource %q was not registered!", resourceName)
+		}
+	}
+
+	if len(skipped) > 0 {
+		sort.Strings(skipped)
+		log.Printf("Skipping %d Typed Resources which aren't fully described:\n\n* %s", len(skipped), strings.Join(skipped, "\n* "))
+	}
+
+	names := make([]string, 0)
+	for name := range resources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	outdated := make([]string, 0)
+	for _, name := range names {
+		fileName := fmt.Sprintf("%s/docs/r/%s.html.markdown", websitePath, strings.TrimPrefix(name, "azurerm_"))
+		existing, err := os.ReadFile(fileName)
+		if err != nil {
+			if os.IsNotExist(err) && resourceName == "" {
+				log.Printf("Skipping %q since the documentation %q was not found", name, fileName)
+				continue
+			}
+			return nil, fmt.Errorf("reading the documentation for %q: %+v", name, err)
+		}
+
+		resourceBrandName := brandName
+		if resourceBrandName == "" {
+			resourceBrandName, err = brandNameFromDocumentation(string(existing))
+			if err != nil {
+				if resourceName != "" {
+					return nil, fmt.Errorf("determining the brand name for %q: %+v", name, err)
+				}
+
+				log.Printf("Skipping %q since the brand name couldn't be determined: %+v", name, err)
+				continue
+			}
+		}
+
+		generator := documentationGenerator{
+			resource:     resources[name],
+			brandName:    resourceBrandName,
+			resourceName: name,
+		}
+		updated, err := replaceReferenceBlocks(string(existing), generator.argumentsBlock(), generator.attributesBlock())
+		if err != nil {
+			return nil, fmt.Errorf("regenerating the documentation for %q: %+v", name, err)
+		}
+
+		if updated == string(existing) {
+			continue
+		}
+
+		if checkOnly {
+			outdated = append(outdated, name)
+			continue
+		}
+
+		if err := os.WriteFile(fileName, []byte(updated), 0644); err != nil {
+			return nil, fmt.Errorf("writing the documentation for %q: %+v", name, err)
+		}
+		log.Printf("Regenerated the Arguments/Attributes Reference for %q", name)
+	}
+
+	return outdated, nil
+}
+
+// brandNameFromDocumentation parses the brand name from the front matter description (e.g. `Manages a Resource Group.`)
+func brandNameFromDocumentation(content string) (string, error) {
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		if strings.TrimSpace(line) != "description: |-" || i+1 >= len(lines) {
+			continue
+		}
+
+		description := strings.TrimSuffix(strings.TrimSpace(lines[i+1]), ".")
+		for _, prefix := range []string{"Manages an ", "Manages a "} {
+			if strings.HasPrefix(description, prefix) {
+				return strings.TrimPrefix(description, prefix), nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("the front matter description wasn't in the format `Manages a {Brand Name}.` - specify the brand name via `-brand-name`")
+}
+
+// replaceReferenceBlocks replaces everything from the Arguments Reference heading up to the heading following
+// the Attributes Reference (e.g. Timeouts or Import) with the specified blocks. When the Attributes Reference
+// heading is missing it's treated as empty, and the blocks replace the Arguments Reference alone.
+func replaceReferenceBlocks(content, argumentsBlock, attributesBlock string) (string, error) {
+	findHeading := func(headings []string, from int) int {
+		for _, heading := range headings {
+			if idx := strings.Index(content[from:], "\n"+heading+"\n"); idx != -1 {
+				return from + idx + 1
+			}
+		}
+		return -1
+	}
+
+	start := findHeading([]string{"## Arguments Reference", "## Argument Reference"}, 0)
+	if start == -1 {
+		return "", fmt.Errorf("the `## Arguments Reference` heading was not found")
+	}
+	end := len(content)
+	nextHeading := func(from int) {
+		if idx := strings.Index(content[from:], "\n## "); idx != -1 {
+			end = from + idx + 1
+		}
+	}
+
+	// when the page has no Attributes Reference (e.g. because only the ID is exported) it's treated as empty,
+	// and is inserted ahead of the heading following the Arguments Reference
+	if attributes := findHeading([]string{"## Attributes Reference", "## Attribute Reference"}, start); attributes != -1 {
+		nextHeading(attributes + 1)
+	} else {
+		nextHeading(start + 1)
+	}
+
+	trailer := strings.TrimLeft(content[end:], "\n")
+	if trailer != "" {
+		trailer = "\n\n" + trailer
+	}
+
+	return content[:start] + argumentsBlock + "\n\n" + attributesBlock + trailer, nil
+}
+
+// undescribedArgument returns the path of the first argument within the schema (including nested blocks) which
+// doesn't have a Description, or an empty string when every argument is described
+func undescribedArgument(input map[string]*schema.Schema) string {
+	names := make([]string, 0)
+	for name := range input {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		field := input[name]
+		if (field.Optional || field.Required) && field.Description == "" {
+			return name
+		}
+
+		if v, ok := field.Elem.(*schema.Resource); ok && v != nil && (field.Optional || field.Required) {
+			if nested := undescribedArgument(v.Schema); nested != "" {
+				return fmt.Sprintf("%s.%s", name, nested)
+			}
+		}
+	}
+
+	return ""
+}
+
 type documentationGenerator struct {
 	resource *schema.Resource
 
@@ -283,13 +507,17 @@ func (gen documentationGenerator) argumentsBlock() string {
 			}
 
 			value := gen.buildDescriptionForArgument(fieldName, field, blockName)
+			if possibleValues := gen.possibleValuesForField(field); len(possibleValues) > 0 && !strings.Contains(strings.ToLower(value), "possible value") {
+				value += fmt.Sprintf(" Possible values are %s.", gen.joinPossibleValues(possibleValues))
+			}
+
 			if len(field.ConflictsWith) > 0 {
 				conflictingValues := make([]string, 0)
 				for _, v := range field.ConflictsWith {
 					conflictingValues = append(conflictingValues, fmt.Sprintf("`%s`", v))
 				}
 
-				value += fmt.Sprintf("Conflicts with %s.", strings.Join(conflictingValues, ","))
+				value += fmt.Sprintf(" Conflicts with %s.", strings.Join(conflictingValues, ", "))
 			}
 
 			if field.Default != nil {
@@ -564,6 +792,10 @@ func (gen documentationGenerator) buildIndentForExample(level int) string {
 }
 
 func (gen documentationGenerator) buildDescriptionForArgument(name string, field *schema.Schema, blockName string) string {
+	if field.Description != "" {
+		return gen.descriptionFromSchema(field)
+	}
+
 	if name == "name" {
 		if blockName == "" {
 			if gen.isDataSource {
@@ -632,6 +864,10 @@ func (gen documentationGenerator) buildDescriptionForArgument(name string, field
 }
 
 func (gen documentationGenerator) buildDescriptionForAttribute(name string, field *schema.Schema, blockName string) string {
+	if field.Description != "" {
+		return gen.descriptionFromSchema(field)
+	}
+
 	if name == "name" {
 		if blockName == "" {
 			return fmt.Sprintf("The name of this %s.", gen.brandName)
@@ -685,6 +921,14 @@ func (gen documentationGenerator) buildDescriptionForAttribute(name string, fiel
 	return "TODO."
 }
 
+func (gen documentationGenerator) descriptionFromSchema(field *schema.Schema) string {
+	description := strings.TrimSpace(field.Description)
+	if !strings.HasSuffix(description, ".") && !strings.HasSuffix(description, "?") {
+		description += "."
+	}
+	return description
+}
+
 func (gen documentationGenerator) determineDefaultValueForExample(name string, field *schema.Schema) string {
 	if field.Default != nil {
 		if v, ok := field.Default.(bool); ok {
@@ -744,6 +988,32 @@ func (gen documentationGenerator) distinctBlockNames(input []string) []string {
 	return output
 }
 
+func (gen documentationGenerator) joinPossibleValues(input []string) string {
+	values := make([]string, 0)
+	for _, v := range input {
+		values = append(values, fmt.Sprintf("`%s`", v))
+	}
+
+	if len(values) == 1 {
+		return values[0]
+	}
+
+	return fmt.Sprintf("%s and %s", strings.Join(values[:len(values)-1], ", "), values[len(values)-1])
+}
+
+// possibleValuesForField returns the values accepted by a `validation.StringInSlice` ValidateFunc, if one is used.
+// Only the `StringInSlice` from `internal/tf/validation` exposes these values, rather than the one in the Plugin SDK.
+func (gen documentationGenerator) possibleValuesForField(field *schema.Schema) []string {
+	if field.Type != schema.TypeString || field.ValidateFunc == nil {
+		if v, ok := field.Elem.(*schema.Schema); ok && v != nil && (field.Type == schema.TypeList || field.Type == schema.TypeSet) {
+			return gen.possibleValuesForField(v)
+		}
+		return nil
+	}
+
+	return validation.PossibleValuesForValidateFunc(field.ValidateFunc)
+}
+
 func (gen documentationGenerator) processElementForExample(field string, indentLevel int, elem interface{}, isAttribute bool) string {
 	indent := gen.buildIndentForExample(indentLevel)
 
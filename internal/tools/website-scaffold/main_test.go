@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/sergi/go-diff/diffmatchpatch"
 )
 
@@ -162,6 +163,200 @@ A 'block3' block supports the following:
 	runTest(t, expectedOut, actualOut)
 }
 
+func TestResourceArgumentBlockFromDescriptions(t *testing.T) {
+	expectedOut := strings.ReplaceAll(`## Arguments Reference
+
+The following arguments are supported:
+
+* 'name' - (Required) The name of this Foobar. Changing this forces a new Foobar to be created.
+
+---
+
+* 'sku' - (Optional) The SKU which should be used for this Foobar. Possible values are 'Basic', 'Premium' and 'Standard'. Defaults to 'Standard'.
+
+* 'zones' - (Optional) The Availability Zones where this Foobar should be located. Possible values are '1', '2' and '3'.`, "'", "`")
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of this Foobar",
+			},
+			"sku": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Standard",
+				ValidateFunc: validation.StringInSlice([]string{"Basic", "Premium", "Standard"}, false),
+				Description:  "The SKU which should be used for this Foobar.",
+			},
+			"zones": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"1", "2", "3"}, false),
+				},
+				Description: "The Availability Zones where this Foobar should be located.",
+			},
+		},
+	}
+	gen := setupDocGen(false, resource)
+	actualOut := gen.argumentsBlock()
+
+	runTest(t, expectedOut, actualOut)
+}
+
+func TestReplaceReferenceBlocks(t *testing.T) {
+	input := strings.ReplaceAll(`# azurerm_foobar
+
+Manages a Foobar.
+
+## Example Usage
+
+'''hcl
+resource "azurerm_foobar" "example" {}
+'''
+
+## Argument Reference
+
+* 'name' - (Required) An outdated description.
+
+## Attributes Reference
+
+* 'id' - The ID of the Foobar.
+
+## Import
+
+Foobars can be imported using the 'resource id'.`, "'", "`")
+
+	expectedOut := strings.ReplaceAll(`# azurerm_foobar
+
+Manages a Foobar.
+
+## Example Usage
+
+'''hcl
+resource "azurerm_foobar" "example" {}
+'''
+
+## Arguments Reference
+
+NEW ARGUMENTS
+
+## Attributes Reference
+
+NEW ATTRIBUTES
+
+## Import
+
+Foobars can be imported using the 'resource id'.`, "'", "`")
+
+	actualOut, err := replaceReferenceBlocks(input, "## Arguments Reference\n\nNEW ARGUMENTS", "## Attributes Reference\n\nNEW ATTRIBUTES")
+	if err != nil {
+		t.Fatalf("replacing reference blocks: %+v", err)
+	}
+
+	runTest(t, expectedOut, actualOut)
+
+	if _, err := replaceReferenceBlocks("# azurerm_foobar", "", ""); err == nil {
+		t.Fatalf("expected an error when the Arguments Reference heading is missing")
+	}
+}
+
+func TestReplaceReferenceBlocksWithoutAttributesReference(t *testing.T) {
+	input := strings.ReplaceAll(`# azurerm_foobar
+
+## Arguments Reference
+
+* 'name' - (Required) An outdated description.
+
+## Import
+
+Foobars can be imported using the 'resource id'.`, "'", "`")
+
+	expectedOut := strings.ReplaceAll(`# azurerm_foobar
+
+## Arguments Reference
+
+NEW ARGUMENTS
+
+## Attributes Reference
+
+NEW ATTRIBUTES
+
+## Import
+
+Foobars can be imported using the 'resource id'.`, "'", "`")
+
+	actualOut, err := replaceReferenceBlocks(input, "## Arguments Reference\n\nNEW ARGUMENTS", "## Attributes Reference\n\nNEW ATTRIBUTES")
+	if err != nil {
+		t.Fatalf("replacing reference blocks: %+v", err)
+	}
+
+	runTest(t, expectedOut, actualOut)
+}
+
+func TestUndescribedArgument(t *testing.T) {
+	input := map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The name of this Foobar",
+		},
+		"id_computed": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"block": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "A block",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"described": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Described",
+					},
+					"undescribed": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+
+	if actual := undescribedArgument(input); actual != "block.undescribed" {
+		t.Fatalf("expected `block.undescribed` but got %q", actual)
+	}
+
+	input["block"].Elem.(*schema.Resource).Schema["undescribed"].Description = "Now Described"
+	if actual := undescribedArgument(input); actual != "" {
+		t.Fatalf("expected no undescribed arguments but got %q", actual)
+	}
+}
+
+func TestBrandNameFromDocumentation(t *testing.T) {
+	input := `---
+subcategory: "Foobar Category"
+layout: "azurerm"
+page_title: "Azure Resource Manager: azurerm_foobar"
+description: |-
+  Manages an Example Foobar.
+---`
+
+	actual, err := brandNameFromDocumentation(input)
+	if err != nil {
+		t.Fatalf("parsing brand name: %+v", err)
+	}
+	if actual != "Example Foobar" {
+		t.Fatalf("expected the brand name to be %q but got %q", "Example Foobar", actual)
+	}
+}
+
 func runTest(t *testing.T, expected, actual string) {
 	dmp := diffmatchpatch.New()
 	diffs := dmp.DiffMain(actual, expected, true)
@@ -5,9 +5,8 @@
 
 function runStaticAnalysis {
 # This tool checks for code conformity within the provider e.g. are the correct Go types used in TypedSDK structs.
-# Currently will not fail GHA's etc as we have existing violations in `main`. -fail-on-error=false can be removed when
-# these are resolved to prevent PRs introducing this in future.
-  go run internal/tools/static-analysis/main.go -fail-on-error=false
+# The existing violations in `main` are listed in the baseline file and are only logged, any new violations will fail.
+  go run internal/tools/static-analysis/main.go -baseline=internal/tools/static-analysis/baseline.txt
 }
 
 function main {